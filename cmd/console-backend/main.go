@@ -3,10 +3,12 @@ package main
 import (
 	"context"
 	"errors"
+	"flag"
 	"fmt"
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
@@ -20,13 +22,19 @@ import (
 	"github.com/nais/console-backend/internal/cost"
 	"github.com/nais/console-backend/internal/database"
 	"github.com/nais/console-backend/internal/database/gensql"
+	"github.com/nais/console-backend/internal/deliverymetrics"
 	"github.com/nais/console-backend/internal/dependencytrack"
+	"github.com/nais/console-backend/internal/deploykeys"
+	"github.com/nais/console-backend/internal/deployments"
+	"github.com/nais/console-backend/internal/export"
 	"github.com/nais/console-backend/internal/graph"
 	"github.com/nais/console-backend/internal/hookd"
 	"github.com/nais/console-backend/internal/k8s"
 	"github.com/nais/console-backend/internal/logger"
 	"github.com/nais/console-backend/internal/resourceusage"
 	"github.com/nais/console-backend/internal/teams"
+	"github.com/nais/console-backend/internal/vulnerabilityhistory"
+	"github.com/nais/console-backend/internal/vulnerabilityindex"
 	"github.com/prometheus/client_golang/api"
 	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -37,6 +45,7 @@ import (
 	met "go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/sdk/metric"
 	"golang.org/x/oauth2/google"
+	"k8s.io/client-go/tools/cache"
 )
 
 const (
@@ -48,8 +57,12 @@ const (
 )
 
 const (
-	costUpdateSchedule     = time.Hour
-	resourceUpdateSchedule = time.Hour
+	costUpdateSchedule            = time.Hour
+	resourceUpdateSchedule        = time.Hour
+	deliveryMetricsUpdateSchedule = time.Hour
+	deployKeyCheckSchedule        = 6 * time.Hour
+	vulnerabilityIndexSchedule    = time.Hour
+	vulnerabilitySnapshotSchedule = time.Hour
 )
 
 func main() {
@@ -75,7 +88,11 @@ func main() {
 		os.Exit(exitCodeLoggerError)
 	}
 
-	err = run(ctx, cfg, appLogger)
+	if len(os.Args) > 1 {
+		err = runCommand(ctx, cfg, appLogger, os.Args[1:])
+	} else {
+		err = run(ctx, cfg, appLogger)
+	}
 	if err != nil {
 		appLogger.WithError(err).Errorf("error in run()")
 		os.Exit(exitCodeRunError)
@@ -109,7 +126,7 @@ func run(ctx context.Context, cfg *config.Config, log logrus.FieldLogger) error
 	}
 	defer closer()
 
-	teamsBackendClient := teams.New(cfg.Teams, errorsCounter, log.WithField("client", "teams"))
+	teamsBackendClient := teams.New(cfg.Teams, errorsCounter, log.WithField("client", "teams"), teams.WithMeter(meter))
 	k8sClient, err := k8s.New(cfg.Tenant, cfg.K8S, errorsCounter, teamsBackendClient, log.WithField("client", "k8s"))
 	if err != nil {
 		var authErr *google.AuthenticationError
@@ -119,10 +136,11 @@ func run(ctx context.Context, cfg *config.Config, log logrus.FieldLogger) error
 		return fmt.Errorf("unable to create k8s client: %w", err)
 	}
 
-	hookdClient := hookd.New(cfg.Hookd, errorsCounter, log.WithField("client", "hookd"))
-	dependencyTrackClient := dependencytrack.New(cfg.DependencyTrack, log.WithField("client", "dependencytrack"))
+	hookdClient := hookd.New(cfg.Hookd, errorsCounter, log.WithField("client", "hookd"), hookd.WithMeter(meter))
+	deploymentsStore := deployments.NewStore(hookdClient, querier, log.WithField("subsystem", "deployments"))
+	dependencyTrackClient := dependencytrack.New(cfg.DependencyTrack, log.WithField("client", "dependencytrack"), dependencytrack.WithMeter(meter))
 	resourceUsageClient := resourceusage.NewClient(cfg.K8S.AllClusterNames, querier, log)
-	resolver := graph.NewResolver(hookdClient, teamsBackendClient, k8sClient, dependencyTrackClient, resourceUsageClient, querier, cfg.K8S.Clusters, log)
+	resolver := graph.NewResolver(deploymentsStore, teamsBackendClient, k8sClient, dependencyTrackClient, resourceUsageClient, querier, cfg.K8S.Clusters, log)
 	graphHandler, err := graph.NewHandler(graph.Config{Resolvers: resolver}, meter, log)
 	if err != nil {
 		return fmt.Errorf("create graph handler: %w", err)
@@ -143,6 +161,23 @@ func run(ctx context.Context, cfg *config.Config, log logrus.FieldLogger) error
 		}
 	}()
 
+	// teams cache refresher
+	go teamsBackendClient.Run(ctx)
+
+	// deployments backfill
+	go func() {
+		start := time.Now()
+		saved, err := deploymentsStore.Backfill(ctx)
+		if err != nil {
+			log.WithError(err).WithField("deployments", saved).Errorf("unable to backfill deployments from hookd")
+			return
+		}
+		log.WithFields(logrus.Fields{
+			"deployments": saved,
+			"duration":    time.Since(start),
+		}).Infof("deployments backfill finished")
+	}()
+
 	// resource usage updater
 	go func() {
 		if !cfg.ResourceUtilization.ImportEnabled {
@@ -190,10 +225,94 @@ func run(ctx context.Context, cfg *config.Config, log logrus.FieldLogger) error
 		}
 	}()
 
+	// delivery metrics updater
+	go func() {
+		if !cfg.DeliveryMetrics.ImportEnabled {
+			log.Warningf(`delivery metrics import is not enabled. Enable by setting the "DELIVERY_METRICS_IMPORT_ENABLED" environment variable to "true".`)
+			return
+		}
+
+		if !waitForAppInformers(ctx, k8sClient, log) {
+			return
+		}
+
+		defer cancel()
+		updater := deliverymetrics.NewUpdater(deploymentsStore, k8sClient, teamsBackendClient, querier, log.WithField("subsystem", "delivery_metrics_updater"))
+		err := runDeliveryMetricsUpdater(ctx, updater, log.WithField("task", "delivery_metrics_updater"))
+		if err != nil {
+			log.WithError(err).Errorf("error in delivery metrics updater")
+		}
+	}()
+
+	// deploy key expiry checker
+	go func() {
+		if !cfg.DeployKeys.ExpiryCheckEnabled {
+			log.Warningf(`deploy key expiry check is not enabled. Enable by setting the "DEPLOY_KEY_EXPIRY_CHECK_ENABLED" environment variable to "true".`)
+			return
+		}
+
+		var notifier deploykeys.Notifier
+		if cfg.DeployKeys.SlackWebhookURL != "" {
+			notifier = deploykeys.NewSlackWebhookNotifier(cfg.DeployKeys.SlackWebhookURL)
+		} else {
+			log.Warningf(`deploy key expiry warnings will not be sent. Enable by setting the "DEPLOY_KEY_SLACK_WEBHOOK_URL" environment variable.`)
+		}
+
+		defer cancel()
+		checker := deploykeys.NewChecker(hookdClient, teamsBackendClient, querier, notifier, log.WithField("subsystem", "deploy_key_checker"))
+		err := runDeployKeyChecker(ctx, checker, log.WithField("task", "deploy_key_checker"))
+		if err != nil {
+			log.WithError(err).Errorf("error in deploy key checker")
+		}
+	}()
+
+	// vulnerability indexer
+	go func() {
+		if !cfg.DependencyTrack.IndexEnabled {
+			log.Warningf(`vulnerability index is not enabled. Enable by setting the "DEPENDENCYTRACK_INDEX_ENABLED" environment variable to "true".`)
+			return
+		}
+
+		if !waitForAppInformers(ctx, k8sClient, log) {
+			return
+		}
+
+		defer cancel()
+		indexer := vulnerabilityindex.NewIndexer(dependencyTrackClient, k8sClient, teamsBackendClient, querier, log.WithField("subsystem", "vulnerability_indexer"))
+		err := runVulnerabilityIndexer(ctx, indexer, log.WithField("task", "vulnerability_indexer"))
+		if err != nil {
+			log.WithError(err).Errorf("error in vulnerability indexer")
+		}
+	}()
+
+	// vulnerability snapshotter
+	go func() {
+		if !cfg.DependencyTrack.HistoryEnabled {
+			log.Warningf(`vulnerability history is not enabled. Enable by setting the "DEPENDENCYTRACK_HISTORY_ENABLED" environment variable to "true".`)
+			return
+		}
+
+		if !waitForAppInformers(ctx, k8sClient, log) {
+			return
+		}
+
+		defer cancel()
+		snapshotter := vulnerabilityhistory.NewSnapshotter(dependencyTrackClient, k8sClient, teamsBackendClient, querier, log.WithField("subsystem", "vulnerability_snapshotter"))
+		err := runVulnerabilitySnapshotter(ctx, snapshotter, log.WithField("task", "vulnerability_snapshotter"))
+		if err != nil {
+			log.WithError(err).Errorf("error in vulnerability snapshotter")
+		}
+	}()
+
 	// HTTP server
 	go func() {
 		defer cancel()
-		err = getHttpServer(cfg, graphHandler).ListenAndServe()
+		err = getHttpServer(
+			cfg,
+			graphHandler,
+			deployments.NewHandler(deploymentsStore, log.WithField("handler", "deployments")),
+			export.NewHandler(querier, log.WithField("handler", "export")),
+		).ListenAndServe()
 		if !errors.Is(err, http.ErrServerClosed) {
 			log.WithError(err).Infof("unexpected error from HTTP server")
 		}
@@ -212,8 +331,72 @@ func run(ctx context.Context, cfg *config.Config, log logrus.FieldLogger) error
 	return ctx.Err()
 }
 
+// runCommand will run a one-off command instead of the server. The only command is "cost backfill", which imports the
+// cost data of a date range, for instance after an outage or when onboarding a tenant.
+func runCommand(ctx context.Context, cfg *config.Config, log logrus.FieldLogger, args []string) error {
+	if len(args) < 2 || args[0] != "cost" || args[1] != "backfill" {
+		return fmt.Errorf("unknown command %q, expected \"cost backfill\"", strings.Join(args, " "))
+	}
+
+	flags := flag.NewFlagSet("cost backfill", flag.ContinueOnError)
+	from := flags.String("from", "", "The first day to import, YYYY-MM-DD.")
+	to := flags.String("to", time.Now().Format(time.DateOnly), "The last day to import, YYYY-MM-DD. Defaults to today.")
+	if err := flags.Parse(args[2:]); err != nil {
+		return err
+	}
+
+	fromDate, err := time.Parse(time.DateOnly, *from)
+	if err != nil {
+		return fmt.Errorf("invalid --from date %q, expected YYYY-MM-DD", *from)
+	}
+
+	toDate, err := time.Parse(time.DateOnly, *to)
+	if err != nil {
+		return fmt.Errorf("invalid --to date %q, expected YYYY-MM-DD", *to)
+	}
+
+	ctx, cancel := signal.NotifyContext(ctx, syscall.SIGTERM, syscall.SIGINT)
+	defer cancel()
+
+	log.Info("connecting to database")
+	querier, closer, err := database.NewQuerier(ctx, cfg.DatabaseConnectionString, log.WithField("subsystem", "database"))
+	if err != nil {
+		return fmt.Errorf("setting up database: %w", err)
+	}
+	defer closer()
+
+	updater, err := getUpdater(ctx, querier, cfg.Tenant, cfg.Cost, log)
+	if err != nil {
+		return fmt.Errorf("unable to set up cost updater: %w", err)
+	}
+
+	start := time.Now()
+	if err := updater.Backfill(ctx, fromDate, toDate); err != nil {
+		return err
+	}
+
+	log.WithFields(logrus.Fields{
+		"duration": time.Since(start),
+		"from":     fromDate.Format(time.DateOnly),
+		"to":       toDate.Format(time.DateOnly),
+	}).Infof("cost backfill finished")
+	return nil
+}
+
+// waitForAppInformers blocks until the app informers of all clusters have synced. Returns false if the context is
+// cancelled before then.
+func waitForAppInformers(ctx context.Context, k8sClient *k8s.Client, log logrus.FieldLogger) bool {
+	synced := make([]cache.InformerSynced, 0)
+	for _, informers := range k8sClient.Informers() {
+		synced = append(synced, informers.AppInformer.Informer().HasSynced)
+	}
+
+	log.Infof("waiting for app informers to sync")
+	return cache.WaitForCacheSync(ctx.Done(), synced...)
+}
+
 // getHttpServer will return a new HTTP server with the specified configuration
-func getHttpServer(cfg *config.Config, graphHandler *handler.Server) *http.Server {
+func getHttpServer(cfg *config.Config, graphHandler *handler.Server, deploymentsHandler http.HandlerFunc, exportHandler *export.Handler) *http.Server {
 	router := chi.NewRouter()
 	router.Handle("/metrics", promhttp.Handler())
 	router.Get("/healthz", func(_ http.ResponseWriter, _ *http.Request) {})
@@ -240,6 +423,17 @@ func getHttpServer(cfg *config.Config, graphHandler *handler.Server) *http.Serve
 		r.Post("/", graphHandler.ServeHTTP)
 	})
 
+	router.Route("/export", func(r chi.Router) {
+		r.Use(middlewares...)
+		r.Get("/cost", exportHandler.Cost)
+		r.Get("/utilization", exportHandler.Utilization)
+	})
+
+	router.Route("/internal/deployments", func(r chi.Router) {
+		r.Use(auth.PreSharedKey(cfg.Hookd.DeploymentEventsPSK))
+		r.Post("/", deploymentsHandler)
+	})
+
 	return &http.Server{
 		Addr:    cfg.ListenAddress,
 		Handler: router,
@@ -263,11 +457,19 @@ func getUpdater(ctx context.Context, querier gensql.Querier, tenant string, cfg
 		return nil, err
 	}
 
+	opts := make([]cost.Option, 0)
+	if cfg.BudgetWebhookURL != "" {
+		opts = append(opts, cost.WithNotifier(cost.NewWebhookNotifier(cfg.BudgetWebhookURL)))
+	} else {
+		log.Warningf(`cost budget notifications will not be sent. Enable by setting the "COST_BUDGET_WEBHOOK_URL" environment variable.`)
+	}
+
 	return cost.NewCostUpdater(
 		bigQueryClient,
 		querier,
 		tenant,
 		log.WithField("subsystem", "cost_updater"),
+		opts...,
 	), nil
 }
 
@@ -323,6 +525,20 @@ func runCostUpdater(ctx context.Context, querier gensql.Querier, tenant string,
 				close(ch)
 				<-done
 
+				detected, err := updater.DetectAnomalies(ctx)
+				if err != nil {
+					log.WithError(err).Errorf("failed to detect cost anomalies")
+				} else {
+					log.WithField("anomalies_detected", detected).Infof("cost anomalies detected")
+				}
+
+				notified, err := updater.CheckBudgets(ctx)
+				if err != nil {
+					log.WithError(err).Errorf("failed to check cost budgets")
+				} else {
+					log.WithField("teams_notified", notified).Infof("cost budgets checked")
+				}
+
 				log.WithFields(logrus.Fields{
 					"duration": time.Since(start),
 				}).Infof("cost update run finished")
@@ -359,6 +575,114 @@ func runResourceUsageUpdater(ctx context.Context, updater *resourceusage.Updater
 	}
 }
 
+// runDeliveryMetricsUpdater will update delivery metrics from hookd hourly. This function will block until the context
+// is cancelled, so it should be run in a goroutine.
+func runDeliveryMetricsUpdater(ctx context.Context, updater *deliverymetrics.Updater, log logrus.FieldLogger) error {
+	ticker := time.NewTicker(time.Second) // initial run
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			ticker.Reset(deliveryMetricsUpdateSchedule) // regular schedule
+			start := time.Now()
+			log.Infof("start scheduled delivery metrics update run")
+			rows, err := updater.UpdateDeliveryMetrics(ctx)
+			runLog := log.WithFields(logrus.Fields{
+				"rows_upserted": rows,
+				"duration":      time.Since(start),
+			})
+			if err != nil {
+				runLog = runLog.WithError(err)
+			}
+			runLog.Infof("scheduled delivery metrics update run finished")
+		}
+	}
+}
+
+// runDeployKeyChecker will check the expiry of all deploy keys every six hours. This function will block until the
+// context is cancelled, so it should be run in a goroutine.
+func runDeployKeyChecker(ctx context.Context, checker *deploykeys.Checker, log logrus.FieldLogger) error {
+	ticker := time.NewTicker(time.Second) // initial run
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			ticker.Reset(deployKeyCheckSchedule) // regular schedule
+			start := time.Now()
+			log.Infof("start scheduled deploy key check run")
+			checked, err := checker.CheckDeployKeys(ctx)
+			runLog := log.WithFields(logrus.Fields{
+				"keys_checked": checked,
+				"duration":     time.Since(start),
+			})
+			if err != nil {
+				runLog = runLog.WithError(err)
+			}
+			runLog.Infof("scheduled deploy key check run finished")
+		}
+	}
+}
+
+// runVulnerabilityIndexer will refresh the vulnerability index from DependencyTrack hourly. This function will block
+// until the context is cancelled, so it should be run in a goroutine.
+func runVulnerabilityIndexer(ctx context.Context, indexer *vulnerabilityindex.Indexer, log logrus.FieldLogger) error {
+	ticker := time.NewTicker(time.Second) // initial run
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			ticker.Reset(vulnerabilityIndexSchedule) // regular schedule
+			start := time.Now()
+			log.Infof("start scheduled vulnerability index run")
+			indexed, err := indexer.UpdateIndex(ctx)
+			runLog := log.WithFields(logrus.Fields{
+				"apps_indexed": indexed,
+				"duration":     time.Since(start),
+			})
+			if err != nil {
+				runLog = runLog.WithError(err)
+			}
+			runLog.Infof("scheduled vulnerability index run finished")
+		}
+	}
+}
+
+// runVulnerabilitySnapshotter will refresh today's vulnerability snapshots hourly. This function will block until the
+// context is cancelled, so it should be run in a goroutine.
+func runVulnerabilitySnapshotter(ctx context.Context, snapshotter *vulnerabilityhistory.Snapshotter, log logrus.FieldLogger) error {
+	ticker := time.NewTicker(time.Second) // initial run
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			ticker.Reset(vulnerabilitySnapshotSchedule) // regular schedule
+			start := time.Now()
+			log.Infof("start scheduled vulnerability snapshot run")
+			snapshotted, err := snapshotter.Snapshot(ctx)
+			runLog := log.WithFields(logrus.Fields{
+				"apps_snapshotted": snapshotted,
+				"duration":         time.Since(start),
+			})
+			if err != nil {
+				runLog = runLog.WithError(err)
+			}
+			runLog.Infof("scheduled vulnerability snapshot run finished")
+		}
+	}
+}
+
 // getMetricMeter will return a new metric meter that uses a Prometheus exporter
 func getMetricMeter() (met.Meter, error) {
 	exporter, err := prometheus.New()
@@ -2,6 +2,7 @@ package auth
 
 import (
 	"context"
+	"crypto/subtle"
 	"fmt"
 	"net/http"
 	"strings"
@@ -57,6 +58,20 @@ func ValidateIAPJWT(aud string) Middleware {
 	}
 }
 
+// PreSharedKey returns a middleware that requires the X-PSK header to match the given key
+func PreSharedKey(psk string) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if subtle.ConstantTimeCompare([]byte(r.Header.Get("X-PSK")), []byte(psk)) != 1 {
+				http.Error(w, jsonError("Invalid pre-shared key"), http.StatusUnauthorized)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
 // GetEmail returns the email address of the authenticated user that is stored in the context
 func GetEmail(ctx context.Context) (string, error) {
 	email, ok := ctx.Value(contextEmail).(string)
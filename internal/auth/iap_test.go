@@ -58,6 +58,32 @@ func TestValidateIAPJWT(t *testing.T) {
 	})
 }
 
+func TestPreSharedKey(t *testing.T) {
+	ctx := context.Background()
+	mw := auth.PreSharedKey("psk")
+
+	t.Run("missing key", func(t *testing.T) {
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Fail(t, "should not be executed")
+		})
+		recorder := httptest.NewRecorder()
+		mw(next).ServeHTTP(recorder, getRequest(t, ctx))
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "Invalid pre-shared key")
+	})
+
+	t.Run("valid key", func(t *testing.T) {
+		executed := false
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			executed = true
+		})
+		req := getRequest(t, ctx)
+		req.Header.Set("X-PSK", "psk")
+		mw(next).ServeHTTP(httptest.NewRecorder(), req)
+		assert.True(t, executed)
+	})
+}
+
 func getRequest(t *testing.T, ctx context.Context) *http.Request {
 	t.Helper()
 	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "/", nil)
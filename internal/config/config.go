@@ -3,6 +3,7 @@ package config
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/sethvargo/go-envconfig"
 )
@@ -11,12 +12,38 @@ import (
 type Cost struct {
 	ImportEnabled     bool   `env:"COST_DATA_IMPORT_ENABLED,default=false"`
 	BigQueryProjectID string `env:"BIGQUERY_PROJECTID,default=*detect-project-id*"`
+
+	// BudgetWebhookURL is the webhook used to notify teams about their cost budgets. No notifications will be sent
+	// when empty
+	BudgetWebhookURL string `env:"COST_BUDGET_WEBHOOK_URL"`
+}
+
+// DeliveryMetrics is the configuration for the delivery metrics service
+type DeliveryMetrics struct {
+	ImportEnabled bool `env:"DELIVERY_METRICS_IMPORT_ENABLED,default=false"`
+}
+
+// DeployKeys is the configuration for the deploy key expiry check
+type DeployKeys struct {
+	ExpiryCheckEnabled bool `env:"DEPLOY_KEY_EXPIRY_CHECK_ENABLED,default=false"`
+
+	// SlackWebhookURL is the Slack incoming webhook used to warn teams about expiring deploy keys. No warnings will be
+	// sent when empty
+	SlackWebhookURL string `env:"DEPLOY_KEY_SLACK_WEBHOOK_URL"`
 }
 
 // Hookd is the configuration for the hookd service
 type Hookd struct {
-	Endpoint string `env:"HOOKD_ENDPOINT,default=http://hookd"`
-	PSK      string `env:"HOOKD_PSK,default=secret-frontend-psk"`
+	Endpoint   string        `env:"HOOKD_ENDPOINT,default=http://hookd"`
+	Timeout    time.Duration `env:"HOOKD_TIMEOUT,default=10s"`
+	MaxRetries int           `env:"HOOKD_MAX_RETRIES,default=2"`
+
+	// PSK is used when calling hookd
+	PSK string `env:"HOOKD_PSK,default=secret-frontend-psk"`
+
+	// DeploymentEventsPSK is used to authenticate deployment events sent from hookd. Required, as deployment events
+	// are written to the database.
+	DeploymentEventsPSK string `env:"HOOKD_DEPLOYMENT_EVENTS_PSK"`
 }
 
 // K8S is the configuration related to Kubernetes
@@ -28,10 +55,36 @@ type K8S struct {
 
 // DependencyTrack is the configuration for the dependency track service
 type DependencyTrack struct {
-	Endpoint string `env:"DEPENDENCYTRACK_ENDPOINT,default=http://dependencytrack-backend:8080"`
-	Frontend string `env:"DEPENDENCYTRACK_FRONTEND"`
-	Username string `env:"DEPENDENCYTRACK_USERNAME,default=console"`
-	Password string `env:"DEPENDENCYTRACK_PASSWORD"`
+	Endpoint   string        `env:"DEPENDENCYTRACK_ENDPOINT,default=http://dependencytrack-backend:8080"`
+	Frontend   string        `env:"DEPENDENCYTRACK_FRONTEND"`
+	Username   string        `env:"DEPENDENCYTRACK_USERNAME,default=console"`
+	Password   string        `env:"DEPENDENCYTRACK_PASSWORD"`
+	Timeout    time.Duration `env:"DEPENDENCYTRACK_TIMEOUT,default=30s"`
+	MaxRetries int           `env:"DEPENDENCYTRACK_MAX_RETRIES,default=2"`
+
+	// IndexEnabled enables the periodic refresh of the tenant-wide vulnerability index
+	IndexEnabled bool `env:"DEPENDENCYTRACK_INDEX_ENABLED,default=false"`
+
+	// HistoryEnabled enables the periodic vulnerability snapshots used for vulnerability history
+	HistoryEnabled bool `env:"DEPENDENCYTRACK_HISTORY_ENABLED,default=false"`
+
+	// RiskModel is how findings are scored in risk scores, one of SEVERITY, CVSS and EPSS
+	RiskModel string `env:"DEPENDENCYTRACK_RISK_MODEL,default=SEVERITY"`
+
+	// RiskWeightCritical, RiskWeightHigh and so on are the scores of findings by severity. The defaults are the weights
+	// used by DependencyTrack.
+	RiskWeightCritical   int `env:"DEPENDENCYTRACK_RISK_WEIGHT_CRITICAL,default=10"`
+	RiskWeightHigh       int `env:"DEPENDENCYTRACK_RISK_WEIGHT_HIGH,default=5"`
+	RiskWeightMedium     int `env:"DEPENDENCYTRACK_RISK_WEIGHT_MEDIUM,default=3"`
+	RiskWeightLow        int `env:"DEPENDENCYTRACK_RISK_WEIGHT_LOW,default=1"`
+	RiskWeightUnassigned int `env:"DEPENDENCYTRACK_RISK_WEIGHT_UNASSIGNED,default=5"`
+
+	// RiskGracePeriod leaves findings first seen more than the grace period ago out of vulnerability counts and risk
+	// scores. 0 means findings of any age are included.
+	RiskGracePeriod time.Duration `env:"DEPENDENCYTRACK_RISK_GRACE_PERIOD,default=0s"`
+
+	// RiskExcludeUnfixed leaves findings without a known fixed version out of vulnerability counts and risk scores
+	RiskExcludeUnfixed bool `env:"DEPENDENCYTRACK_RISK_EXCLUDE_UNFIXED,default=false"`
 }
 
 // Logger is the configuration for the logger
@@ -47,13 +100,17 @@ type ResourceUtilization struct {
 
 // Teams is the configuration for the teams backend service
 type Teams struct {
-	Endpoint string `env:"TEAMS_ENDPOINT,default=http://teams-backend/query"`
-	Token    string `env:"TEAMS_TOKEN,default=secret-admin-api-key"`
+	Endpoint   string        `env:"TEAMS_ENDPOINT,default=http://teams-backend/query"`
+	Token      string        `env:"TEAMS_TOKEN,default=secret-admin-api-key"`
+	Timeout    time.Duration `env:"TEAMS_TIMEOUT,default=10s"`
+	MaxRetries int           `env:"TEAMS_MAX_RETRIES,default=2"`
 }
 
 // Config is the configuration for the console-backend application
 type Config struct {
 	Cost                Cost
+	DeliveryMetrics     DeliveryMetrics
+	DeployKeys          DeployKeys
 	Hookd               Hookd
 	K8S                 K8S
 	Logger              Logger
@@ -88,6 +145,16 @@ func New(ctx context.Context, lookuper envconfig.Lookuper) (*Config, error) {
 		return nil, fmt.Errorf("either RUN_AS_USER or IAP_AUDIENCE must be set")
 	}
 
+	if cfg.Hookd.DeploymentEventsPSK == "" {
+		return nil, fmt.Errorf("HOOKD_DEPLOYMENT_EVENTS_PSK must be set")
+	}
+
+	switch cfg.DependencyTrack.RiskModel {
+	case "SEVERITY", "CVSS", "EPSS":
+	default:
+		return nil, fmt.Errorf("invalid DEPENDENCYTRACK_RISK_MODEL %q, must be one of SEVERITY, CVSS and EPSS", cfg.DependencyTrack.RiskModel)
+	}
+
 	clusterNames := cfg.K8S.Clusters
 	for _, staticCluster := range cfg.K8S.StaticClusters {
 		clusterNames = append(clusterNames, staticCluster.Name)
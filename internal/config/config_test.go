@@ -26,15 +26,35 @@ func TestNew(t *testing.T) {
 		assert.ErrorContains(t, err, `invalid static cluster entry: "foobar"`)
 	})
 
-	t.Run("process config", func(t *testing.T) {
+	t.Run("missing deployment events psk", func(t *testing.T) {
 		cfg, err := config.New(ctx, envconfig.MapLookuper(map[string]string{
 			"RUN_AS_USER": "some-user",
 		}))
+		assert.Nil(t, cfg)
+		assert.ErrorContains(t, err, "HOOKD_DEPLOYMENT_EVENTS_PSK must be set")
+	})
+
+	t.Run("invalid risk model", func(t *testing.T) {
+		cfg, err := config.New(ctx, envconfig.MapLookuper(map[string]string{
+			"RUN_AS_USER":                 "some-user",
+			"HOOKD_DEPLOYMENT_EVENTS_PSK": "events-psk",
+			"DEPENDENCYTRACK_RISK_MODEL":  "foobar",
+		}))
+		assert.Nil(t, cfg)
+		assert.ErrorContains(t, err, `invalid DEPENDENCYTRACK_RISK_MODEL "foobar"`)
+	})
+
+	t.Run("process config", func(t *testing.T) {
+		cfg, err := config.New(ctx, envconfig.MapLookuper(map[string]string{
+			"RUN_AS_USER":                 "some-user",
+			"HOOKD_DEPLOYMENT_EVENTS_PSK": "events-psk",
+		}))
 		assert.NoError(t, err)
 		assert.Equal(t, bigquery.DetectProjectID, cfg.Cost.BigQueryProjectID)
 
 		assert.Equal(t, "http://hookd", cfg.Hookd.Endpoint)
 		assert.Equal(t, "secret-frontend-psk", cfg.Hookd.PSK)
+		assert.Equal(t, "events-psk", cfg.Hookd.DeploymentEventsPSK)
 
 		assert.Empty(t, cfg.K8S.Clusters)
 		assert.Empty(t, cfg.K8S.StaticClusters)
@@ -54,9 +74,10 @@ func TestNew(t *testing.T) {
 
 	t.Run("all cluster names", func(t *testing.T) {
 		cfg, err := config.New(ctx, envconfig.MapLookuper(map[string]string{
-			"RUN_AS_USER":                "some-user",
-			"KUBERNETES_CLUSTERS":        "cluster1,cluster2",
-			"KUBERNETES_CLUSTERS_STATIC": "cluster3|host3|token3,cluster4|host4|token4",
+			"RUN_AS_USER":                 "some-user",
+			"HOOKD_DEPLOYMENT_EVENTS_PSK": "events-psk",
+			"KUBERNETES_CLUSTERS":         "cluster1,cluster2",
+			"KUBERNETES_CLUSTERS_STATIC":  "cluster3|host3|token3,cluster4|host4|token4",
 		}))
 		assert.NoError(t, err)
 		assert.Equal(t, []string{
@@ -0,0 +1,135 @@
+package cost
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nais/console-backend/internal/database/gensql"
+)
+
+const (
+	// AnomalyBaselineDays is the number of days before the day being checked that the baseline of a series is computed
+	// from
+	AnomalyBaselineDays = 14
+
+	// anomalyMinimumDays is the number of days with recorded cost a series needs in the baseline to be checked, so
+	// that new series are not flagged
+	anomalyMinimumDays = 7
+
+	// anomalyDeviations is the number of standard deviations above the baseline the cost of a day must be
+	anomalyDeviations = 3.0
+
+	// anomalyMinimumRatio is the minimum ratio between the cost of a day and the baseline, so that small fluctuations
+	// of stable series are not flagged
+	anomalyMinimumRatio = 1.5
+
+	// anomalyMinimumIncrease is the minimum increase from the baseline in euros, so that cheap series are not flagged
+	anomalyMinimumIncrease = 1.0
+)
+
+// Anomaly is the cost of a day that deviates significantly from the baseline of the series
+type Anomaly struct {
+	Cost     float64
+	Baseline float64
+}
+
+// DetectAnomaly checks if the cost of a day deviates significantly from the baseline of the series, the mean daily cost
+// of the AnomalyBaselineDays days before. Days without recorded cost count as 0. Only increases are flagged, as the cost
+// of the last day is often incomplete when imported. The series must be in ascending order.
+func DetectAnomaly(series []DailyCost, day time.Time) (Anomaly, bool) {
+	from := day.AddDate(0, 0, -AnomalyBaselineDays)
+
+	cost := 0.0
+	sum := 0.0
+	days := 0
+	baseline := make([]float64, 0, AnomalyBaselineDays)
+	for _, c := range series {
+		switch {
+		case c.Date.Equal(day):
+			cost = c.Cost
+		case !c.Date.Before(from) && c.Date.Before(day):
+			baseline = append(baseline, c.Cost)
+			sum += c.Cost
+			days++
+		}
+	}
+
+	if days < anomalyMinimumDays {
+		return Anomaly{}, false
+	}
+
+	mean := sum / AnomalyBaselineDays
+	variance := float64(AnomalyBaselineDays-days) * mean * mean
+	for _, c := range baseline {
+		variance += (c - mean) * (c - mean)
+	}
+	stddev := math.Sqrt(variance / (AnomalyBaselineDays - 1))
+
+	if cost-mean < anomalyMinimumIncrease || cost < mean*anomalyMinimumRatio || cost <= mean+anomalyDeviations*stddev {
+		return Anomaly{}, false
+	}
+	return Anomaly{Cost: cost, Baseline: mean}, true
+}
+
+// DetectAnomalies checks the cost series of each team, app, environment and cost type for anomalies on the last day
+// with recorded cost, and stores them. Returns the number of anomalies found.
+func (c *Updater) DetectAnomalies(ctx context.Context) (detected int, err error) {
+	last, err := c.querier.LastCostDate(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("unable to get last cost date: %w", err)
+	}
+	if !last.Valid {
+		return 0, nil
+	}
+
+	rows, err := c.querier.CostSeries(ctx, gensql.CostSeriesParams{
+		FromDate: pgtype.Date{Time: last.Time.AddDate(0, 0, -AnomalyBaselineDays), Valid: true},
+		ToDate:   last,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("unable to get cost series: %w", err)
+	}
+
+	for start := 0; start < len(rows); {
+		end := start + 1
+		for end < len(rows) && sameSeries(rows[start], rows[end]) {
+			end++
+		}
+
+		series := make([]DailyCost, 0, end-start)
+		for _, row := range rows[start:end] {
+			series = append(series, DailyCost{Date: row.Date.Time, Cost: float64(row.DailyCost)})
+		}
+
+		row := rows[start]
+		start = end
+
+		anomaly, found := DetectAnomaly(series, last.Time)
+		if !found {
+			continue
+		}
+
+		err := c.querier.CostAnomalyUpsert(ctx, gensql.CostAnomalyUpsertParams{
+			Team:     row.Team,
+			Env:      row.Env,
+			App:      row.App,
+			CostType: row.CostType,
+			Date:     last,
+			Cost:     float32(anomaly.Cost),
+			Baseline: float32(anomaly.Baseline),
+		})
+		if err != nil {
+			return detected, fmt.Errorf("unable to store cost anomaly: %w", err)
+		}
+		detected++
+	}
+
+	return detected, nil
+}
+
+func sameSeries(a, b *gensql.CostSeriesRow) bool {
+	return a.Team == b.Team && a.Env == b.Env && a.App == b.App && a.CostType == b.CostType
+}
@@ -0,0 +1,141 @@
+package cost_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nais/console-backend/internal/cost"
+	"github.com/nais/console-backend/internal/database/gensql"
+	logrustest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/option"
+)
+
+func TestDetectAnomaly(t *testing.T) {
+	day := time.Date(2023, time.October, 15, 0, 0, 0, 0, time.UTC)
+
+	// withDay returns a series of the 14 days before day, followed by the cost of day
+	withDay := func(baseline func(day int) float64, dayCost float64) []cost.DailyCost {
+		return append(series(day.AddDate(0, 0, -1), cost.AnomalyBaselineDays, baseline), cost.DailyCost{Date: day, Cost: dayCost})
+	}
+
+	t.Run("stable cost", func(t *testing.T) {
+		_, found := cost.DetectAnomaly(withDay(func(int) float64 { return 10 }, 10.5), day)
+		assert.False(t, found)
+	})
+
+	t.Run("spike", func(t *testing.T) {
+		anomaly, found := cost.DetectAnomaly(withDay(func(int) float64 { return 10 }, 40), day)
+		assert.True(t, found)
+		assert.Equal(t, cost.Anomaly{Cost: 40, Baseline: 10}, anomaly)
+	})
+
+	t.Run("drop is not flagged", func(t *testing.T) {
+		_, found := cost.DetectAnomaly(withDay(func(int) float64 { return 10 }, 0), day)
+		assert.False(t, found)
+	})
+
+	t.Run("spike within the variation of the series", func(t *testing.T) {
+		_, found := cost.DetectAnomaly(withDay(func(day int) float64 {
+			if day%2 == 0 {
+				return 2
+			}
+			return 20
+		}, 25), day)
+		assert.False(t, found)
+	})
+
+	t.Run("small increase of cheap series", func(t *testing.T) {
+		_, found := cost.DetectAnomaly(withDay(func(int) float64 { return 0.1 }, 0.9), day)
+		assert.False(t, found)
+	})
+
+	t.Run("new series", func(t *testing.T) {
+		s := append(series(day.AddDate(0, 0, -1), 3, func(int) float64 { return 1 }), cost.DailyCost{Date: day, Cost: 100})
+		_, found := cost.DetectAnomaly(s, day)
+		assert.False(t, found)
+	})
+
+	t.Run("no cost on the day", func(t *testing.T) {
+		_, found := cost.DetectAnomaly(series(day.AddDate(0, 0, -1), cost.AnomalyBaselineDays, func(int) float64 { return 10 }), day)
+		assert.False(t, found)
+	})
+}
+
+func TestUpdater_DetectAnomalies(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := logrustest.NewNullLogger()
+	bigQueryClient, err := bigquery.NewClient(ctx, projectID, option.WithoutAuthentication())
+	assert.NoError(t, err)
+
+	last := time.Date(2023, time.October, 15, 0, 0, 0, 0, time.UTC)
+
+	rows := func(team, app, costType string, costFn func(day int) float64) []*gensql.CostSeriesRow {
+		ret := make([]*gensql.CostSeriesRow, 0)
+		for _, c := range series(last, cost.AnomalyBaselineDays+1, costFn) {
+			ret = append(ret, &gensql.CostSeriesRow{
+				Team:      team,
+				Env:       "prod",
+				App:       app,
+				CostType:  costType,
+				Date:      date(c.Date),
+				DailyCost: float32(c.Cost),
+			})
+		}
+		return ret
+	}
+	spike := func(day int) float64 {
+		if day == cost.AnomalyBaselineDays {
+			return 100
+		}
+		return 10
+	}
+
+	t.Run("no recorded cost", func(t *testing.T) {
+		querier := gensql.NewMockQuerier(t)
+		querier.EXPECT().LastCostDate(ctx).Return(pgtype.Date{}, nil)
+
+		detected, err := cost.NewCostUpdater(bigQueryClient, querier, tenant, logger).DetectAnomalies(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, detected)
+	})
+
+	t.Run("anomalies are stored", func(t *testing.T) {
+		series := make([]*gensql.CostSeriesRow, 0)
+		series = append(series, rows("team-a", "app-a", "Cloud SQL", func(int) float64 { return 10 })...)
+		series = append(series, rows("team-a", "app-a", "Compute Engine", spike)...)
+		series = append(series, rows("team-b", "app-b", "Compute Engine", spike)...)
+
+		querier := gensql.NewMockQuerier(t)
+		querier.EXPECT().LastCostDate(ctx).Return(date(last), nil)
+		querier.EXPECT().CostSeries(ctx, gensql.CostSeriesParams{
+			FromDate: date(last.AddDate(0, 0, -cost.AnomalyBaselineDays)),
+			ToDate:   date(last),
+		}).Return(series, nil)
+		querier.EXPECT().CostAnomalyUpsert(ctx, gensql.CostAnomalyUpsertParams{
+			Team:     "team-a",
+			Env:      "prod",
+			App:      "app-a",
+			CostType: "Compute Engine",
+			Date:     date(last),
+			Cost:     100,
+			Baseline: 10,
+		}).Return(nil)
+		querier.EXPECT().CostAnomalyUpsert(ctx, gensql.CostAnomalyUpsertParams{
+			Team:     "team-b",
+			Env:      "prod",
+			App:      "app-b",
+			CostType: "Compute Engine",
+			Date:     date(last),
+			Cost:     100,
+			Baseline: 10,
+		}).Return(nil)
+
+		detected, err := cost.NewCostUpdater(bigQueryClient, querier, tenant, logger).DetectAnomalies(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, detected)
+	})
+}
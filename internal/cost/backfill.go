@@ -0,0 +1,94 @@
+package cost
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nais/console-backend/internal/database/gensql"
+	"github.com/sirupsen/logrus"
+)
+
+// DateRange is a range of days, from and to inclusive
+type DateRange struct {
+	From time.Time
+	To   time.Time
+}
+
+// MonthlyChunks splits a date range into chunks of at most a calendar month. The first and last chunks are partial
+// months if the range does not start on the first, or end on the last, day of a month.
+func MonthlyChunks(from, to time.Time) []DateRange {
+	ret := make([]DateRange, 0)
+	for start := from; !start.After(to); {
+		end := time.Date(start.Year(), start.Month()+1, 1, 0, 0, 0, 0, start.Location()).AddDate(0, 0, -1)
+		if end.After(to) {
+			end = to
+		}
+		ret = append(ret, DateRange{From: start, To: end})
+		start = end.AddDate(0, 0, 1)
+	}
+	return ret
+}
+
+// Backfill imports the cost data of a date range from BigQuery, from and to inclusive, one month at a time. Existing
+// cost is overwritten, so a backfill can safely be run again, for instance after a failure. Months that fail are
+// skipped, and reported in the returned error once all months have been attempted.
+func (c *Updater) Backfill(ctx context.Context, from, to time.Time) error {
+	if from.After(to) {
+		return fmt.Errorf("from date cannot be after to date")
+	}
+
+	chunks := MonthlyChunks(from, to)
+	failed := make([]string, 0)
+
+	for i, chunk := range chunks {
+		start := time.Now()
+		log := c.log.WithFields(logrus.Fields{
+			"chunk": fmt.Sprintf("%d/%d", i+1, len(chunks)),
+			"from":  chunk.From.Format(YYYYMMDD),
+			"to":    chunk.To.Format(YYYYMMDD),
+		})
+		log.Infof("backfilling cost")
+
+		if err := c.backfillChunk(ctx, chunk); err != nil {
+			if ctx.Err() != nil {
+				return fmt.Errorf("backfill cancelled after %d of %d months: %w", i, len(chunks), ctx.Err())
+			}
+
+			log.WithError(err).Errorf("unable to backfill cost")
+			failed = append(failed, chunk.From.Format(YYYYMMDD)+" - "+chunk.To.Format(YYYYMMDD))
+			continue
+		}
+
+		log.WithField("duration", time.Since(start)).Infof("backfilled cost")
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("unable to backfill %d of %d months: %s", len(failed), len(chunks), strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// backfillChunk fetches and upserts the cost data of a single chunk
+func (c *Updater) backfillChunk(ctx context.Context, chunk DateRange) error {
+	ch := make(chan gensql.CostUpsertParams, c.upsertBatchSize*2)
+	done := make(chan error)
+
+	go func() {
+		done <- c.UpdateCosts(ctx, ch)
+	}()
+
+	fetchErr := c.FetchBigQueryDataForRange(ctx, ch, chunk.From, chunk.To)
+	close(ch)
+	updateErr := <-done
+
+	if fetchErr != nil {
+		return fmt.Errorf("fetching data from BigQuery: %w", fetchErr)
+	}
+	if updateErr != nil {
+		return fmt.Errorf("updating costs: %w", updateErr)
+	}
+
+	return nil
+}
@@ -0,0 +1,190 @@
+package cost_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/nais/console-backend/internal/cost"
+	"github.com/nais/console-backend/internal/database/gensql"
+	httptest "github.com/nais/console-backend/internal/test"
+	logrustest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/api/option"
+)
+
+// fakeBatchDB executes all queued queries of a batch, successfully unless err is set
+type fakeBatchDB struct {
+	gensql.DBTX
+	err error
+}
+
+func (db fakeBatchDB) SendBatch(context.Context, *pgx.Batch) pgx.BatchResults {
+	return fakeBatchResults{err: db.err}
+}
+
+type fakeBatchResults struct {
+	pgx.BatchResults
+	err error
+}
+
+func (r fakeBatchResults) Exec() (pgconn.CommandTag, error) {
+	if r.err != nil {
+		return pgconn.CommandTag{}, r.err
+	}
+	return pgconn.NewCommandTag("INSERT 0 1"), nil
+}
+
+func (fakeBatchResults) Close() error {
+	return nil
+}
+
+// bigQueryRows responds to a BigQuery query with a single row of cost data for each of the given dates
+func bigQueryRows(t *testing.T, dates ...string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		assert.True(t, strings.HasSuffix(r.URL.Path, "/queries"))
+
+		rows := make([]map[string]any, 0, len(dates))
+		for _, date := range dates {
+			rows = append(rows, map[string]any{"f": []map[string]any{
+				{"v": "dev"}, {"v": "team-a"}, {"v": "app-a"}, {"v": "Cloud SQL"}, {"v": date}, {"v": "1.5"},
+			}})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"jobComplete":  true,
+			"jobReference": map[string]any{"projectId": projectID, "jobId": "job"},
+			"schema": map[string]any{"fields": []map[string]any{
+				{"name": "env", "type": "STRING"},
+				{"name": "team", "type": "STRING"},
+				{"name": "app", "type": "STRING"},
+				{"name": "cost_type", "type": "STRING"},
+				{"name": "date", "type": "DATE"},
+				{"name": "cost", "type": "FLOAT"},
+			}},
+			"rows":      rows,
+			"totalRows": fmt.Sprint(len(rows)),
+		})
+	}
+}
+
+// bigQueryError responds to a BigQuery query with an error that is not retried
+func bigQueryError(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(map[string]any{"error": map[string]any{
+		"code":    http.StatusBadRequest,
+		"message": "some error from BigQuery",
+		"errors":  []map[string]any{{"reason": "invalidQuery", "message": "some error from BigQuery"}},
+	}})
+}
+
+func TestMonthlyChunks(t *testing.T) {
+	day := func(month time.Month, day int) time.Time {
+		return time.Date(2023, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	t.Run("single day", func(t *testing.T) {
+		assert.Equal(t, []cost.DateRange{
+			{From: day(time.March, 15), To: day(time.March, 15)},
+		}, cost.MonthlyChunks(day(time.March, 15), day(time.March, 15)))
+	})
+
+	t.Run("partial months", func(t *testing.T) {
+		assert.Equal(t, []cost.DateRange{
+			{From: day(time.January, 20), To: day(time.January, 31)},
+			{From: day(time.February, 1), To: day(time.February, 28)},
+			{From: day(time.March, 1), To: day(time.March, 10)},
+		}, cost.MonthlyChunks(day(time.January, 20), day(time.March, 10)))
+	})
+
+	t.Run("whole months", func(t *testing.T) {
+		assert.Equal(t, []cost.DateRange{
+			{From: day(time.November, 1), To: day(time.November, 30)},
+			{From: day(time.December, 1), To: day(time.December, 31)},
+		}, cost.MonthlyChunks(day(time.November, 1), day(time.December, 31)))
+	})
+
+	t.Run("from after to", func(t *testing.T) {
+		assert.Empty(t, cost.MonthlyChunks(day(time.March, 2), day(time.March, 1)))
+	})
+}
+
+func TestUpdater_Backfill(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := logrustest.NewNullLogger()
+	bigQueryClient, err := bigquery.NewClient(ctx, projectID, option.WithoutAuthentication())
+	assert.NoError(t, err)
+
+	t.Run("from after to", func(t *testing.T) {
+		err := cost.
+			NewCostUpdater(bigQueryClient, gensql.NewMockQuerier(t), tenant, logger).
+			Backfill(ctx, time.Date(2023, time.March, 2, 0, 0, 0, 0, time.UTC), time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC))
+		assert.EqualError(t, err, "from date cannot be after to date")
+	})
+
+	t.Run("failed month does not stop the backfill", func(t *testing.T) {
+		server := httptest.NewHttpServerWithHandlers(t, []http.HandlerFunc{
+			bigQueryRows(t, "2023-01-01", "2023-01-31"),
+			bigQueryError,
+			bigQueryRows(t, "2023-03-01"),
+		})
+		defer server.Close()
+
+		bigQueryClient, err := bigquery.NewClient(ctx, projectID, option.WithEndpoint(server.URL), option.WithoutAuthentication())
+		assert.NoError(t, err)
+
+		imported := make([]string, 0)
+		querier := gensql.NewMockQuerier(t)
+		querier.EXPECT().
+			CostUpsert(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, batch []gensql.CostUpsertParams) *gensql.CostUpsertBatchResults {
+				for _, row := range batch {
+					imported = append(imported, row.Date.Time.Format(YYYYMMDD))
+				}
+				return gensql.New(fakeBatchDB{}).CostUpsert(ctx, batch)
+			})
+
+		err = cost.
+			NewCostUpdater(bigQueryClient, querier, tenant, logger).
+			Backfill(ctx, time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, time.March, 31, 0, 0, 0, 0, time.UTC))
+		assert.EqualError(t, err, "unable to backfill 1 of 3 months: 2023-02-01 - 2023-02-28")
+		assert.Equal(t, []string{"2023-01-01", "2023-01-31", "2023-03-01"}, imported)
+	})
+
+	t.Run("failed upserts fail the month", func(t *testing.T) {
+		server := httptest.NewHttpServerWithHandlers(t, []http.HandlerFunc{
+			bigQueryRows(t, "2023-01-01"),
+			bigQueryRows(t, "2023-02-01", "2023-02-28"),
+		})
+		defer server.Close()
+
+		bigQueryClient, err := bigquery.NewClient(ctx, projectID, option.WithEndpoint(server.URL), option.WithoutAuthentication())
+		assert.NoError(t, err)
+
+		querier := gensql.NewMockQuerier(t)
+		querier.EXPECT().
+			CostUpsert(mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, batch []gensql.CostUpsertParams) *gensql.CostUpsertBatchResults {
+				db := fakeBatchDB{}
+				if batch[0].Date.Time.Month() == time.February {
+					db.err = assert.AnError
+				}
+				return gensql.New(db).CostUpsert(ctx, batch)
+			})
+
+		err = cost.
+			NewCostUpdater(bigQueryClient, querier, tenant, logger).
+			Backfill(ctx, time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, time.February, 28, 0, 0, 0, 0, time.UTC))
+		assert.EqualError(t, err, "unable to backfill 1 of 2 months: 2023-02-01 - 2023-02-28")
+	})
+}
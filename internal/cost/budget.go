@@ -0,0 +1,118 @@
+package cost
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nais/console-backend/internal/database/gensql"
+	"github.com/sirupsen/logrus"
+)
+
+// BudgetThresholds are the shares of a monthly budget, in percent, that teams are notified about
+var BudgetThresholds = []int{50, 80, 100}
+
+// ExceededThreshold returns the highest threshold of the budget that has been reached by the spend, or 0 if none
+func ExceededThreshold(budget, spend float64) int {
+	ret := 0
+	for _, threshold := range BudgetThresholds {
+		if spend >= budget*float64(threshold)/100 {
+			ret = threshold
+		}
+	}
+	return ret
+}
+
+// CheckBudgets evaluates the monthly cost budget of each team against the cost of the month of the last imported
+// cost, and notifies teams the first time a threshold is reached each month. Nothing is checked if the updater has no
+// notifier. Returns the number of teams notified.
+func (c *Updater) CheckBudgets(ctx context.Context) (notified int, err error) {
+	if c.notifier == nil {
+		return 0, nil
+	}
+
+	last, err := c.querier.LastCostDate(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("unable to get last cost date: %w", err)
+	}
+	if !last.Valid {
+		return 0, nil
+	}
+
+	budgets, err := c.querier.CostBudgets(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("unable to get budgets: %w", err)
+	}
+	if len(budgets) == 0 {
+		return 0, nil
+	}
+
+	teams := make([]string, 0, len(budgets))
+	for _, b := range budgets {
+		teams = append(teams, b.Team)
+	}
+
+	month := time.Date(last.Time.Year(), last.Time.Month(), 1, 0, 0, 0, 0, time.UTC)
+	rows, err := c.querier.CostForTeams(ctx, gensql.CostForTeamsParams{
+		FromDate: pgtype.Date{Time: month, Valid: true},
+		ToDate:   last,
+		Teams:    teams,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("unable to get cost for teams: %w", err)
+	}
+
+	spend := make(map[string]float64, len(rows))
+	for _, row := range rows {
+		if row.Team != nil {
+			spend[*row.Team] = float64(row.DailyCost)
+		}
+	}
+
+	for _, b := range budgets {
+		log := c.log.WithField("team", b.Team)
+		threshold := ExceededThreshold(float64(b.Amount), spend[b.Team])
+		if threshold == 0 || alreadyNotified(b, month, threshold) {
+			continue
+		}
+
+		err := c.notifier.NotifyBudget(ctx, BudgetAlert{
+			Team:      b.Team,
+			Month:     month,
+			Budget:    float64(b.Amount),
+			Spend:     spend[b.Team],
+			Threshold: threshold,
+		})
+		if err != nil {
+			log.WithError(err).Errorf("unable to notify team about budget")
+			continue
+		}
+
+		t := int32(threshold)
+		err = c.querier.CostBudgetNotified(ctx, gensql.CostBudgetNotifiedParams{
+			Team:              b.Team,
+			NotifiedMonth:     pgtype.Date{Time: month, Valid: true},
+			NotifiedThreshold: &t,
+		})
+		if err != nil {
+			return notified, fmt.Errorf("unable to store budget notification: %w", err)
+		}
+
+		log.WithFields(logrus.Fields{
+			"threshold": threshold,
+			"spend":     spend[b.Team],
+		}).Infof("notified team about budget")
+		notified++
+	}
+
+	return notified, nil
+}
+
+// alreadyNotified returns true if the team has been notified about the threshold, or a higher one, in the month
+func alreadyNotified(b *gensql.CostBudget, month time.Time, threshold int) bool {
+	return b.NotifiedMonth.Valid &&
+		b.NotifiedMonth.Time.Equal(month) &&
+		b.NotifiedThreshold != nil &&
+		int(*b.NotifiedThreshold) >= threshold
+}
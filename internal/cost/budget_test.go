@@ -0,0 +1,127 @@
+package cost_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nais/console-backend/internal/cost"
+	"github.com/nais/console-backend/internal/database/gensql"
+	logrustest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/option"
+)
+
+type fakeNotifier struct {
+	alerts []cost.BudgetAlert
+	err    error
+}
+
+func (n *fakeNotifier) NotifyBudget(_ context.Context, alert cost.BudgetAlert) error {
+	if n.err != nil {
+		return n.err
+	}
+	n.alerts = append(n.alerts, alert)
+	return nil
+}
+
+func TestExceededThreshold(t *testing.T) {
+	assert.Equal(t, 0, cost.ExceededThreshold(100, 0))
+	assert.Equal(t, 0, cost.ExceededThreshold(100, 49.99))
+	assert.Equal(t, 50, cost.ExceededThreshold(100, 50))
+	assert.Equal(t, 80, cost.ExceededThreshold(100, 99))
+	assert.Equal(t, 100, cost.ExceededThreshold(100, 100))
+	assert.Equal(t, 100, cost.ExceededThreshold(100, 250))
+}
+
+func TestUpdater_CheckBudgets(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := logrustest.NewNullLogger()
+	bigQueryClient, err := bigquery.NewClient(ctx, projectID, option.WithoutAuthentication())
+	assert.NoError(t, err)
+
+	last := time.Date(2023, time.October, 15, 0, 0, 0, 0, time.UTC)
+	month := time.Date(2023, time.October, 1, 0, 0, 0, 0, time.UTC)
+	teamA, teamB, teamC := "team-a", "team-b", "team-c"
+
+	threshold := func(t int32) *int32 { return &t }
+
+	t.Run("no notifier", func(t *testing.T) {
+		querier := gensql.NewMockQuerier(t)
+
+		notified, err := cost.NewCostUpdater(bigQueryClient, querier, tenant, logger).CheckBudgets(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, notified)
+	})
+
+	t.Run("no recorded cost", func(t *testing.T) {
+		querier := gensql.NewMockQuerier(t)
+		querier.EXPECT().LastCostDate(ctx).Return(pgtype.Date{}, nil)
+
+		notifier := &fakeNotifier{}
+		notified, err := cost.NewCostUpdater(bigQueryClient, querier, tenant, logger, cost.WithNotifier(notifier)).CheckBudgets(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, notified)
+		assert.Empty(t, notifier.alerts)
+	})
+
+	t.Run("teams are notified once per threshold and month", func(t *testing.T) {
+		querier := gensql.NewMockQuerier(t)
+		querier.EXPECT().LastCostDate(ctx).Return(date(last), nil)
+		querier.EXPECT().CostBudgets(ctx).Return([]*gensql.CostBudget{
+			// reached 80%, already notified about 50%
+			{Team: teamA, Amount: 100, NotifiedMonth: date(month), NotifiedThreshold: threshold(50)},
+			// reached 50%, already notified
+			{Team: teamB, Amount: 100, NotifiedMonth: date(month), NotifiedThreshold: threshold(50)},
+			// reached 100%, notified about 100% last month
+			{Team: teamC, Amount: 10, NotifiedMonth: date(month.AddDate(0, -1, 0)), NotifiedThreshold: threshold(100)},
+		}, nil)
+		querier.EXPECT().CostForTeams(ctx, gensql.CostForTeamsParams{
+			FromDate: date(month),
+			ToDate:   date(last),
+			Teams:    []string{teamA, teamB, teamC},
+		}).Return([]*gensql.CostForTeamsRow{
+			{Team: &teamA, DailyCost: 85},
+			{Team: &teamB, DailyCost: 60},
+			{Team: &teamC, DailyCost: 12},
+		}, nil)
+		querier.EXPECT().CostBudgetNotified(ctx, gensql.CostBudgetNotifiedParams{
+			Team:              teamA,
+			NotifiedMonth:     date(month),
+			NotifiedThreshold: threshold(80),
+		}).Return(nil)
+		querier.EXPECT().CostBudgetNotified(ctx, gensql.CostBudgetNotifiedParams{
+			Team:              teamC,
+			NotifiedMonth:     date(month),
+			NotifiedThreshold: threshold(100),
+		}).Return(nil)
+
+		notifier := &fakeNotifier{}
+		notified, err := cost.NewCostUpdater(bigQueryClient, querier, tenant, logger, cost.WithNotifier(notifier)).CheckBudgets(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, notified)
+		assert.Equal(t, []cost.BudgetAlert{
+			{Team: teamA, Month: month, Budget: 100, Spend: 85, Threshold: 80},
+			{Team: teamC, Month: month, Budget: 10, Spend: 12, Threshold: 100},
+		}, notifier.alerts)
+	})
+
+	t.Run("failed notifications are not recorded", func(t *testing.T) {
+		querier := gensql.NewMockQuerier(t)
+		querier.EXPECT().LastCostDate(ctx).Return(date(last), nil)
+		querier.EXPECT().CostBudgets(ctx).Return([]*gensql.CostBudget{{Team: teamA, Amount: 100}}, nil)
+		querier.EXPECT().CostForTeams(ctx, gensql.CostForTeamsParams{
+			FromDate: date(month),
+			ToDate:   date(last),
+			Teams:    []string{teamA},
+		}).Return([]*gensql.CostForTeamsRow{{Team: &teamA, DailyCost: 50}}, nil)
+
+		notifier := &fakeNotifier{err: fmt.Errorf("webhook is down")}
+		notified, err := cost.NewCostUpdater(bigQueryClient, querier, tenant, logger, cost.WithNotifier(notifier)).CheckBudgets(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, notified)
+	})
+}
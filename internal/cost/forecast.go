@@ -0,0 +1,215 @@
+package cost
+
+import (
+	"math"
+	"slices"
+	"time"
+)
+
+const (
+	// ForecastDays is the number of days of daily cost the trend of each cost type is fitted to
+	ForecastDays = 30
+
+	// forecastHalfLife is the age, in days, where the weight of a daily cost in the trend is halved
+	forecastHalfLife = 14.0
+
+	// confidenceZ is the z-score of the confidence bounds of forecasts, 1.96 for a 95% confidence interval
+	confidenceZ = 1.96
+)
+
+// DailyCost is the cost of a single day
+type DailyCost struct {
+	Date time.Time
+	Cost float64
+}
+
+// Trend is a linear trend of daily cost, fitted with a weighted least squares regression where recent days weigh more
+// than older days
+type Trend struct {
+	origin    time.Time
+	intercept float64
+	slope     float64
+
+	// variance is the variance of the residuals of a day with weight 1
+	variance float64
+
+	// sumWeights, meanX and sxx are needed to compute the variance of predictions
+	sumWeights float64
+	meanX      float64
+	sxx        float64
+}
+
+// FitTrend fits a trend to a daily cost series in ascending order. The weight of each day is halved every
+// forecastHalfLife days back from the last day of the series. Series with a single day have a flat trend, and series
+// with less than three days have no variance.
+func FitTrend(series []DailyCost) Trend {
+	if len(series) == 0 {
+		return Trend{}
+	}
+
+	t := Trend{origin: series[0].Date}
+	last := t.x(series[len(series)-1].Date)
+
+	weights := make([]float64, len(series))
+	meanY := 0.0
+	for i, c := range series {
+		weights[i] = math.Pow(0.5, (last-t.x(c.Date))/forecastHalfLife)
+		t.sumWeights += weights[i]
+		t.meanX += weights[i] * t.x(c.Date)
+		meanY += weights[i] * c.Cost
+	}
+	t.meanX /= t.sumWeights
+	meanY /= t.sumWeights
+
+	sxy := 0.0
+	for i, c := range series {
+		dx := t.x(c.Date) - t.meanX
+		t.sxx += weights[i] * dx * dx
+		sxy += weights[i] * dx * (c.Cost - meanY)
+	}
+	if t.sxx > 0 {
+		t.slope = sxy / t.sxx
+	}
+	t.intercept = meanY - t.slope*t.meanX
+
+	if len(series) > 2 {
+		residuals := 0.0
+		for i, c := range series {
+			r := c.Cost - (t.intercept + t.slope*t.x(c.Date))
+			residuals += weights[i] * r * r
+		}
+		t.variance = residuals / float64(len(series)-2)
+	}
+
+	return t
+}
+
+// x returns the number of days from the origin of the trend
+func (t Trend) x(day time.Time) float64 {
+	return math.Round(day.Sub(t.origin).Hours() / 24)
+}
+
+// Predict returns the expected cost of a day. Costs are never negative.
+func (t Trend) Predict(day time.Time) float64 {
+	return max(0, t.intercept+t.slope*t.x(day))
+}
+
+// Sum returns the expected total cost of the days from and including from, to and including to, and the variance of
+// the total. The variance accounts for both the daily variation around the trend, and the uncertainty of the trend.
+func (t Trend) Sum(from, to time.Time) (expected, variance float64) {
+	days := 0.0
+	distance := 0.0
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		expected += t.Predict(day)
+		distance += t.x(day) - t.meanX
+		days++
+	}
+
+	if days == 0 || t.sumWeights == 0 {
+		return expected, 0
+	}
+
+	variance = t.variance * days
+	variance += t.variance * days * days / t.sumWeights
+	if t.sxx > 0 {
+		variance += t.variance * distance * distance / t.sxx
+	}
+	return expected, variance
+}
+
+// Forecast is a projected cost with confidence bounds
+type Forecast struct {
+	Cost       float64
+	LowerBound float64
+	UpperBound float64
+}
+
+// MonthForecast is the projected cost of a month, in total and per cost type
+type MonthForecast struct {
+	Forecast
+
+	// Month is the first day of the month
+	Month time.Time
+
+	CostTypes map[string]Forecast
+}
+
+// FetchFrom returns the first day of daily cost needed by ForecastMonths, given the last day with recorded cost
+func FetchFrom(last time.Time) time.Time {
+	monthStart := time.Date(last.Year(), last.Month(), 1, 0, 0, 0, 0, last.Location())
+	trendStart := last.AddDate(0, 0, 1-ForecastDays)
+	if monthStart.Before(trendStart) {
+		return monthStart
+	}
+	return trendStart
+}
+
+// ForecastMonths projects the cost of a number of months, starting with the month of the last day with recorded cost.
+// The series are the daily cost of each cost type in ascending order, from FetchFrom(last) up to and including last.
+// The trend of each cost type is fitted to the last ForecastDays days, and the cost recorded in the first month is
+// included in its forecast.
+func ForecastMonths(series map[string][]DailyCost, last time.Time, months int) []MonthForecast {
+	firstMonth := time.Date(last.Year(), last.Month(), 1, 0, 0, 0, 0, last.Location())
+	trendStart := last.AddDate(0, 0, 1-ForecastDays)
+
+	trends := make(map[string]Trend, len(series))
+	recorded := make(map[string]float64, len(series))
+	for costType, costs := range series {
+		trendStartIdx, _ := slices.BinarySearchFunc(costs, trendStart, func(c DailyCost, t time.Time) int {
+			return c.Date.Compare(t)
+		})
+		trends[costType] = FitTrend(costs[trendStartIdx:])
+
+		for _, c := range costs {
+			if !c.Date.Before(firstMonth) && !c.Date.After(last) {
+				recorded[costType] += c.Cost
+			}
+		}
+	}
+
+	ret := make([]MonthForecast, 0, months)
+	for i := 0; i < months; i++ {
+		month := firstMonth.AddDate(0, i, 0)
+		from := month
+		if i == 0 {
+			from = last.AddDate(0, 0, 1)
+		}
+		to := month.AddDate(0, 1, -1)
+
+		f := MonthForecast{
+			Month:     month,
+			CostTypes: make(map[string]Forecast, len(trends)),
+		}
+
+		totalRecorded := 0.0
+		totalVariance := 0.0
+		for costType, trend := range trends {
+			expected, variance := trend.Sum(from, to)
+			costTypeRecorded := 0.0
+			if i == 0 {
+				costTypeRecorded = recorded[costType]
+				expected += costTypeRecorded
+				totalRecorded += costTypeRecorded
+			}
+
+			f.CostTypes[costType] = bounds(expected, variance, costTypeRecorded)
+			f.Cost += expected
+			totalVariance += variance
+		}
+
+		// the cost types are assumed to vary independently
+		f.Forecast = bounds(f.Cost, totalVariance, totalRecorded)
+		ret = append(ret, f)
+	}
+	return ret
+}
+
+// bounds returns a forecast with confidence bounds. The lower bound is never below the cost already recorded.
+func bounds(expected, variance, recorded float64) Forecast {
+	margin := confidenceZ * math.Sqrt(variance)
+	return Forecast{
+		Cost:       expected,
+		LowerBound: max(recorded, expected-margin),
+		UpperBound: expected + margin,
+	}
+}
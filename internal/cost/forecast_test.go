@@ -0,0 +1,145 @@
+package cost_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nais/console-backend/internal/cost"
+	"github.com/stretchr/testify/assert"
+)
+
+// series returns a synthetic daily cost series of n days ending at last
+func series(last time.Time, n int, costFn func(day int) float64) []cost.DailyCost {
+	ret := make([]cost.DailyCost, 0, n)
+	for i := 0; i < n; i++ {
+		ret = append(ret, cost.DailyCost{
+			Date: last.AddDate(0, 0, i-n+1),
+			Cost: costFn(i),
+		})
+	}
+	return ret
+}
+
+func TestFitTrend(t *testing.T) {
+	last := time.Date(2023, time.January, 15, 0, 0, 0, 0, time.UTC)
+	const allowedDelta = 0.000001
+
+	t.Run("empty series", func(t *testing.T) {
+		trend := cost.FitTrend(nil)
+		assert.Equal(t, 0.0, trend.Predict(last))
+	})
+
+	t.Run("single day is flat", func(t *testing.T) {
+		trend := cost.FitTrend(series(last, 1, func(int) float64 { return 7 }))
+		assert.InDelta(t, 7.0, trend.Predict(last.AddDate(0, 0, 10)), allowedDelta)
+
+		expected, variance := trend.Sum(last.AddDate(0, 0, 1), last.AddDate(0, 0, 3))
+		assert.InDelta(t, 21.0, expected, allowedDelta)
+		assert.Equal(t, 0.0, variance)
+	})
+
+	t.Run("linear series is extrapolated exactly", func(t *testing.T) {
+		trend := cost.FitTrend(series(last, 30, func(day int) float64 { return 5 + 2*float64(day) }))
+		assert.InDelta(t, 5+2*30.0, trend.Predict(last.AddDate(0, 0, 1)), allowedDelta)
+		assert.InDelta(t, 5+2*39.0, trend.Predict(last.AddDate(0, 0, 10)), allowedDelta)
+
+		_, variance := trend.Sum(last.AddDate(0, 0, 1), last.AddDate(0, 0, 10))
+		assert.InDelta(t, 0.0, variance, allowedDelta)
+	})
+
+	t.Run("decreasing cost is never negative", func(t *testing.T) {
+		trend := cost.FitTrend(series(last, 30, func(day int) float64 { return 30 - float64(day) }))
+		assert.Equal(t, 0.0, trend.Predict(last.AddDate(0, 0, 100)))
+	})
+
+	t.Run("recent days weigh more", func(t *testing.T) {
+		// the cost doubled 10 days ago, so the trend should be closer to the new cost than to the mean
+		trend := cost.FitTrend(series(last, 30, func(day int) float64 {
+			if day < 20 {
+				return 10
+			}
+			return 20
+		}))
+		assert.Greater(t, trend.Predict(last.AddDate(0, 0, 1)), 20.0)
+	})
+}
+
+func TestForecastMonths(t *testing.T) {
+	last := time.Date(2023, time.January, 15, 0, 0, 0, 0, time.UTC)
+	const allowedDelta = 0.000001
+
+	t.Run("fetch from", func(t *testing.T) {
+		assert.Equal(t, time.Date(2022, time.December, 17, 0, 0, 0, 0, time.UTC), cost.FetchFrom(last))
+
+		endOfMonth := time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC)
+		assert.Equal(t, time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC), cost.FetchFrom(endOfMonth))
+	})
+
+	t.Run("no cost types", func(t *testing.T) {
+		forecasts := cost.ForecastMonths(map[string][]cost.DailyCost{}, last, 2)
+		assert.Len(t, forecasts, 2)
+		assert.Equal(t, 0.0, forecasts[0].Cost)
+		assert.Empty(t, forecasts[0].CostTypes)
+	})
+
+	t.Run("constant cost", func(t *testing.T) {
+		forecasts := cost.ForecastMonths(map[string][]cost.DailyCost{
+			"Compute Engine": series(last, 30, func(int) float64 { return 10 }),
+			"Cloud SQL":      series(last, 30, func(int) float64 { return 1 }),
+		}, last, 12)
+
+		assert.Len(t, forecasts, 12)
+		assert.Equal(t, time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC), forecasts[0].Month)
+		assert.InDelta(t, 31*11.0, forecasts[0].Cost, allowedDelta)
+		assert.InDelta(t, 31*11.0, forecasts[0].LowerBound, allowedDelta)
+		assert.InDelta(t, 31*11.0, forecasts[0].UpperBound, allowedDelta)
+		assert.InDelta(t, 31*10.0, forecasts[0].CostTypes["Compute Engine"].Cost, allowedDelta)
+		assert.InDelta(t, 31*1.0, forecasts[0].CostTypes["Cloud SQL"].Cost, allowedDelta)
+
+		assert.Equal(t, time.Date(2023, time.February, 1, 0, 0, 0, 0, time.UTC), forecasts[1].Month)
+		assert.InDelta(t, 28*11.0, forecasts[1].Cost, allowedDelta)
+		assert.Equal(t, time.Date(2023, time.December, 1, 0, 0, 0, 0, time.UTC), forecasts[11].Month)
+		assert.InDelta(t, 31*11.0, forecasts[11].Cost, allowedDelta)
+	})
+
+	t.Run("recorded cost is included in the current month", func(t *testing.T) {
+		// the cost this month differs from the trend, as it has been increasing every day
+		forecasts := cost.ForecastMonths(map[string][]cost.DailyCost{
+			"Compute Engine": series(last, 30, func(day int) float64 { return float64(day) }),
+		}, last, 1)
+
+		recorded := 0.0
+		for day := 15; day < 30; day++ {
+			recorded += float64(day)
+		}
+		remaining := 0.0
+		for day := 30; day < 46; day++ {
+			remaining += float64(day)
+		}
+		assert.InDelta(t, recorded+remaining, forecasts[0].Cost, allowedDelta)
+	})
+
+	t.Run("noisy cost has confidence bounds", func(t *testing.T) {
+		forecasts := cost.ForecastMonths(map[string][]cost.DailyCost{
+			"Compute Engine": series(last, 30, func(day int) float64 {
+				if day%2 == 0 {
+					return 9
+				}
+				return 11
+			}),
+		}, last, 12)
+
+		recorded := 9.0*7 + 11.0*8
+		for _, f := range forecasts {
+			assert.Less(t, f.LowerBound, f.Cost)
+			assert.Greater(t, f.UpperBound, f.Cost)
+		}
+		assert.GreaterOrEqual(t, forecasts[0].LowerBound, recorded)
+		assert.InDelta(t, 31*10.0, forecasts[0].Cost, 10)
+
+		// the further into the future, the less certain
+		first := forecasts[1].UpperBound - forecasts[1].LowerBound
+		lastMonth := forecasts[11].UpperBound - forecasts[11].LowerBound
+		assert.Greater(t, lastMonth, first)
+	})
+}
@@ -0,0 +1,63 @@
+package cost
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nais/console-backend/internal/webhook"
+)
+
+// BudgetAlert is a notification that a team has spent a share of its monthly cost budget
+type BudgetAlert struct {
+	Team string `json:"team"`
+
+	// Month is the first day of the month
+	Month time.Time `json:"month"`
+
+	// Budget and Spend are in euros
+	Budget float64 `json:"budget"`
+	Spend  float64 `json:"spend"`
+
+	// Threshold is the share of the budget that has been spent, in percent
+	Threshold int `json:"threshold"`
+}
+
+// Message returns a human readable description of the alert
+func (a BudgetAlert) Message() string {
+	return fmt.Sprintf(
+		"Team %s has spent %d%% of its monthly budget of €%.2f in %s, €%.2f so far.",
+		a.Team,
+		a.Threshold,
+		a.Budget,
+		a.Month.Format("January 2006"),
+		a.Spend,
+	)
+}
+
+type Notifier interface {
+	// NotifyBudget notifies a team that it has spent a share of its monthly cost budget
+	NotifyBudget(ctx context.Context, alert BudgetAlert) error
+}
+
+type webhookNotifier struct {
+	webhook *webhook.Client
+}
+
+// NewWebhookNotifier creates a notifier that posts budget alerts as JSON to a webhook. The payload includes the
+// message as "text", so that it can be posted to a Slack incoming webhook as well.
+func NewWebhookNotifier(webhookURL string) Notifier {
+	return &webhookNotifier{
+		webhook: webhook.New(webhookURL),
+	}
+}
+
+func (n *webhookNotifier) NotifyBudget(ctx context.Context, alert BudgetAlert) error {
+	return n.webhook.Post(ctx, struct {
+		BudgetAlert
+		Text string `json:"text"`
+	}{
+		BudgetAlert: alert,
+		Text:        alert.Message(),
+	})
+}
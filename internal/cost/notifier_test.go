@@ -0,0 +1,54 @@
+package cost_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/nais/console-backend/internal/cost"
+	httptest "github.com/nais/console-backend/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookNotifier_NotifyBudget(t *testing.T) {
+	ctx := context.Background()
+	alert := cost.BudgetAlert{
+		Team:      "team-a",
+		Month:     time.Date(2023, time.October, 1, 0, 0, 0, 0, time.UTC),
+		Budget:    1000,
+		Spend:     812.5,
+		Threshold: 80,
+	}
+
+	t.Run("alert is posted to webhook", func(t *testing.T) {
+		server := httptest.NewHttpServerWithHandlers(t, []http.HandlerFunc{
+			func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+				body := map[string]any{}
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, "team-a", body["team"])
+				assert.Equal(t, 80.0, body["threshold"])
+				assert.Equal(t, 812.5, body["spend"])
+				assert.Equal(t, "Team team-a has spent 80% of its monthly budget of €1000.00 in October 2023, €812.50 so far.", body["text"])
+			},
+		})
+
+		err := cost.NewWebhookNotifier(server.URL).NotifyBudget(ctx, alert)
+		assert.NoError(t, err)
+	})
+
+	t.Run("webhook returns error", func(t *testing.T) {
+		server := httptest.NewHttpServerWithHandlers(t, []http.HandlerFunc{
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+			},
+		})
+
+		err := cost.NewWebhookNotifier(server.URL).NotifyBudget(ctx, alert)
+		assert.ErrorContains(t, err, "webhook returned 404")
+	})
+}
@@ -38,6 +38,7 @@ type Updater struct {
 	bigQueryTable   string
 	daysToFetch     int
 	upsertBatchSize int
+	notifier        Notifier
 }
 
 // Option is a function that can be used to set custom options for the cost updater
@@ -57,6 +58,13 @@ func WithDaysToFetch(daysToFetch int) Option {
 	}
 }
 
+// WithNotifier will set a notifier used to notify teams about their budgets
+func WithNotifier(notifier Notifier) Option {
+	return func(u *Updater) {
+		u.notifier = notifier
+	}
+}
+
 // NewCostUpdater creates a new cost updater
 func NewCostUpdater(bigQueryClient *bigquery.Client, querier gensql.Querier, tenantName string, log logrus.FieldLogger, opts ...Option) *Updater {
 	updater := &Updater{
@@ -97,13 +105,30 @@ func (c *Updater) ShouldUpdateCosts(ctx context.Context) (bool, error) {
 
 // FetchBigQueryData fetches cost data from BigQuery and sends it to the provided channel
 func (c *Updater) FetchBigQueryData(ctx context.Context, ch chan<- gensql.CostUpsertParams) error {
-	start := time.Now()
-	numRows := 0
 	it, err := c.getBigQueryIterator(ctx)
 	if err != nil {
 		return err
 	}
 
+	return c.sendBigQueryRows(ctx, it, ch)
+}
+
+// FetchBigQueryDataForRange fetches cost data in a date range from BigQuery, from and to inclusive, and sends it to the
+// provided channel
+func (c *Updater) FetchBigQueryDataForRange(ctx context.Context, ch chan<- gensql.CostUpsertParams, from, to time.Time) error {
+	it, err := c.getBigQueryIteratorForRange(ctx, from, to)
+	if err != nil {
+		return err
+	}
+
+	return c.sendBigQueryRows(ctx, it, ch)
+}
+
+// sendBigQueryRows will send all rows of a BigQuery resultset to the provided channel
+func (c *Updater) sendBigQueryRows(ctx context.Context, it *bigquery.RowIterator, ch chan<- gensql.CostUpsertParams) error {
+	start := time.Now()
+	numRows := 0
+
 	var row bigQueryCostTableRow
 	for {
 		if err := it.Next(&row); err != nil {
@@ -142,7 +167,8 @@ func (c *Updater) FetchBigQueryData(ctx context.Context, ch chan<- gensql.CostUp
 	return nil
 }
 
-// UpdateCosts will update the cost data in the database based on data from the provided channel
+// UpdateCosts will update the cost data in the database based on data from the provided channel. All rows are
+// attempted, and an error is returned if any of them could not be upserted.
 func (c *Updater) UpdateCosts(ctx context.Context, ch <-chan gensql.CostUpsertParams) error {
 	var numUpserted, numErrors int
 	start := time.Now()
@@ -167,6 +193,10 @@ func (c *Updater) UpdateCosts(ctx context.Context, ch <-chan gensql.CostUpsertPa
 		"num_rows":   numUpserted - numErrors,
 		"num_errors": numErrors,
 	}).Infof("cost data has been updated")
+
+	if numErrors > 0 {
+		return fmt.Errorf("unable to upsert %d of %d rows", numErrors, numUpserted+numErrors)
+	}
 	return nil
 }
 
@@ -204,6 +234,28 @@ func (c *Updater) getBigQueryIterator(ctx context.Context) (*bigquery.RowIterato
 	return c.bigQueryClient.Query(sql).Read(ctx)
 }
 
+// getBigQueryIteratorForRange will return an iterator for the resultset of the cost query for a date range, from and to
+// inclusive
+func (c *Updater) getBigQueryIteratorForRange(ctx context.Context, from, to time.Time) (*bigquery.RowIterator, error) {
+	sql := fmt.Sprintf(
+		"SELECT * FROM `%s` WHERE `date` >= @from AND `date` <= @to",
+		c.bigQueryTable,
+	)
+
+	query := c.bigQueryClient.Query(sql)
+	query.Parameters = []bigquery.QueryParameter{
+		{Name: "from", Value: civil.DateOf(from)},
+		{Name: "to", Value: civil.DateOf(to)},
+	}
+
+	c.log.WithFields(logrus.Fields{
+		"query": sql,
+		"from":  from.Format(YYYYMMDD),
+		"to":    to.Format(YYYYMMDD),
+	}).Infof("fetch data from bigquery")
+	return query.Read(ctx)
+}
+
 // getBatch will return a batch of rows from the provided channel
 func (c *Updater) getBatch(ctx context.Context, ch <-chan gensql.CostUpsertParams) ([]gensql.CostUpsertParams, error) {
 	batch := make([]gensql.CostUpsertParams, 0)
@@ -18,9 +18,43 @@ var embedMigrations embed.FS
 
 const databaseConnectRetries = 5
 
+// Querier is a gensql.Querier that can also run queries in a transaction
+type Querier interface {
+	gensql.Querier
+
+	// Transaction runs fn in a transaction, using the querier passed to fn. The transaction is committed if fn returns
+	// nil, and rolled back otherwise.
+	Transaction(ctx context.Context, fn func(ctx context.Context, querier gensql.Querier) error) error
+}
+
+type querier struct {
+	*gensql.Queries
+	pool *pgxpool.Pool
+}
+
+func (q *querier) Transaction(ctx context.Context, fn func(ctx context.Context, querier gensql.Querier) error) error {
+	tx, err := q.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if err := fn(ctx, q.Queries.WithTx(tx)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
 // NewQuerier connects to the database, runs migrations and returns a querier instance. The caller must call the
 // returned closer function when the database connection is no longer needed
-func NewQuerier(ctx context.Context, dsn string, log logrus.FieldLogger) (querier gensql.Querier, closer func(), err error) {
+func NewQuerier(ctx context.Context, dsn string, log logrus.FieldLogger) (q Querier, closer func(), err error) {
 	config, err := pgxpool.ParseConfig(dsn)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to parse dsn config: %w", err)
@@ -50,7 +84,7 @@ func NewQuerier(ctx context.Context, dsn string, log logrus.FieldLogger) (querie
 		return nil, nil, err
 	}
 
-	return gensql.New(conn), conn.Close, nil
+	return &querier{Queries: gensql.New(conn), pool: conn}, conn.Close, nil
 }
 
 // migrateDatabaseSchema runs database migrations
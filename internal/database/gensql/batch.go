@@ -79,6 +79,215 @@ func (b *CostUpsertBatchResults) Close() error {
 	return b.br.Close()
 }
 
+const deliveryMetricsUpsert = `-- name: DeliveryMetricsUpsert :batchexec
+INSERT INTO delivery_metrics (date, env, team, app, deployments, failed_deployments, rollbacks, restores, restore_time_seconds, lead_times, lead_time_seconds)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+ON CONFLICT ON CONSTRAINT delivery_metric DO
+    UPDATE SET
+        deployments = EXCLUDED.deployments,
+        failed_deployments = EXCLUDED.failed_deployments,
+        rollbacks = EXCLUDED.rollbacks,
+        restores = EXCLUDED.restores,
+        restore_time_seconds = EXCLUDED.restore_time_seconds,
+        lead_times = EXCLUDED.lead_times,
+        lead_time_seconds = EXCLUDED.lead_time_seconds
+`
+
+type DeliveryMetricsUpsertBatchResults struct {
+	br     pgx.BatchResults
+	tot    int
+	closed bool
+}
+
+type DeliveryMetricsUpsertParams struct {
+	Date               pgtype.Date
+	Env                string
+	Team               string
+	App                string
+	Deployments        int32
+	FailedDeployments  int32
+	Rollbacks          int32
+	Restores           int32
+	RestoreTimeSeconds int64
+	LeadTimes          int32
+	LeadTimeSeconds    int64
+}
+
+// DeliveryMetricsUpsert will insert or update the daily delivery metrics for an app. If there is a conflict on the
+// delivery_metric constraint, all metrics for the day will be replaced.
+func (q *Queries) DeliveryMetricsUpsert(ctx context.Context, arg []DeliveryMetricsUpsertParams) *DeliveryMetricsUpsertBatchResults {
+	batch := &pgx.Batch{}
+	for _, a := range arg {
+		vals := []interface{}{
+			a.Date,
+			a.Env,
+			a.Team,
+			a.App,
+			a.Deployments,
+			a.FailedDeployments,
+			a.Rollbacks,
+			a.Restores,
+			a.RestoreTimeSeconds,
+			a.LeadTimes,
+			a.LeadTimeSeconds,
+		}
+		batch.Queue(deliveryMetricsUpsert, vals...)
+	}
+	br := q.db.SendBatch(ctx, batch)
+	return &DeliveryMetricsUpsertBatchResults{br, len(arg), false}
+}
+
+func (b *DeliveryMetricsUpsertBatchResults) Exec(f func(int, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		if b.closed {
+			if f != nil {
+				f(t, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		_, err := b.br.Exec()
+		if f != nil {
+			f(t, err)
+		}
+	}
+}
+
+func (b *DeliveryMetricsUpsertBatchResults) Close() error {
+	b.closed = true
+	return b.br.Close()
+}
+
+const deploymentResourceUpsert = `-- name: DeploymentResourceUpsert :batchexec
+INSERT INTO deployment_resources (id, deployment_id, "group", kind, name, version, namespace)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (id) DO
+    UPDATE SET
+        "group" = EXCLUDED."group",
+        kind = EXCLUDED.kind,
+        name = EXCLUDED.name,
+        version = EXCLUDED.version,
+        namespace = EXCLUDED.namespace
+`
+
+type DeploymentResourceUpsertBatchResults struct {
+	br     pgx.BatchResults
+	tot    int
+	closed bool
+}
+
+type DeploymentResourceUpsertParams struct {
+	ID           string
+	DeploymentID string
+	Group        string
+	Kind         string
+	Name         string
+	Version      string
+	Namespace    string
+}
+
+// DeploymentResourceUpsert will insert or update resources of deployments.
+func (q *Queries) DeploymentResourceUpsert(ctx context.Context, arg []DeploymentResourceUpsertParams) *DeploymentResourceUpsertBatchResults {
+	batch := &pgx.Batch{}
+	for _, a := range arg {
+		vals := []interface{}{
+			a.ID,
+			a.DeploymentID,
+			a.Group,
+			a.Kind,
+			a.Name,
+			a.Version,
+			a.Namespace,
+		}
+		batch.Queue(deploymentResourceUpsert, vals...)
+	}
+	br := q.db.SendBatch(ctx, batch)
+	return &DeploymentResourceUpsertBatchResults{br, len(arg), false}
+}
+
+func (b *DeploymentResourceUpsertBatchResults) Exec(f func(int, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		if b.closed {
+			if f != nil {
+				f(t, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		_, err := b.br.Exec()
+		if f != nil {
+			f(t, err)
+		}
+	}
+}
+
+func (b *DeploymentResourceUpsertBatchResults) Close() error {
+	b.closed = true
+	return b.br.Close()
+}
+
+const deploymentStatusUpsert = `-- name: DeploymentStatusUpsert :batchexec
+INSERT INTO deployment_statuses (id, deployment_id, status, message, created)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (id) DO
+    UPDATE SET
+        status = EXCLUDED.status,
+        message = EXCLUDED.message,
+        created = EXCLUDED.created
+`
+
+type DeploymentStatusUpsertBatchResults struct {
+	br     pgx.BatchResults
+	tot    int
+	closed bool
+}
+
+type DeploymentStatusUpsertParams struct {
+	ID           string
+	DeploymentID string
+	Status       string
+	Message      string
+	Created      pgtype.Timestamptz
+}
+
+// DeploymentStatusUpsert will insert or update statuses of deployments.
+func (q *Queries) DeploymentStatusUpsert(ctx context.Context, arg []DeploymentStatusUpsertParams) *DeploymentStatusUpsertBatchResults {
+	batch := &pgx.Batch{}
+	for _, a := range arg {
+		vals := []interface{}{
+			a.ID,
+			a.DeploymentID,
+			a.Status,
+			a.Message,
+			a.Created,
+		}
+		batch.Queue(deploymentStatusUpsert, vals...)
+	}
+	br := q.db.SendBatch(ctx, batch)
+	return &DeploymentStatusUpsertBatchResults{br, len(arg), false}
+}
+
+func (b *DeploymentStatusUpsertBatchResults) Exec(f func(int, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		if b.closed {
+			if f != nil {
+				f(t, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		_, err := b.br.Exec()
+		if f != nil {
+			f(t, err)
+		}
+	}
+}
+
+func (b *DeploymentStatusUpsertBatchResults) Close() error {
+	b.closed = true
+	return b.br.Close()
+}
+
 const resourceUtilizationUpsert = `-- name: ResourceUtilizationUpsert :batchexec
 INSERT INTO resource_utilization_metrics (timestamp, env, team, app, resource_type, usage, request)
 VALUES ($1, $2, $3, $4, $5, $6, $7)
@@ -140,3 +349,66 @@ func (b *ResourceUtilizationUpsertBatchResults) Close() error {
 	b.closed = true
 	return b.br.Close()
 }
+
+const vulnerabilityIndexComponentsInsert = `-- name: VulnerabilityIndexComponentsInsert :batchexec
+INSERT INTO vulnerability_index_components (team, env, kind, app, "group", name, version, purl)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+
+type VulnerabilityIndexComponentsInsertBatchResults struct {
+	br     pgx.BatchResults
+	tot    int
+	closed bool
+}
+
+type VulnerabilityIndexComponentsInsertParams struct {
+	Team    string
+	Env     string
+	Kind    string
+	App     string
+	Group   string
+	Name    string
+	Version string
+	Purl    string
+}
+
+// VulnerabilityIndexComponentsInsert will add components of a workload to the vulnerability index.
+func (q *Queries) VulnerabilityIndexComponentsInsert(ctx context.Context, arg []VulnerabilityIndexComponentsInsertParams) *VulnerabilityIndexComponentsInsertBatchResults {
+	batch := &pgx.Batch{}
+	for _, a := range arg {
+		vals := []interface{}{
+			a.Team,
+			a.Env,
+			a.Kind,
+			a.App,
+			a.Group,
+			a.Name,
+			a.Version,
+			a.Purl,
+		}
+		batch.Queue(vulnerabilityIndexComponentsInsert, vals...)
+	}
+	br := q.db.SendBatch(ctx, batch)
+	return &VulnerabilityIndexComponentsInsertBatchResults{br, len(arg), false}
+}
+
+func (b *VulnerabilityIndexComponentsInsertBatchResults) Exec(f func(int, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		if b.closed {
+			if f != nil {
+				f(t, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		_, err := b.br.Exec()
+		if f != nil {
+			f(t, err)
+		}
+	}
+}
+
+func (b *VulnerabilityIndexComponentsInsertBatchResults) Close() error {
+	b.closed = true
+	return b.br.Close()
+}
@@ -11,6 +11,196 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const costBreakdownForTeam = `-- name: CostBreakdownForTeam :many
+SELECT
+    COALESCE(env, '')::text AS env,
+    app,
+    cost_type,
+    SUM(daily_cost)::real AS cost
+FROM
+    cost
+WHERE
+    date >= $2::date
+    AND date <= $3::date
+    AND team = $1
+GROUP BY
+    env, app, cost_type
+ORDER BY
+    env, app, cost_type ASC
+`
+
+type CostBreakdownForTeamParams struct {
+	Team     *string
+	FromDate pgtype.Date
+	ToDate   pgtype.Date
+}
+
+type CostBreakdownForTeamRow struct {
+	Env      string
+	App      string
+	CostType string
+	Cost     float32
+}
+
+// CostBreakdownForTeam will fetch the total cost of a team in a date range for each environment, app and cost type.
+func (q *Queries) CostBreakdownForTeam(ctx context.Context, arg CostBreakdownForTeamParams) ([]*CostBreakdownForTeamRow, error) {
+	rows, err := q.db.Query(ctx, costBreakdownForTeam, arg.Team, arg.FromDate, arg.ToDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*CostBreakdownForTeamRow
+	for rows.Next() {
+		var i CostBreakdownForTeamRow
+		if err := rows.Scan(
+			&i.Env,
+			&i.App,
+			&i.CostType,
+			&i.Cost,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const costExport = `-- name: CostExport :many
+SELECT
+    id,
+    date,
+    team::text AS team,
+    COALESCE(env, '')::text AS env,
+    app,
+    cost_type,
+    daily_cost
+FROM
+    cost
+WHERE
+    team = $1
+    AND ($2::text IS NULL OR env = $2::text)
+    AND ($3::text IS NULL OR app = $3::text)
+    AND date >= $4::date
+    AND date <= $5::date
+    AND (date, id) > ($6::date, $7::integer)
+ORDER BY
+    date, id ASC
+LIMIT
+    $8
+`
+
+type CostExportParams struct {
+	Team      *string
+	Env       *string
+	App       *string
+	FromDate  pgtype.Date
+	ToDate    pgtype.Date
+	AfterDate pgtype.Date
+	AfterID   int32
+	PageSize  int32
+}
+
+type CostExportRow struct {
+	ID        int32
+	Date      pgtype.Date
+	Team      string
+	Env       string
+	App       string
+	CostType  string
+	DailyCost float32
+}
+
+// CostExport will fetch a page of the daily cost of a team in a date range, optionally for a single environment and
+// app, ordered by date and id. The page starts after the given date and id, so that large ranges can be read in pages.
+func (q *Queries) CostExport(ctx context.Context, arg CostExportParams) ([]*CostExportRow, error) {
+	rows, err := q.db.Query(ctx, costExport,
+		arg.Team,
+		arg.Env,
+		arg.App,
+		arg.FromDate,
+		arg.ToDate,
+		arg.AfterDate,
+		arg.AfterID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*CostExportRow
+	for rows.Next() {
+		var i CostExportRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Date,
+			&i.Team,
+			&i.Env,
+			&i.App,
+			&i.CostType,
+			&i.DailyCost,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const costForTeams = `-- name: CostForTeams :many
+SELECT
+    team,
+    SUM(daily_cost)::real AS daily_cost
+FROM
+    cost
+WHERE
+    date >= $1::date
+    AND date <= $2::date
+    AND team = ANY($3::text[])
+GROUP BY
+    team
+ORDER BY
+    team ASC
+`
+
+type CostForTeamsParams struct {
+	FromDate pgtype.Date
+	ToDate   pgtype.Date
+	Teams    []string
+}
+
+type CostForTeamsRow struct {
+	Team      *string
+	DailyCost float32
+}
+
+// CostForTeams will fetch the total cost for each of the given teams in a date range, across all apps, envs and cost
+// types.
+func (q *Queries) CostForTeams(ctx context.Context, arg CostForTeamsParams) ([]*CostForTeamsRow, error) {
+	rows, err := q.db.Query(ctx, costForTeams, arg.FromDate, arg.ToDate, arg.Teams)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*CostForTeamsRow
+	for rows.Next() {
+		var i CostForTeamsRow
+		if err := rows.Scan(&i.Team, &i.DailyCost); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const dailyCostForApp = `-- name: DailyCostForApp :many
 SELECT
     id, env, team, app, cost_type, date, daily_cost
@@ -320,3 +510,303 @@ func (q *Queries) MonthlyCostForTeam(ctx context.Context, team *string) ([]*Mont
 	}
 	return items, nil
 }
+
+const tenantAppCostGrowth = `-- name: TenantAppCostGrowth :many
+SELECT
+    team::text AS team,
+    COALESCE(env, '')::text AS env,
+    app,
+    SUM(CASE WHEN date >= $1::date THEN daily_cost ELSE 0 END)::real AS current_cost,
+    SUM(CASE WHEN date <= $2::date THEN daily_cost ELSE 0 END)::real AS previous_cost,
+    SUM(CASE WHEN date >= $1::date THEN daily_cost ELSE -daily_cost END)::real AS growth
+FROM
+    cost
+WHERE
+    (
+        date >= $3::date AND date <= $2::date
+        OR date >= $1::date AND date <= $4::date
+    )
+    AND team IS NOT NULL
+GROUP BY
+    team, env, app
+ORDER BY
+    growth DESC, team, env, app ASC
+LIMIT
+    $5
+`
+
+type TenantAppCostGrowthParams struct {
+	CurrentFrom  pgtype.Date
+	PreviousTo   pgtype.Date
+	PreviousFrom pgtype.Date
+	CurrentTo    pgtype.Date
+	Limit        int32
+}
+
+type TenantAppCostGrowthRow struct {
+	Team         string
+	Env          string
+	App          string
+	CurrentCost  float32
+	PreviousCost float32
+	Growth       float32
+}
+
+// TenantAppCostGrowth will fetch the apps with the highest increase in cost from a previous period to a current period.
+func (q *Queries) TenantAppCostGrowth(ctx context.Context, arg TenantAppCostGrowthParams) ([]*TenantAppCostGrowthRow, error) {
+	rows, err := q.db.Query(ctx, tenantAppCostGrowth,
+		arg.CurrentFrom,
+		arg.PreviousTo,
+		arg.PreviousFrom,
+		arg.CurrentTo,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*TenantAppCostGrowthRow
+	for rows.Next() {
+		var i TenantAppCostGrowthRow
+		if err := rows.Scan(
+			&i.Team,
+			&i.Env,
+			&i.App,
+			&i.CurrentCost,
+			&i.PreviousCost,
+			&i.Growth,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const tenantCostPerEnv = `-- name: TenantCostPerEnv :many
+SELECT
+    COALESCE(env, '')::text AS env,
+    SUM(daily_cost)::real AS cost
+FROM
+    cost
+WHERE
+    date >= $1::date
+    AND date <= $2::date
+GROUP BY
+    env
+ORDER BY
+    cost DESC, env ASC
+`
+
+type TenantCostPerEnvParams struct {
+	FromDate pgtype.Date
+	ToDate   pgtype.Date
+}
+
+type TenantCostPerEnvRow struct {
+	Env  string
+	Cost float32
+}
+
+// TenantCostPerEnv will fetch the total cost of all teams in a date range for each environment, highest cost first.
+func (q *Queries) TenantCostPerEnv(ctx context.Context, arg TenantCostPerEnvParams) ([]*TenantCostPerEnvRow, error) {
+	rows, err := q.db.Query(ctx, tenantCostPerEnv, arg.FromDate, arg.ToDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*TenantCostPerEnvRow
+	for rows.Next() {
+		var i TenantCostPerEnvRow
+		if err := rows.Scan(&i.Env, &i.Cost); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const tenantTeamCostGrowth = `-- name: TenantTeamCostGrowth :many
+SELECT
+    team::text AS team,
+    SUM(CASE WHEN date >= $1::date THEN daily_cost ELSE 0 END)::real AS current_cost,
+    SUM(CASE WHEN date <= $2::date THEN daily_cost ELSE 0 END)::real AS previous_cost,
+    SUM(CASE WHEN date >= $1::date THEN daily_cost ELSE -daily_cost END)::real AS growth
+FROM
+    cost
+WHERE
+    (
+        date >= $3::date AND date <= $2::date
+        OR date >= $1::date AND date <= $4::date
+    )
+    AND team IS NOT NULL
+GROUP BY
+    team
+ORDER BY
+    growth DESC, team ASC
+LIMIT
+    $5
+`
+
+type TenantTeamCostGrowthParams struct {
+	CurrentFrom  pgtype.Date
+	PreviousTo   pgtype.Date
+	PreviousFrom pgtype.Date
+	CurrentTo    pgtype.Date
+	Limit        int32
+}
+
+type TenantTeamCostGrowthRow struct {
+	Team         string
+	CurrentCost  float32
+	PreviousCost float32
+	Growth       float32
+}
+
+// TenantTeamCostGrowth will fetch the teams with the highest increase in cost from a previous period to a current
+// period.
+func (q *Queries) TenantTeamCostGrowth(ctx context.Context, arg TenantTeamCostGrowthParams) ([]*TenantTeamCostGrowthRow, error) {
+	rows, err := q.db.Query(ctx, tenantTeamCostGrowth,
+		arg.CurrentFrom,
+		arg.PreviousTo,
+		arg.PreviousFrom,
+		arg.CurrentTo,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*TenantTeamCostGrowthRow
+	for rows.Next() {
+		var i TenantTeamCostGrowthRow
+		if err := rows.Scan(
+			&i.Team,
+			&i.CurrentCost,
+			&i.PreviousCost,
+			&i.Growth,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const tenantTopApps = `-- name: TenantTopApps :many
+SELECT
+    team::text AS team,
+    COALESCE(env, '')::text AS env,
+    app,
+    SUM(daily_cost)::real AS cost
+FROM
+    cost
+WHERE
+    date >= $1::date
+    AND date <= $2::date
+    AND team IS NOT NULL
+GROUP BY
+    team, env, app
+ORDER BY
+    cost DESC, team, env, app ASC
+LIMIT
+    $3
+`
+
+type TenantTopAppsParams struct {
+	FromDate pgtype.Date
+	ToDate   pgtype.Date
+	Limit    int32
+}
+
+type TenantTopAppsRow struct {
+	Team string
+	Env  string
+	App  string
+	Cost float32
+}
+
+// TenantTopApps will fetch the apps with the highest total cost in a date range.
+func (q *Queries) TenantTopApps(ctx context.Context, arg TenantTopAppsParams) ([]*TenantTopAppsRow, error) {
+	rows, err := q.db.Query(ctx, tenantTopApps, arg.FromDate, arg.ToDate, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*TenantTopAppsRow
+	for rows.Next() {
+		var i TenantTopAppsRow
+		if err := rows.Scan(
+			&i.Team,
+			&i.Env,
+			&i.App,
+			&i.Cost,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const tenantTopTeams = `-- name: TenantTopTeams :many
+SELECT
+    team::text AS team,
+    SUM(daily_cost)::real AS cost
+FROM
+    cost
+WHERE
+    date >= $1::date
+    AND date <= $2::date
+    AND team IS NOT NULL
+GROUP BY
+    team
+ORDER BY
+    cost DESC, team ASC
+LIMIT
+    $3
+`
+
+type TenantTopTeamsParams struct {
+	FromDate pgtype.Date
+	ToDate   pgtype.Date
+	Limit    int32
+}
+
+type TenantTopTeamsRow struct {
+	Team string
+	Cost float32
+}
+
+// TenantTopTeams will fetch the teams with the highest total cost in a date range.
+func (q *Queries) TenantTopTeams(ctx context.Context, arg TenantTopTeamsParams) ([]*TenantTopTeamsRow, error) {
+	rows, err := q.db.Query(ctx, tenantTopTeams, arg.FromDate, arg.ToDate, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*TenantTopTeamsRow
+	for rows.Next() {
+		var i TenantTopTeamsRow
+		if err := rows.Scan(&i.Team, &i.Cost); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
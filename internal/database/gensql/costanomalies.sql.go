@@ -0,0 +1,184 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.23.0
+// source: costanomalies.sql
+
+package gensql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const costAnomaliesForTeam = `-- name: CostAnomaliesForTeam :many
+SELECT
+    id, team, env, app, cost_type, first_seen, last_seen, cost, baseline
+FROM
+    cost_anomalies
+WHERE
+    team = $1
+    AND last_seen >= $2::date
+    AND first_seen <= $3::date
+ORDER BY
+    last_seen DESC, (cost - baseline) DESC
+`
+
+type CostAnomaliesForTeamParams struct {
+	Team     string
+	FromDate pgtype.Date
+	ToDate   pgtype.Date
+}
+
+// CostAnomaliesForTeam will fetch the cost anomalies of a team that were seen in a date range, most recent first.
+func (q *Queries) CostAnomaliesForTeam(ctx context.Context, arg CostAnomaliesForTeamParams) ([]*CostAnomaly, error) {
+	rows, err := q.db.Query(ctx, costAnomaliesForTeam, arg.Team, arg.FromDate, arg.ToDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*CostAnomaly
+	for rows.Next() {
+		var i CostAnomaly
+		if err := rows.Scan(
+			&i.ID,
+			&i.Team,
+			&i.Env,
+			&i.App,
+			&i.CostType,
+			&i.FirstSeen,
+			&i.LastSeen,
+			&i.Cost,
+			&i.Baseline,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const costAnomalyUpsert = `-- name: CostAnomalyUpsert :exec
+INSERT INTO cost_anomalies (team, env, app, cost_type, first_seen, last_seen, cost, baseline)
+VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    COALESCE(
+        (
+            SELECT
+                a.first_seen
+            FROM
+                cost_anomalies a
+            WHERE
+                a.team = $1
+                AND a.env = $2
+                AND a.app = $3
+                AND a.cost_type = $4
+                AND a.last_seen >= $5::date - 1
+            ORDER BY
+                a.first_seen DESC
+            LIMIT 1
+        ),
+        $5::date
+    ),
+    $5::date,
+    $6,
+    $7
+)
+ON CONFLICT (team, env, app, cost_type, first_seen) DO
+    UPDATE SET
+        last_seen = EXCLUDED.last_seen,
+        cost = EXCLUDED.cost,
+        baseline = EXCLUDED.baseline
+`
+
+type CostAnomalyUpsertParams struct {
+	Team     string
+	Env      string
+	App      string
+	CostType string
+	Date     pgtype.Date
+	Cost     float32
+	Baseline float32
+}
+
+// CostAnomalyUpsert will store an anomaly of a cost series. If the series had an anomaly the day before, the anomaly
+// is extended to the date, and keeps the date it was first seen.
+func (q *Queries) CostAnomalyUpsert(ctx context.Context, arg CostAnomalyUpsertParams) error {
+	_, err := q.db.Exec(ctx, costAnomalyUpsert,
+		arg.Team,
+		arg.Env,
+		arg.App,
+		arg.CostType,
+		arg.Date,
+		arg.Cost,
+		arg.Baseline,
+	)
+	return err
+}
+
+const costSeries = `-- name: CostSeries :many
+SELECT
+    team::text AS team,
+    COALESCE(env, '')::text AS env,
+    app,
+    cost_type,
+    date,
+    daily_cost
+FROM
+    cost
+WHERE
+    team IS NOT NULL
+    AND date >= $1::date
+    AND date <= $2::date
+ORDER BY
+    team, env, app, cost_type, date ASC
+`
+
+type CostSeriesParams struct {
+	FromDate pgtype.Date
+	ToDate   pgtype.Date
+}
+
+type CostSeriesRow struct {
+	Team      string
+	Env       string
+	App       string
+	CostType  string
+	Date      pgtype.Date
+	DailyCost float32
+}
+
+// CostSeries will fetch the daily cost of each team, app, environment and cost type in a date range, ordered by series
+// and date. Cost without a team is not included.
+func (q *Queries) CostSeries(ctx context.Context, arg CostSeriesParams) ([]*CostSeriesRow, error) {
+	rows, err := q.db.Query(ctx, costSeries, arg.FromDate, arg.ToDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*CostSeriesRow
+	for rows.Next() {
+		var i CostSeriesRow
+		if err := rows.Scan(
+			&i.Team,
+			&i.Env,
+			&i.App,
+			&i.CostType,
+			&i.Date,
+			&i.DailyCost,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,139 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.23.0
+// source: costbudgets.sql
+
+package gensql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const costBudget = `-- name: CostBudget :one
+SELECT
+    team, amount, updated_by, updated, notified_month, notified_threshold
+FROM
+    cost_budgets
+WHERE
+    team = $1
+`
+
+// CostBudget will fetch the monthly cost budget of a team.
+func (q *Queries) CostBudget(ctx context.Context, team string) (*CostBudget, error) {
+	row := q.db.QueryRow(ctx, costBudget, team)
+	var i CostBudget
+	err := row.Scan(
+		&i.Team,
+		&i.Amount,
+		&i.UpdatedBy,
+		&i.Updated,
+		&i.NotifiedMonth,
+		&i.NotifiedThreshold,
+	)
+	return &i, err
+}
+
+const costBudgetDelete = `-- name: CostBudgetDelete :exec
+DELETE FROM cost_budgets
+WHERE team = $1
+`
+
+// CostBudgetDelete will remove the monthly cost budget of a team.
+func (q *Queries) CostBudgetDelete(ctx context.Context, team string) error {
+	_, err := q.db.Exec(ctx, costBudgetDelete, team)
+	return err
+}
+
+const costBudgetNotified = `-- name: CostBudgetNotified :exec
+UPDATE cost_budgets
+SET
+    notified_month = $2,
+    notified_threshold = $3
+WHERE team = $1
+`
+
+type CostBudgetNotifiedParams struct {
+	Team              string
+	NotifiedMonth     pgtype.Date
+	NotifiedThreshold *int32
+}
+
+// CostBudgetNotified will record the highest threshold of the budget the team has been notified about in a month.
+func (q *Queries) CostBudgetNotified(ctx context.Context, arg CostBudgetNotifiedParams) error {
+	_, err := q.db.Exec(ctx, costBudgetNotified, arg.Team, arg.NotifiedMonth, arg.NotifiedThreshold)
+	return err
+}
+
+const costBudgetUpsert = `-- name: CostBudgetUpsert :one
+INSERT INTO cost_budgets (team, amount, updated_by)
+VALUES ($1, $2, $3)
+ON CONFLICT (team) DO
+    UPDATE SET
+        amount = EXCLUDED.amount,
+        updated_by = EXCLUDED.updated_by,
+        updated = NOW(),
+        notified_month = NULL,
+        notified_threshold = NULL
+RETURNING team, amount, updated_by, updated, notified_month, notified_threshold
+`
+
+type CostBudgetUpsertParams struct {
+	Team      string
+	Amount    float32
+	UpdatedBy string
+}
+
+// CostBudgetUpsert will set the monthly cost budget of a team, and return the stored row. Notifications are reset, so
+// that thresholds of the new budget will be notified about.
+func (q *Queries) CostBudgetUpsert(ctx context.Context, arg CostBudgetUpsertParams) (*CostBudget, error) {
+	row := q.db.QueryRow(ctx, costBudgetUpsert, arg.Team, arg.Amount, arg.UpdatedBy)
+	var i CostBudget
+	err := row.Scan(
+		&i.Team,
+		&i.Amount,
+		&i.UpdatedBy,
+		&i.Updated,
+		&i.NotifiedMonth,
+		&i.NotifiedThreshold,
+	)
+	return &i, err
+}
+
+const costBudgets = `-- name: CostBudgets :many
+SELECT
+    team, amount, updated_by, updated, notified_month, notified_threshold
+FROM
+    cost_budgets
+ORDER BY
+    team ASC
+`
+
+// CostBudgets will fetch the monthly cost budgets of all teams.
+func (q *Queries) CostBudgets(ctx context.Context) ([]*CostBudget, error) {
+	rows, err := q.db.Query(ctx, costBudgets)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*CostBudget
+	for rows.Next() {
+		var i CostBudget
+		if err := rows.Scan(
+			&i.Team,
+			&i.Amount,
+			&i.UpdatedBy,
+			&i.Updated,
+			&i.NotifiedMonth,
+			&i.NotifiedThreshold,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
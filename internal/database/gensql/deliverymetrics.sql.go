@@ -0,0 +1,290 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.23.0
+// source: deliverymetrics.sql
+
+package gensql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const appCommitUpsert = `-- name: AppCommitUpsert :exec
+INSERT INTO app_commits (team, env, app, commit_sha, last_seen)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (team, env, app, commit_sha) DO
+    UPDATE SET last_seen = EXCLUDED.last_seen
+`
+
+type AppCommitUpsertParams struct {
+	Team      string
+	Env       string
+	App       string
+	CommitSha string
+	LastSeen  pgtype.Timestamptz
+}
+
+// AppCommitUpsert will record that an app is running a commit.
+func (q *Queries) AppCommitUpsert(ctx context.Context, arg AppCommitUpsertParams) error {
+	_, err := q.db.Exec(ctx, appCommitUpsert,
+		arg.Team,
+		arg.Env,
+		arg.App,
+		arg.CommitSha,
+		arg.LastSeen,
+	)
+	return err
+}
+
+const appCommits = `-- name: AppCommits :many
+SELECT
+    commit_sha
+FROM
+    app_commits
+WHERE
+    team = $1
+    AND env = $2
+    AND app = $3
+ORDER BY
+    last_seen DESC
+`
+
+type AppCommitsParams struct {
+	Team string
+	Env  string
+	App  string
+}
+
+// AppCommits will fetch the commits an app has run, the last one seen first.
+func (q *Queries) AppCommits(ctx context.Context, arg AppCommitsParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, appCommits, arg.Team, arg.Env, arg.App)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var commit_sha string
+		if err := rows.Scan(&commit_sha); err != nil {
+			return nil, err
+		}
+		items = append(items, commit_sha)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const appFailureEnd = `-- name: AppFailureEnd :exec
+UPDATE
+    app_failures
+SET
+    ended = $4
+WHERE
+    team = $1
+    AND env = $2
+    AND app = $3
+    AND ended IS NULL
+`
+
+type AppFailureEndParams struct {
+	Team  string
+	Env   string
+	App   string
+	Ended pgtype.Timestamptz
+}
+
+// AppFailureEnd will record that an app is no longer failing.
+func (q *Queries) AppFailureEnd(ctx context.Context, arg AppFailureEndParams) error {
+	_, err := q.db.Exec(ctx, appFailureEnd,
+		arg.Team,
+		arg.Env,
+		arg.App,
+		arg.Ended,
+	)
+	return err
+}
+
+const appFailureStart = `-- name: AppFailureStart :exec
+INSERT INTO app_failures (team, env, app, started)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (team, env, app) WHERE ended IS NULL DO NOTHING
+`
+
+type AppFailureStartParams struct {
+	Team    string
+	Env     string
+	App     string
+	Started pgtype.Timestamptz
+}
+
+// AppFailureStart will record that an app has started failing, unless the app is already failing.
+func (q *Queries) AppFailureStart(ctx context.Context, arg AppFailureStartParams) error {
+	_, err := q.db.Exec(ctx, appFailureStart,
+		arg.Team,
+		arg.Env,
+		arg.App,
+		arg.Started,
+	)
+	return err
+}
+
+const appFailures = `-- name: AppFailures :many
+SELECT
+    id, team, env, app, started, ended
+FROM
+    app_failures
+WHERE
+    ended IS NULL
+    OR ended >= $1::timestamptz
+ORDER BY
+    started ASC
+`
+
+// AppFailures will fetch the failures of all apps that have not ended, or ended after the given time.
+func (q *Queries) AppFailures(ctx context.Context, since pgtype.Timestamptz) ([]*AppFailure, error) {
+	rows, err := q.db.Query(ctx, appFailures, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*AppFailure
+	for rows.Next() {
+		var i AppFailure
+		if err := rows.Scan(
+			&i.ID,
+			&i.Team,
+			&i.Env,
+			&i.App,
+			&i.Started,
+			&i.Ended,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const appRollbackInsert = `-- name: AppRollbackInsert :exec
+INSERT INTO app_rollbacks (team, env, app, commit_sha, detected)
+VALUES ($1, $2, $3, $4, $5)
+`
+
+type AppRollbackInsertParams struct {
+	Team      string
+	Env       string
+	App       string
+	CommitSha string
+	Detected  pgtype.Timestamptz
+}
+
+// AppRollbackInsert will record that an app has been rolled back to a commit.
+func (q *Queries) AppRollbackInsert(ctx context.Context, arg AppRollbackInsertParams) error {
+	_, err := q.db.Exec(ctx, appRollbackInsert,
+		arg.Team,
+		arg.Env,
+		arg.App,
+		arg.CommitSha,
+		arg.Detected,
+	)
+	return err
+}
+
+const appRollbacks = `-- name: AppRollbacks :many
+SELECT
+    id, team, env, app, commit_sha, detected
+FROM
+    app_rollbacks
+WHERE
+    detected >= $1::timestamptz
+ORDER BY
+    detected ASC
+`
+
+// AppRollbacks will fetch the rollbacks of all apps detected after the given time.
+func (q *Queries) AppRollbacks(ctx context.Context, since pgtype.Timestamptz) ([]*AppRollback, error) {
+	rows, err := q.db.Query(ctx, appRollbacks, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*AppRollback
+	for rows.Next() {
+		var i AppRollback
+		if err := rows.Scan(
+			&i.ID,
+			&i.Team,
+			&i.Env,
+			&i.App,
+			&i.CommitSha,
+			&i.Detected,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deliveryMetricsForTeam = `-- name: DeliveryMetricsForTeam :many
+SELECT
+    id, date, env, team, app, deployments, failed_deployments, rollbacks, restores, restore_time_seconds, lead_times, lead_time_seconds
+FROM
+    delivery_metrics
+WHERE
+    team = $1
+    AND date >= $2::date
+    AND date <= $3::date
+ORDER BY
+    date, env, app ASC
+`
+
+type DeliveryMetricsForTeamParams struct {
+	Team     string
+	FromDate pgtype.Date
+	ToDate   pgtype.Date
+}
+
+// DeliveryMetricsForTeam will fetch the daily delivery metrics for all apps of a team in a date range.
+func (q *Queries) DeliveryMetricsForTeam(ctx context.Context, arg DeliveryMetricsForTeamParams) ([]*DeliveryMetric, error) {
+	rows, err := q.db.Query(ctx, deliveryMetricsForTeam, arg.Team, arg.FromDate, arg.ToDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*DeliveryMetric
+	for rows.Next() {
+		var i DeliveryMetric
+		if err := rows.Scan(
+			&i.ID,
+			&i.Date,
+			&i.Env,
+			&i.Team,
+			&i.App,
+			&i.Deployments,
+			&i.FailedDeployments,
+			&i.Rollbacks,
+			&i.Restores,
+			&i.RestoreTimeSeconds,
+			&i.LeadTimes,
+			&i.LeadTimeSeconds,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,123 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.23.0
+// source: deploykeys.sql
+
+package gensql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const deployKeyChannelNotified = `-- name: DeployKeyChannelNotified :exec
+UPDATE deploy_keys
+SET notified_channels = array_append(notified_channels, $1::text)
+WHERE team = $2
+`
+
+type DeployKeyChannelNotifiedParams struct {
+	Channel string
+	Team    string
+}
+
+// DeployKeyChannelNotified will record that a Slack channel of the team has been notified about their current deploy
+// key.
+func (q *Queries) DeployKeyChannelNotified(ctx context.Context, arg DeployKeyChannelNotifiedParams) error {
+	_, err := q.db.Exec(ctx, deployKeyChannelNotified, arg.Channel, arg.Team)
+	return err
+}
+
+const deployKeyNotified = `-- name: DeployKeyNotified :exec
+UPDATE deploy_keys
+SET notified_expires = $2
+WHERE team = $1
+`
+
+type DeployKeyNotifiedParams struct {
+	Team            string
+	NotifiedExpires pgtype.Timestamptz
+}
+
+// DeployKeyNotified will record that the team has been notified about their deploy key with the given expiry.
+func (q *Queries) DeployKeyNotified(ctx context.Context, arg DeployKeyNotifiedParams) error {
+	_, err := q.db.Exec(ctx, deployKeyNotified, arg.Team, arg.NotifiedExpires)
+	return err
+}
+
+const deployKeyUpsert = `-- name: DeployKeyUpsert :one
+INSERT INTO deploy_keys (team, created, expires)
+VALUES ($1, $2, $3)
+ON CONFLICT (team) DO
+    UPDATE SET
+        created = EXCLUDED.created,
+        expires = EXCLUDED.expires,
+        notified_channels = CASE
+            WHEN deploy_keys.expires = EXCLUDED.expires THEN deploy_keys.notified_channels
+            ELSE '{}'
+        END,
+        checked_at = NOW()
+RETURNING team, created, expires, checked_at, notified_expires, notified_channels
+`
+
+type DeployKeyUpsertParams struct {
+	Team    string
+	Created pgtype.Timestamptz
+	Expires pgtype.Timestamptz
+}
+
+// DeployKeyUpsert will insert or update the deploy key expiry of a team, and return the stored row. The notified
+// channels are reset when the expiry changes.
+func (q *Queries) DeployKeyUpsert(ctx context.Context, arg DeployKeyUpsertParams) (*DeployKey, error) {
+	row := q.db.QueryRow(ctx, deployKeyUpsert, arg.Team, arg.Created, arg.Expires)
+	var i DeployKey
+	err := row.Scan(
+		&i.Team,
+		&i.Created,
+		&i.Expires,
+		&i.CheckedAt,
+		&i.NotifiedExpires,
+		&i.NotifiedChannels,
+	)
+	return &i, err
+}
+
+const expiringDeployKeys = `-- name: ExpiringDeployKeys :many
+SELECT
+    team, created, expires, checked_at, notified_expires, notified_channels
+FROM
+    deploy_keys
+WHERE
+    expires < $1::timestamptz
+ORDER BY
+    expires, team ASC
+`
+
+// ExpiringDeployKeys will fetch deploy keys that expire before the given time, soonest first.
+func (q *Queries) ExpiringDeployKeys(ctx context.Context, before pgtype.Timestamptz) ([]*DeployKey, error) {
+	rows, err := q.db.Query(ctx, expiringDeployKeys, before)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*DeployKey
+	for rows.Next() {
+		var i DeployKey
+		if err := rows.Scan(
+			&i.Team,
+			&i.Created,
+			&i.Expires,
+			&i.CheckedAt,
+			&i.NotifiedExpires,
+			&i.NotifiedChannels,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
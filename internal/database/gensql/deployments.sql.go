@@ -0,0 +1,234 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.23.0
+// source: deployments.sql
+
+package gensql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const deploymentResourcesForDeployments = `-- name: DeploymentResourcesForDeployments :many
+SELECT
+    id, deployment_id, "group", kind, name, version, namespace
+FROM
+    deployment_resources
+WHERE
+    deployment_id = ANY($1::text[])
+ORDER BY
+    deployment_id, kind, name
+`
+
+// DeploymentResourcesForDeployments will fetch the resources of the given deployments.
+func (q *Queries) DeploymentResourcesForDeployments(ctx context.Context, deploymentIds []string) ([]*DeploymentResource, error) {
+	rows, err := q.db.Query(ctx, deploymentResourcesForDeployments, deploymentIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*DeploymentResource
+	for rows.Next() {
+		var i DeploymentResource
+		if err := rows.Scan(
+			&i.ID,
+			&i.DeploymentID,
+			&i.Group,
+			&i.Kind,
+			&i.Name,
+			&i.Version,
+			&i.Namespace,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deploymentStatusesForDeployments = `-- name: DeploymentStatusesForDeployments :many
+SELECT
+    id, deployment_id, status, message, created
+FROM
+    deployment_statuses
+WHERE
+    deployment_id = ANY($1::text[])
+ORDER BY
+    created DESC
+`
+
+// DeploymentStatusesForDeployments will fetch the statuses of the given deployments.
+func (q *Queries) DeploymentStatusesForDeployments(ctx context.Context, deploymentIds []string) ([]*DeploymentStatus, error) {
+	rows, err := q.db.Query(ctx, deploymentStatusesForDeployments, deploymentIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*DeploymentStatus
+	for rows.Next() {
+		var i DeploymentStatus
+		if err := rows.Scan(
+			&i.ID,
+			&i.DeploymentID,
+			&i.Status,
+			&i.Message,
+			&i.Created,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deploymentUpsert = `-- name: DeploymentUpsert :exec
+INSERT INTO deployments (id, team, env, repository, created, commit_timestamp)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (id) DO
+    UPDATE SET
+        team = EXCLUDED.team,
+        env = EXCLUDED.env,
+        repository = EXCLUDED.repository,
+        created = EXCLUDED.created,
+        commit_timestamp = COALESCE(EXCLUDED.commit_timestamp, deployments.commit_timestamp)
+`
+
+type DeploymentUpsertParams struct {
+	ID              string
+	Team            string
+	Env             string
+	Repository      string
+	Created         pgtype.Timestamptz
+	CommitTimestamp pgtype.Timestamptz
+}
+
+// DeploymentUpsert will insert or update a deployment. A known commit timestamp is kept when the deployment is
+// updated without one, as hookd does not return commit timestamps.
+func (q *Queries) DeploymentUpsert(ctx context.Context, arg DeploymentUpsertParams) error {
+	_, err := q.db.Exec(ctx, deploymentUpsert,
+		arg.ID,
+		arg.Team,
+		arg.Env,
+		arg.Repository,
+		arg.Created,
+		arg.CommitTimestamp,
+	)
+	return err
+}
+
+const deployments = `-- name: Deployments :many
+SELECT
+    id, team, env, repository, created, commit_timestamp
+FROM
+    deployments
+WHERE
+    ($1::text IS NULL OR team = $1)
+    AND ($2::text IS NULL OR env = $2)
+    AND (cardinality($3::text[]) = 0 OR team = ANY($3::text[]))
+    AND NOT (team = ANY($4::text[]))
+    AND ($5::text IS NULL OR repository = $5)
+    AND (
+        ($6::text IS NULL AND $7::text IS NULL)
+        OR EXISTS (
+            SELECT
+                1
+            FROM
+                deployment_resources
+            WHERE
+                deployment_resources.deployment_id = deployments.id
+                AND ($6::text IS NULL OR deployment_resources.kind = $6)
+                AND ($7::text IS NULL OR deployment_resources.name = $7)
+        )
+    )
+    AND (
+        $8::text IS NULL
+        OR $8::text = (
+            CASE COALESCE((
+                SELECT
+                    deployment_statuses.status
+                FROM
+                    deployment_statuses
+                WHERE
+                    deployment_statuses.deployment_id = deployments.id
+                ORDER BY
+                    deployment_statuses.created DESC
+                LIMIT 1
+            ), '')
+                WHEN 'success' THEN 'success'
+                WHEN 'failure' THEN 'failure'
+                WHEN 'error' THEN 'failure'
+                ELSE 'in_progress'
+            END
+        )
+    )
+    AND ($9::timestamptz IS NULL OR created >= $9)
+    AND ($10::timestamptz IS NULL OR created < $10)
+ORDER BY
+    created DESC
+LIMIT
+    $11
+`
+
+type DeploymentsParams struct {
+	Team          *string
+	Env           *string
+	Teams         []string
+	IgnoreTeams   []string
+	Repository    *string
+	ResourceKind  *string
+	ResourceName  *string
+	State         *string
+	CreatedFrom   pgtype.Timestamptz
+	CreatedBefore pgtype.Timestamptz
+	Limit         int32
+}
+
+// Deployments will fetch deployments, newest first. All filters are optional, an empty list of teams includes all teams. The state of a deployment is given by its
+// most recent status, and a deployment without statuses is in progress. created_from is inclusive and created_before is
+// exclusive.
+func (q *Queries) Deployments(ctx context.Context, arg DeploymentsParams) ([]*Deployment, error) {
+	rows, err := q.db.Query(ctx, deployments,
+		arg.Team,
+		arg.Env,
+		arg.Teams,
+		arg.IgnoreTeams,
+		arg.Repository,
+		arg.ResourceKind,
+		arg.ResourceName,
+		arg.State,
+		arg.CreatedFrom,
+		arg.CreatedBefore,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Deployment
+	for rows.Next() {
+		var i Deployment
+		if err := rows.Scan(
+			&i.ID,
+			&i.Team,
+			&i.Env,
+			&i.Repository,
+			&i.Created,
+			&i.CommitTimestamp,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
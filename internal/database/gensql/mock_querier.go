@@ -22,24 +22,67 @@ func (_m *MockQuerier) EXPECT() *MockQuerier_Expecter {
 	return &MockQuerier_Expecter{mock: &_m.Mock}
 }
 
-// AverageResourceUtilizationForTeam provides a mock function with given fields: ctx, arg
-func (_m *MockQuerier) AverageResourceUtilizationForTeam(ctx context.Context, arg AverageResourceUtilizationForTeamParams) (*AverageResourceUtilizationForTeamRow, error) {
+// AppCommitUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) AppCommitUpsert(ctx context.Context, arg AppCommitUpsertParams) error {
 	ret := _m.Called(ctx, arg)
 
-	var r0 *AverageResourceUtilizationForTeamRow
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, AppCommitUpsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_AppCommitUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AppCommitUpsert'
+type MockQuerier_AppCommitUpsert_Call struct {
+	*mock.Call
+}
+
+// AppCommitUpsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg AppCommitUpsertParams
+func (_e *MockQuerier_Expecter) AppCommitUpsert(ctx interface{}, arg interface{}) *MockQuerier_AppCommitUpsert_Call {
+	return &MockQuerier_AppCommitUpsert_Call{Call: _e.mock.On("AppCommitUpsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_AppCommitUpsert_Call) Run(run func(ctx context.Context, arg AppCommitUpsertParams)) *MockQuerier_AppCommitUpsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(AppCommitUpsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_AppCommitUpsert_Call) Return(_a0 error) *MockQuerier_AppCommitUpsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_AppCommitUpsert_Call) RunAndReturn(run func(context.Context, AppCommitUpsertParams) error) *MockQuerier_AppCommitUpsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AppCommits provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) AppCommits(ctx context.Context, arg AppCommitsParams) ([]string, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, AverageResourceUtilizationForTeamParams) (*AverageResourceUtilizationForTeamRow, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, AppCommitsParams) ([]string, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, AverageResourceUtilizationForTeamParams) *AverageResourceUtilizationForTeamRow); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, AppCommitsParams) []string); ok {
 		r0 = rf(ctx, arg)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*AverageResourceUtilizationForTeamRow)
+			r0 = ret.Get(0).([]string)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, AverageResourceUtilizationForTeamParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, AppCommitsParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -48,154 +91,140 @@ func (_m *MockQuerier) AverageResourceUtilizationForTeam(ctx context.Context, ar
 	return r0, r1
 }
 
-// MockQuerier_AverageResourceUtilizationForTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AverageResourceUtilizationForTeam'
-type MockQuerier_AverageResourceUtilizationForTeam_Call struct {
+// MockQuerier_AppCommits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AppCommits'
+type MockQuerier_AppCommits_Call struct {
 	*mock.Call
 }
 
-// AverageResourceUtilizationForTeam is a helper method to define mock.On call
+// AppCommits is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg AverageResourceUtilizationForTeamParams
-func (_e *MockQuerier_Expecter) AverageResourceUtilizationForTeam(ctx interface{}, arg interface{}) *MockQuerier_AverageResourceUtilizationForTeam_Call {
-	return &MockQuerier_AverageResourceUtilizationForTeam_Call{Call: _e.mock.On("AverageResourceUtilizationForTeam", ctx, arg)}
+//   - arg AppCommitsParams
+func (_e *MockQuerier_Expecter) AppCommits(ctx interface{}, arg interface{}) *MockQuerier_AppCommits_Call {
+	return &MockQuerier_AppCommits_Call{Call: _e.mock.On("AppCommits", ctx, arg)}
 }
 
-func (_c *MockQuerier_AverageResourceUtilizationForTeam_Call) Run(run func(ctx context.Context, arg AverageResourceUtilizationForTeamParams)) *MockQuerier_AverageResourceUtilizationForTeam_Call {
+func (_c *MockQuerier_AppCommits_Call) Run(run func(ctx context.Context, arg AppCommitsParams)) *MockQuerier_AppCommits_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(AverageResourceUtilizationForTeamParams))
+		run(args[0].(context.Context), args[1].(AppCommitsParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_AverageResourceUtilizationForTeam_Call) Return(_a0 *AverageResourceUtilizationForTeamRow, _a1 error) *MockQuerier_AverageResourceUtilizationForTeam_Call {
+func (_c *MockQuerier_AppCommits_Call) Return(_a0 []string, _a1 error) *MockQuerier_AppCommits_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_AverageResourceUtilizationForTeam_Call) RunAndReturn(run func(context.Context, AverageResourceUtilizationForTeamParams) (*AverageResourceUtilizationForTeamRow, error)) *MockQuerier_AverageResourceUtilizationForTeam_Call {
+func (_c *MockQuerier_AppCommits_Call) RunAndReturn(run func(context.Context, AppCommitsParams) ([]string, error)) *MockQuerier_AppCommits_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CostUpsert provides a mock function with given fields: ctx, arg
-func (_m *MockQuerier) CostUpsert(ctx context.Context, arg []CostUpsertParams) *CostUpsertBatchResults {
+// AppFailureEnd provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) AppFailureEnd(ctx context.Context, arg AppFailureEndParams) error {
 	ret := _m.Called(ctx, arg)
 
-	var r0 *CostUpsertBatchResults
-	if rf, ok := ret.Get(0).(func(context.Context, []CostUpsertParams) *CostUpsertBatchResults); ok {
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, AppFailureEndParams) error); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*CostUpsertBatchResults)
-		}
+		r0 = ret.Error(0)
 	}
 
 	return r0
 }
 
-// MockQuerier_CostUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CostUpsert'
-type MockQuerier_CostUpsert_Call struct {
+// MockQuerier_AppFailureEnd_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AppFailureEnd'
+type MockQuerier_AppFailureEnd_Call struct {
 	*mock.Call
 }
 
-// CostUpsert is a helper method to define mock.On call
+// AppFailureEnd is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg []CostUpsertParams
-func (_e *MockQuerier_Expecter) CostUpsert(ctx interface{}, arg interface{}) *MockQuerier_CostUpsert_Call {
-	return &MockQuerier_CostUpsert_Call{Call: _e.mock.On("CostUpsert", ctx, arg)}
+//   - arg AppFailureEndParams
+func (_e *MockQuerier_Expecter) AppFailureEnd(ctx interface{}, arg interface{}) *MockQuerier_AppFailureEnd_Call {
+	return &MockQuerier_AppFailureEnd_Call{Call: _e.mock.On("AppFailureEnd", ctx, arg)}
 }
 
-func (_c *MockQuerier_CostUpsert_Call) Run(run func(ctx context.Context, arg []CostUpsertParams)) *MockQuerier_CostUpsert_Call {
+func (_c *MockQuerier_AppFailureEnd_Call) Run(run func(ctx context.Context, arg AppFailureEndParams)) *MockQuerier_AppFailureEnd_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]CostUpsertParams))
+		run(args[0].(context.Context), args[1].(AppFailureEndParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_CostUpsert_Call) Return(_a0 *CostUpsertBatchResults) *MockQuerier_CostUpsert_Call {
+func (_c *MockQuerier_AppFailureEnd_Call) Return(_a0 error) *MockQuerier_AppFailureEnd_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockQuerier_CostUpsert_Call) RunAndReturn(run func(context.Context, []CostUpsertParams) *CostUpsertBatchResults) *MockQuerier_CostUpsert_Call {
+func (_c *MockQuerier_AppFailureEnd_Call) RunAndReturn(run func(context.Context, AppFailureEndParams) error) *MockQuerier_AppFailureEnd_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DailyCostForApp provides a mock function with given fields: ctx, arg
-func (_m *MockQuerier) DailyCostForApp(ctx context.Context, arg DailyCostForAppParams) ([]*Cost, error) {
+// AppFailureStart provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) AppFailureStart(ctx context.Context, arg AppFailureStartParams) error {
 	ret := _m.Called(ctx, arg)
 
-	var r0 []*Cost
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DailyCostForAppParams) ([]*Cost, error)); ok {
-		return rf(ctx, arg)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, DailyCostForAppParams) []*Cost); ok {
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, AppFailureStartParams) error); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*Cost)
-		}
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, DailyCostForAppParams) error); ok {
-		r1 = rf(ctx, arg)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockQuerier_DailyCostForApp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DailyCostForApp'
-type MockQuerier_DailyCostForApp_Call struct {
+// MockQuerier_AppFailureStart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AppFailureStart'
+type MockQuerier_AppFailureStart_Call struct {
 	*mock.Call
 }
 
-// DailyCostForApp is a helper method to define mock.On call
+// AppFailureStart is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg DailyCostForAppParams
-func (_e *MockQuerier_Expecter) DailyCostForApp(ctx interface{}, arg interface{}) *MockQuerier_DailyCostForApp_Call {
-	return &MockQuerier_DailyCostForApp_Call{Call: _e.mock.On("DailyCostForApp", ctx, arg)}
+//   - arg AppFailureStartParams
+func (_e *MockQuerier_Expecter) AppFailureStart(ctx interface{}, arg interface{}) *MockQuerier_AppFailureStart_Call {
+	return &MockQuerier_AppFailureStart_Call{Call: _e.mock.On("AppFailureStart", ctx, arg)}
 }
 
-func (_c *MockQuerier_DailyCostForApp_Call) Run(run func(ctx context.Context, arg DailyCostForAppParams)) *MockQuerier_DailyCostForApp_Call {
+func (_c *MockQuerier_AppFailureStart_Call) Run(run func(ctx context.Context, arg AppFailureStartParams)) *MockQuerier_AppFailureStart_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DailyCostForAppParams))
+		run(args[0].(context.Context), args[1].(AppFailureStartParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_DailyCostForApp_Call) Return(_a0 []*Cost, _a1 error) *MockQuerier_DailyCostForApp_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockQuerier_AppFailureStart_Call) Return(_a0 error) *MockQuerier_AppFailureStart_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockQuerier_DailyCostForApp_Call) RunAndReturn(run func(context.Context, DailyCostForAppParams) ([]*Cost, error)) *MockQuerier_DailyCostForApp_Call {
+func (_c *MockQuerier_AppFailureStart_Call) RunAndReturn(run func(context.Context, AppFailureStartParams) error) *MockQuerier_AppFailureStart_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DailyCostForTeam provides a mock function with given fields: ctx, arg
-func (_m *MockQuerier) DailyCostForTeam(ctx context.Context, arg DailyCostForTeamParams) ([]*Cost, error) {
-	ret := _m.Called(ctx, arg)
+// AppFailures provides a mock function with given fields: ctx, since
+func (_m *MockQuerier) AppFailures(ctx context.Context, since pgtype.Timestamptz) ([]*AppFailure, error) {
+	ret := _m.Called(ctx, since)
 
-	var r0 []*Cost
+	var r0 []*AppFailure
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DailyCostForTeamParams) ([]*Cost, error)); ok {
-		return rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) ([]*AppFailure, error)); ok {
+		return rf(ctx, since)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, DailyCostForTeamParams) []*Cost); ok {
-		r0 = rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) []*AppFailure); ok {
+		r0 = rf(ctx, since)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*Cost)
+			r0 = ret.Get(0).([]*AppFailure)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, DailyCostForTeamParams) error); ok {
-		r1 = rf(ctx, arg)
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Timestamptz) error); ok {
+		r1 = rf(ctx, since)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -203,107 +232,97 @@ func (_m *MockQuerier) DailyCostForTeam(ctx context.Context, arg DailyCostForTea
 	return r0, r1
 }
 
-// MockQuerier_DailyCostForTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DailyCostForTeam'
-type MockQuerier_DailyCostForTeam_Call struct {
+// MockQuerier_AppFailures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AppFailures'
+type MockQuerier_AppFailures_Call struct {
 	*mock.Call
 }
 
-// DailyCostForTeam is a helper method to define mock.On call
+// AppFailures is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg DailyCostForTeamParams
-func (_e *MockQuerier_Expecter) DailyCostForTeam(ctx interface{}, arg interface{}) *MockQuerier_DailyCostForTeam_Call {
-	return &MockQuerier_DailyCostForTeam_Call{Call: _e.mock.On("DailyCostForTeam", ctx, arg)}
+//   - since pgtype.Timestamptz
+func (_e *MockQuerier_Expecter) AppFailures(ctx interface{}, since interface{}) *MockQuerier_AppFailures_Call {
+	return &MockQuerier_AppFailures_Call{Call: _e.mock.On("AppFailures", ctx, since)}
 }
 
-func (_c *MockQuerier_DailyCostForTeam_Call) Run(run func(ctx context.Context, arg DailyCostForTeamParams)) *MockQuerier_DailyCostForTeam_Call {
+func (_c *MockQuerier_AppFailures_Call) Run(run func(ctx context.Context, since pgtype.Timestamptz)) *MockQuerier_AppFailures_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DailyCostForTeamParams))
+		run(args[0].(context.Context), args[1].(pgtype.Timestamptz))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_DailyCostForTeam_Call) Return(_a0 []*Cost, _a1 error) *MockQuerier_DailyCostForTeam_Call {
+func (_c *MockQuerier_AppFailures_Call) Return(_a0 []*AppFailure, _a1 error) *MockQuerier_AppFailures_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_DailyCostForTeam_Call) RunAndReturn(run func(context.Context, DailyCostForTeamParams) ([]*Cost, error)) *MockQuerier_DailyCostForTeam_Call {
+func (_c *MockQuerier_AppFailures_Call) RunAndReturn(run func(context.Context, pgtype.Timestamptz) ([]*AppFailure, error)) *MockQuerier_AppFailures_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DailyEnvCostForTeam provides a mock function with given fields: ctx, arg
-func (_m *MockQuerier) DailyEnvCostForTeam(ctx context.Context, arg DailyEnvCostForTeamParams) ([]*DailyEnvCostForTeamRow, error) {
+// AppRollbackInsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) AppRollbackInsert(ctx context.Context, arg AppRollbackInsertParams) error {
 	ret := _m.Called(ctx, arg)
 
-	var r0 []*DailyEnvCostForTeamRow
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, DailyEnvCostForTeamParams) ([]*DailyEnvCostForTeamRow, error)); ok {
-		return rf(ctx, arg)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, DailyEnvCostForTeamParams) []*DailyEnvCostForTeamRow); ok {
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, AppRollbackInsertParams) error); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*DailyEnvCostForTeamRow)
-		}
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, DailyEnvCostForTeamParams) error); ok {
-		r1 = rf(ctx, arg)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockQuerier_DailyEnvCostForTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DailyEnvCostForTeam'
-type MockQuerier_DailyEnvCostForTeam_Call struct {
+// MockQuerier_AppRollbackInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AppRollbackInsert'
+type MockQuerier_AppRollbackInsert_Call struct {
 	*mock.Call
 }
 
-// DailyEnvCostForTeam is a helper method to define mock.On call
+// AppRollbackInsert is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg DailyEnvCostForTeamParams
-func (_e *MockQuerier_Expecter) DailyEnvCostForTeam(ctx interface{}, arg interface{}) *MockQuerier_DailyEnvCostForTeam_Call {
-	return &MockQuerier_DailyEnvCostForTeam_Call{Call: _e.mock.On("DailyEnvCostForTeam", ctx, arg)}
+//   - arg AppRollbackInsertParams
+func (_e *MockQuerier_Expecter) AppRollbackInsert(ctx interface{}, arg interface{}) *MockQuerier_AppRollbackInsert_Call {
+	return &MockQuerier_AppRollbackInsert_Call{Call: _e.mock.On("AppRollbackInsert", ctx, arg)}
 }
 
-func (_c *MockQuerier_DailyEnvCostForTeam_Call) Run(run func(ctx context.Context, arg DailyEnvCostForTeamParams)) *MockQuerier_DailyEnvCostForTeam_Call {
+func (_c *MockQuerier_AppRollbackInsert_Call) Run(run func(ctx context.Context, arg AppRollbackInsertParams)) *MockQuerier_AppRollbackInsert_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(DailyEnvCostForTeamParams))
+		run(args[0].(context.Context), args[1].(AppRollbackInsertParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_DailyEnvCostForTeam_Call) Return(_a0 []*DailyEnvCostForTeamRow, _a1 error) *MockQuerier_DailyEnvCostForTeam_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockQuerier_AppRollbackInsert_Call) Return(_a0 error) *MockQuerier_AppRollbackInsert_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockQuerier_DailyEnvCostForTeam_Call) RunAndReturn(run func(context.Context, DailyEnvCostForTeamParams) ([]*DailyEnvCostForTeamRow, error)) *MockQuerier_DailyEnvCostForTeam_Call {
+func (_c *MockQuerier_AppRollbackInsert_Call) RunAndReturn(run func(context.Context, AppRollbackInsertParams) error) *MockQuerier_AppRollbackInsert_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LastCostDate provides a mock function with given fields: ctx
-func (_m *MockQuerier) LastCostDate(ctx context.Context) (pgtype.Date, error) {
-	ret := _m.Called(ctx)
+// AppRollbacks provides a mock function with given fields: ctx, since
+func (_m *MockQuerier) AppRollbacks(ctx context.Context, since pgtype.Timestamptz) ([]*AppRollback, error) {
+	ret := _m.Called(ctx, since)
 
-	var r0 pgtype.Date
+	var r0 []*AppRollback
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (pgtype.Date, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) ([]*AppRollback, error)); ok {
+		return rf(ctx, since)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) pgtype.Date); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) []*AppRollback); ok {
+		r0 = rf(ctx, since)
 	} else {
-		r0 = ret.Get(0).(pgtype.Date)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*AppRollback)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Timestamptz) error); ok {
+		r1 = rf(ctx, since)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -311,51 +330,54 @@ func (_m *MockQuerier) LastCostDate(ctx context.Context) (pgtype.Date, error) {
 	return r0, r1
 }
 
-// MockQuerier_LastCostDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LastCostDate'
-type MockQuerier_LastCostDate_Call struct {
+// MockQuerier_AppRollbacks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AppRollbacks'
+type MockQuerier_AppRollbacks_Call struct {
 	*mock.Call
 }
 
-// LastCostDate is a helper method to define mock.On call
+// AppRollbacks is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockQuerier_Expecter) LastCostDate(ctx interface{}) *MockQuerier_LastCostDate_Call {
-	return &MockQuerier_LastCostDate_Call{Call: _e.mock.On("LastCostDate", ctx)}
+//   - since pgtype.Timestamptz
+func (_e *MockQuerier_Expecter) AppRollbacks(ctx interface{}, since interface{}) *MockQuerier_AppRollbacks_Call {
+	return &MockQuerier_AppRollbacks_Call{Call: _e.mock.On("AppRollbacks", ctx, since)}
 }
 
-func (_c *MockQuerier_LastCostDate_Call) Run(run func(ctx context.Context)) *MockQuerier_LastCostDate_Call {
+func (_c *MockQuerier_AppRollbacks_Call) Run(run func(ctx context.Context, since pgtype.Timestamptz)) *MockQuerier_AppRollbacks_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(pgtype.Timestamptz))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_LastCostDate_Call) Return(_a0 pgtype.Date, _a1 error) *MockQuerier_LastCostDate_Call {
+func (_c *MockQuerier_AppRollbacks_Call) Return(_a0 []*AppRollback, _a1 error) *MockQuerier_AppRollbacks_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_LastCostDate_Call) RunAndReturn(run func(context.Context) (pgtype.Date, error)) *MockQuerier_LastCostDate_Call {
+func (_c *MockQuerier_AppRollbacks_Call) RunAndReturn(run func(context.Context, pgtype.Timestamptz) ([]*AppRollback, error)) *MockQuerier_AppRollbacks_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// MaxResourceUtilizationDate provides a mock function with given fields: ctx
-func (_m *MockQuerier) MaxResourceUtilizationDate(ctx context.Context) (pgtype.Timestamptz, error) {
-	ret := _m.Called(ctx)
+// AverageResourceUtilizationForTeam provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) AverageResourceUtilizationForTeam(ctx context.Context, arg AverageResourceUtilizationForTeamParams) (*AverageResourceUtilizationForTeamRow, error) {
+	ret := _m.Called(ctx, arg)
 
-	var r0 pgtype.Timestamptz
+	var r0 *AverageResourceUtilizationForTeamRow
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) (pgtype.Timestamptz, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, AverageResourceUtilizationForTeamParams) (*AverageResourceUtilizationForTeamRow, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) pgtype.Timestamptz); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, AverageResourceUtilizationForTeamParams) *AverageResourceUtilizationForTeamRow); ok {
+		r0 = rf(ctx, arg)
 	} else {
-		r0 = ret.Get(0).(pgtype.Timestamptz)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*AverageResourceUtilizationForTeamRow)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, AverageResourceUtilizationForTeamParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -363,52 +385,53 @@ func (_m *MockQuerier) MaxResourceUtilizationDate(ctx context.Context) (pgtype.T
 	return r0, r1
 }
 
-// MockQuerier_MaxResourceUtilizationDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MaxResourceUtilizationDate'
-type MockQuerier_MaxResourceUtilizationDate_Call struct {
+// MockQuerier_AverageResourceUtilizationForTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AverageResourceUtilizationForTeam'
+type MockQuerier_AverageResourceUtilizationForTeam_Call struct {
 	*mock.Call
 }
 
-// MaxResourceUtilizationDate is a helper method to define mock.On call
+// AverageResourceUtilizationForTeam is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockQuerier_Expecter) MaxResourceUtilizationDate(ctx interface{}) *MockQuerier_MaxResourceUtilizationDate_Call {
-	return &MockQuerier_MaxResourceUtilizationDate_Call{Call: _e.mock.On("MaxResourceUtilizationDate", ctx)}
+//   - arg AverageResourceUtilizationForTeamParams
+func (_e *MockQuerier_Expecter) AverageResourceUtilizationForTeam(ctx interface{}, arg interface{}) *MockQuerier_AverageResourceUtilizationForTeam_Call {
+	return &MockQuerier_AverageResourceUtilizationForTeam_Call{Call: _e.mock.On("AverageResourceUtilizationForTeam", ctx, arg)}
 }
 
-func (_c *MockQuerier_MaxResourceUtilizationDate_Call) Run(run func(ctx context.Context)) *MockQuerier_MaxResourceUtilizationDate_Call {
+func (_c *MockQuerier_AverageResourceUtilizationForTeam_Call) Run(run func(ctx context.Context, arg AverageResourceUtilizationForTeamParams)) *MockQuerier_AverageResourceUtilizationForTeam_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(AverageResourceUtilizationForTeamParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_MaxResourceUtilizationDate_Call) Return(_a0 pgtype.Timestamptz, _a1 error) *MockQuerier_MaxResourceUtilizationDate_Call {
+func (_c *MockQuerier_AverageResourceUtilizationForTeam_Call) Return(_a0 *AverageResourceUtilizationForTeamRow, _a1 error) *MockQuerier_AverageResourceUtilizationForTeam_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_MaxResourceUtilizationDate_Call) RunAndReturn(run func(context.Context) (pgtype.Timestamptz, error)) *MockQuerier_MaxResourceUtilizationDate_Call {
+func (_c *MockQuerier_AverageResourceUtilizationForTeam_Call) RunAndReturn(run func(context.Context, AverageResourceUtilizationForTeamParams) (*AverageResourceUtilizationForTeamRow, error)) *MockQuerier_AverageResourceUtilizationForTeam_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// MonthlyCostForApp provides a mock function with given fields: ctx, arg
-func (_m *MockQuerier) MonthlyCostForApp(ctx context.Context, arg MonthlyCostForAppParams) ([]*MonthlyCostForAppRow, error) {
+// ComponentUsage provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) ComponentUsage(ctx context.Context, arg ComponentUsageParams) ([]*VulnerabilityIndexComponent, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 []*MonthlyCostForAppRow
+	var r0 []*VulnerabilityIndexComponent
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, MonthlyCostForAppParams) ([]*MonthlyCostForAppRow, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, ComponentUsageParams) ([]*VulnerabilityIndexComponent, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, MonthlyCostForAppParams) []*MonthlyCostForAppRow); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, ComponentUsageParams) []*VulnerabilityIndexComponent); ok {
 		r0 = rf(ctx, arg)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*MonthlyCostForAppRow)
+			r0 = ret.Get(0).([]*VulnerabilityIndexComponent)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, MonthlyCostForAppParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, ComponentUsageParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -417,54 +440,54 @@ func (_m *MockQuerier) MonthlyCostForApp(ctx context.Context, arg MonthlyCostFor
 	return r0, r1
 }
 
-// MockQuerier_MonthlyCostForApp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MonthlyCostForApp'
-type MockQuerier_MonthlyCostForApp_Call struct {
+// MockQuerier_ComponentUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ComponentUsage'
+type MockQuerier_ComponentUsage_Call struct {
 	*mock.Call
 }
 
-// MonthlyCostForApp is a helper method to define mock.On call
+// ComponentUsage is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg MonthlyCostForAppParams
-func (_e *MockQuerier_Expecter) MonthlyCostForApp(ctx interface{}, arg interface{}) *MockQuerier_MonthlyCostForApp_Call {
-	return &MockQuerier_MonthlyCostForApp_Call{Call: _e.mock.On("MonthlyCostForApp", ctx, arg)}
+//   - arg ComponentUsageParams
+func (_e *MockQuerier_Expecter) ComponentUsage(ctx interface{}, arg interface{}) *MockQuerier_ComponentUsage_Call {
+	return &MockQuerier_ComponentUsage_Call{Call: _e.mock.On("ComponentUsage", ctx, arg)}
 }
 
-func (_c *MockQuerier_MonthlyCostForApp_Call) Run(run func(ctx context.Context, arg MonthlyCostForAppParams)) *MockQuerier_MonthlyCostForApp_Call {
+func (_c *MockQuerier_ComponentUsage_Call) Run(run func(ctx context.Context, arg ComponentUsageParams)) *MockQuerier_ComponentUsage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(MonthlyCostForAppParams))
+		run(args[0].(context.Context), args[1].(ComponentUsageParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_MonthlyCostForApp_Call) Return(_a0 []*MonthlyCostForAppRow, _a1 error) *MockQuerier_MonthlyCostForApp_Call {
+func (_c *MockQuerier_ComponentUsage_Call) Return(_a0 []*VulnerabilityIndexComponent, _a1 error) *MockQuerier_ComponentUsage_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_MonthlyCostForApp_Call) RunAndReturn(run func(context.Context, MonthlyCostForAppParams) ([]*MonthlyCostForAppRow, error)) *MockQuerier_MonthlyCostForApp_Call {
+func (_c *MockQuerier_ComponentUsage_Call) RunAndReturn(run func(context.Context, ComponentUsageParams) ([]*VulnerabilityIndexComponent, error)) *MockQuerier_ComponentUsage_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// MonthlyCostForTeam provides a mock function with given fields: ctx, team
-func (_m *MockQuerier) MonthlyCostForTeam(ctx context.Context, team *string) ([]*MonthlyCostForTeamRow, error) {
-	ret := _m.Called(ctx, team)
+// CostAnomaliesForTeam provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CostAnomaliesForTeam(ctx context.Context, arg CostAnomaliesForTeamParams) ([]*CostAnomaly, error) {
+	ret := _m.Called(ctx, arg)
 
-	var r0 []*MonthlyCostForTeamRow
+	var r0 []*CostAnomaly
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *string) ([]*MonthlyCostForTeamRow, error)); ok {
-		return rf(ctx, team)
+	if rf, ok := ret.Get(0).(func(context.Context, CostAnomaliesForTeamParams) ([]*CostAnomaly, error)); ok {
+		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *string) []*MonthlyCostForTeamRow); ok {
-		r0 = rf(ctx, team)
+	if rf, ok := ret.Get(0).(func(context.Context, CostAnomaliesForTeamParams) []*CostAnomaly); ok {
+		r0 = rf(ctx, arg)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*MonthlyCostForTeamRow)
+			r0 = ret.Get(0).([]*CostAnomaly)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *string) error); ok {
-		r1 = rf(ctx, team)
+	if rf, ok := ret.Get(1).(func(context.Context, CostAnomaliesForTeamParams) error); ok {
+		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -472,108 +495,96 @@ func (_m *MockQuerier) MonthlyCostForTeam(ctx context.Context, team *string) ([]
 	return r0, r1
 }
 
-// MockQuerier_MonthlyCostForTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MonthlyCostForTeam'
-type MockQuerier_MonthlyCostForTeam_Call struct {
+// MockQuerier_CostAnomaliesForTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CostAnomaliesForTeam'
+type MockQuerier_CostAnomaliesForTeam_Call struct {
 	*mock.Call
 }
 
-// MonthlyCostForTeam is a helper method to define mock.On call
+// CostAnomaliesForTeam is a helper method to define mock.On call
 //   - ctx context.Context
-//   - team *string
-func (_e *MockQuerier_Expecter) MonthlyCostForTeam(ctx interface{}, team interface{}) *MockQuerier_MonthlyCostForTeam_Call {
-	return &MockQuerier_MonthlyCostForTeam_Call{Call: _e.mock.On("MonthlyCostForTeam", ctx, team)}
+//   - arg CostAnomaliesForTeamParams
+func (_e *MockQuerier_Expecter) CostAnomaliesForTeam(ctx interface{}, arg interface{}) *MockQuerier_CostAnomaliesForTeam_Call {
+	return &MockQuerier_CostAnomaliesForTeam_Call{Call: _e.mock.On("CostAnomaliesForTeam", ctx, arg)}
 }
 
-func (_c *MockQuerier_MonthlyCostForTeam_Call) Run(run func(ctx context.Context, team *string)) *MockQuerier_MonthlyCostForTeam_Call {
+func (_c *MockQuerier_CostAnomaliesForTeam_Call) Run(run func(ctx context.Context, arg CostAnomaliesForTeamParams)) *MockQuerier_CostAnomaliesForTeam_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*string))
+		run(args[0].(context.Context), args[1].(CostAnomaliesForTeamParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_MonthlyCostForTeam_Call) Return(_a0 []*MonthlyCostForTeamRow, _a1 error) *MockQuerier_MonthlyCostForTeam_Call {
+func (_c *MockQuerier_CostAnomaliesForTeam_Call) Return(_a0 []*CostAnomaly, _a1 error) *MockQuerier_CostAnomaliesForTeam_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_MonthlyCostForTeam_Call) RunAndReturn(run func(context.Context, *string) ([]*MonthlyCostForTeamRow, error)) *MockQuerier_MonthlyCostForTeam_Call {
+func (_c *MockQuerier_CostAnomaliesForTeam_Call) RunAndReturn(run func(context.Context, CostAnomaliesForTeamParams) ([]*CostAnomaly, error)) *MockQuerier_CostAnomaliesForTeam_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ResourceUtilizationForApp provides a mock function with given fields: ctx, arg
-func (_m *MockQuerier) ResourceUtilizationForApp(ctx context.Context, arg ResourceUtilizationForAppParams) ([]*ResourceUtilizationMetric, error) {
+// CostAnomalyUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CostAnomalyUpsert(ctx context.Context, arg CostAnomalyUpsertParams) error {
 	ret := _m.Called(ctx, arg)
 
-	var r0 []*ResourceUtilizationMetric
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, ResourceUtilizationForAppParams) ([]*ResourceUtilizationMetric, error)); ok {
-		return rf(ctx, arg)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, ResourceUtilizationForAppParams) []*ResourceUtilizationMetric); ok {
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, CostAnomalyUpsertParams) error); ok {
 		r0 = rf(ctx, arg)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*ResourceUtilizationMetric)
-		}
-	}
-
-	if rf, ok := ret.Get(1).(func(context.Context, ResourceUtilizationForAppParams) error); ok {
-		r1 = rf(ctx, arg)
-	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockQuerier_ResourceUtilizationForApp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResourceUtilizationForApp'
-type MockQuerier_ResourceUtilizationForApp_Call struct {
+// MockQuerier_CostAnomalyUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CostAnomalyUpsert'
+type MockQuerier_CostAnomalyUpsert_Call struct {
 	*mock.Call
 }
 
-// ResourceUtilizationForApp is a helper method to define mock.On call
+// CostAnomalyUpsert is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg ResourceUtilizationForAppParams
-func (_e *MockQuerier_Expecter) ResourceUtilizationForApp(ctx interface{}, arg interface{}) *MockQuerier_ResourceUtilizationForApp_Call {
-	return &MockQuerier_ResourceUtilizationForApp_Call{Call: _e.mock.On("ResourceUtilizationForApp", ctx, arg)}
+//   - arg CostAnomalyUpsertParams
+func (_e *MockQuerier_Expecter) CostAnomalyUpsert(ctx interface{}, arg interface{}) *MockQuerier_CostAnomalyUpsert_Call {
+	return &MockQuerier_CostAnomalyUpsert_Call{Call: _e.mock.On("CostAnomalyUpsert", ctx, arg)}
 }
 
-func (_c *MockQuerier_ResourceUtilizationForApp_Call) Run(run func(ctx context.Context, arg ResourceUtilizationForAppParams)) *MockQuerier_ResourceUtilizationForApp_Call {
+func (_c *MockQuerier_CostAnomalyUpsert_Call) Run(run func(ctx context.Context, arg CostAnomalyUpsertParams)) *MockQuerier_CostAnomalyUpsert_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(ResourceUtilizationForAppParams))
+		run(args[0].(context.Context), args[1].(CostAnomalyUpsertParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_ResourceUtilizationForApp_Call) Return(_a0 []*ResourceUtilizationMetric, _a1 error) *MockQuerier_ResourceUtilizationForApp_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockQuerier_CostAnomalyUpsert_Call) Return(_a0 error) *MockQuerier_CostAnomalyUpsert_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockQuerier_ResourceUtilizationForApp_Call) RunAndReturn(run func(context.Context, ResourceUtilizationForAppParams) ([]*ResourceUtilizationMetric, error)) *MockQuerier_ResourceUtilizationForApp_Call {
+func (_c *MockQuerier_CostAnomalyUpsert_Call) RunAndReturn(run func(context.Context, CostAnomalyUpsertParams) error) *MockQuerier_CostAnomalyUpsert_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ResourceUtilizationForTeam provides a mock function with given fields: ctx, arg
-func (_m *MockQuerier) ResourceUtilizationForTeam(ctx context.Context, arg ResourceUtilizationForTeamParams) ([]*ResourceUtilizationForTeamRow, error) {
+// CostBreakdownForTeam provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CostBreakdownForTeam(ctx context.Context, arg CostBreakdownForTeamParams) ([]*CostBreakdownForTeamRow, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 []*ResourceUtilizationForTeamRow
+	var r0 []*CostBreakdownForTeamRow
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, ResourceUtilizationForTeamParams) ([]*ResourceUtilizationForTeamRow, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, CostBreakdownForTeamParams) ([]*CostBreakdownForTeamRow, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, ResourceUtilizationForTeamParams) []*ResourceUtilizationForTeamRow); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, CostBreakdownForTeamParams) []*CostBreakdownForTeamRow); ok {
 		r0 = rf(ctx, arg)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*ResourceUtilizationForTeamRow)
+			r0 = ret.Get(0).([]*CostBreakdownForTeamRow)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, ResourceUtilizationForTeamParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, CostBreakdownForTeamParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -582,54 +593,54 @@ func (_m *MockQuerier) ResourceUtilizationForTeam(ctx context.Context, arg Resou
 	return r0, r1
 }
 
-// MockQuerier_ResourceUtilizationForTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResourceUtilizationForTeam'
-type MockQuerier_ResourceUtilizationForTeam_Call struct {
+// MockQuerier_CostBreakdownForTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CostBreakdownForTeam'
+type MockQuerier_CostBreakdownForTeam_Call struct {
 	*mock.Call
 }
 
-// ResourceUtilizationForTeam is a helper method to define mock.On call
+// CostBreakdownForTeam is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg ResourceUtilizationForTeamParams
-func (_e *MockQuerier_Expecter) ResourceUtilizationForTeam(ctx interface{}, arg interface{}) *MockQuerier_ResourceUtilizationForTeam_Call {
-	return &MockQuerier_ResourceUtilizationForTeam_Call{Call: _e.mock.On("ResourceUtilizationForTeam", ctx, arg)}
+//   - arg CostBreakdownForTeamParams
+func (_e *MockQuerier_Expecter) CostBreakdownForTeam(ctx interface{}, arg interface{}) *MockQuerier_CostBreakdownForTeam_Call {
+	return &MockQuerier_CostBreakdownForTeam_Call{Call: _e.mock.On("CostBreakdownForTeam", ctx, arg)}
 }
 
-func (_c *MockQuerier_ResourceUtilizationForTeam_Call) Run(run func(ctx context.Context, arg ResourceUtilizationForTeamParams)) *MockQuerier_ResourceUtilizationForTeam_Call {
+func (_c *MockQuerier_CostBreakdownForTeam_Call) Run(run func(ctx context.Context, arg CostBreakdownForTeamParams)) *MockQuerier_CostBreakdownForTeam_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(ResourceUtilizationForTeamParams))
+		run(args[0].(context.Context), args[1].(CostBreakdownForTeamParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_ResourceUtilizationForTeam_Call) Return(_a0 []*ResourceUtilizationForTeamRow, _a1 error) *MockQuerier_ResourceUtilizationForTeam_Call {
+func (_c *MockQuerier_CostBreakdownForTeam_Call) Return(_a0 []*CostBreakdownForTeamRow, _a1 error) *MockQuerier_CostBreakdownForTeam_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_ResourceUtilizationForTeam_Call) RunAndReturn(run func(context.Context, ResourceUtilizationForTeamParams) ([]*ResourceUtilizationForTeamRow, error)) *MockQuerier_ResourceUtilizationForTeam_Call {
+func (_c *MockQuerier_CostBreakdownForTeam_Call) RunAndReturn(run func(context.Context, CostBreakdownForTeamParams) ([]*CostBreakdownForTeamRow, error)) *MockQuerier_CostBreakdownForTeam_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ResourceUtilizationOverageForTeam provides a mock function with given fields: ctx, arg
-func (_m *MockQuerier) ResourceUtilizationOverageForTeam(ctx context.Context, arg ResourceUtilizationOverageForTeamParams) ([]*ResourceUtilizationOverageForTeamRow, error) {
-	ret := _m.Called(ctx, arg)
+// CostBudget provides a mock function with given fields: ctx, team
+func (_m *MockQuerier) CostBudget(ctx context.Context, team string) (*CostBudget, error) {
+	ret := _m.Called(ctx, team)
 
-	var r0 []*ResourceUtilizationOverageForTeamRow
+	var r0 *CostBudget
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, ResourceUtilizationOverageForTeamParams) ([]*ResourceUtilizationOverageForTeamRow, error)); ok {
-		return rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*CostBudget, error)); ok {
+		return rf(ctx, team)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, ResourceUtilizationOverageForTeamParams) []*ResourceUtilizationOverageForTeamRow); ok {
-		r0 = rf(ctx, arg)
+	if rf, ok := ret.Get(0).(func(context.Context, string) *CostBudget); ok {
+		r0 = rf(ctx, team)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*ResourceUtilizationOverageForTeamRow)
+			r0 = ret.Get(0).(*CostBudget)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, ResourceUtilizationOverageForTeamParams) error); ok {
-		r1 = rf(ctx, arg)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, team)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -637,53 +648,139 @@ func (_m *MockQuerier) ResourceUtilizationOverageForTeam(ctx context.Context, ar
 	return r0, r1
 }
 
-// MockQuerier_ResourceUtilizationOverageForTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResourceUtilizationOverageForTeam'
-type MockQuerier_ResourceUtilizationOverageForTeam_Call struct {
+// MockQuerier_CostBudget_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CostBudget'
+type MockQuerier_CostBudget_Call struct {
 	*mock.Call
 }
 
-// ResourceUtilizationOverageForTeam is a helper method to define mock.On call
+// CostBudget is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg ResourceUtilizationOverageForTeamParams
-func (_e *MockQuerier_Expecter) ResourceUtilizationOverageForTeam(ctx interface{}, arg interface{}) *MockQuerier_ResourceUtilizationOverageForTeam_Call {
-	return &MockQuerier_ResourceUtilizationOverageForTeam_Call{Call: _e.mock.On("ResourceUtilizationOverageForTeam", ctx, arg)}
+//   - team string
+func (_e *MockQuerier_Expecter) CostBudget(ctx interface{}, team interface{}) *MockQuerier_CostBudget_Call {
+	return &MockQuerier_CostBudget_Call{Call: _e.mock.On("CostBudget", ctx, team)}
 }
 
-func (_c *MockQuerier_ResourceUtilizationOverageForTeam_Call) Run(run func(ctx context.Context, arg ResourceUtilizationOverageForTeamParams)) *MockQuerier_ResourceUtilizationOverageForTeam_Call {
+func (_c *MockQuerier_CostBudget_Call) Run(run func(ctx context.Context, team string)) *MockQuerier_CostBudget_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(ResourceUtilizationOverageForTeamParams))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_ResourceUtilizationOverageForTeam_Call) Return(_a0 []*ResourceUtilizationOverageForTeamRow, _a1 error) *MockQuerier_ResourceUtilizationOverageForTeam_Call {
+func (_c *MockQuerier_CostBudget_Call) Return(_a0 *CostBudget, _a1 error) *MockQuerier_CostBudget_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_ResourceUtilizationOverageForTeam_Call) RunAndReturn(run func(context.Context, ResourceUtilizationOverageForTeamParams) ([]*ResourceUtilizationOverageForTeamRow, error)) *MockQuerier_ResourceUtilizationOverageForTeam_Call {
+func (_c *MockQuerier_CostBudget_Call) RunAndReturn(run func(context.Context, string) (*CostBudget, error)) *MockQuerier_CostBudget_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ResourceUtilizationRangeForApp provides a mock function with given fields: ctx, arg
-func (_m *MockQuerier) ResourceUtilizationRangeForApp(ctx context.Context, arg ResourceUtilizationRangeForAppParams) (*ResourceUtilizationRangeForAppRow, error) {
+// CostBudgetDelete provides a mock function with given fields: ctx, team
+func (_m *MockQuerier) CostBudgetDelete(ctx context.Context, team string) error {
+	ret := _m.Called(ctx, team)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, team)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_CostBudgetDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CostBudgetDelete'
+type MockQuerier_CostBudgetDelete_Call struct {
+	*mock.Call
+}
+
+// CostBudgetDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - team string
+func (_e *MockQuerier_Expecter) CostBudgetDelete(ctx interface{}, team interface{}) *MockQuerier_CostBudgetDelete_Call {
+	return &MockQuerier_CostBudgetDelete_Call{Call: _e.mock.On("CostBudgetDelete", ctx, team)}
+}
+
+func (_c *MockQuerier_CostBudgetDelete_Call) Run(run func(ctx context.Context, team string)) *MockQuerier_CostBudgetDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CostBudgetDelete_Call) Return(_a0 error) *MockQuerier_CostBudgetDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_CostBudgetDelete_Call) RunAndReturn(run func(context.Context, string) error) *MockQuerier_CostBudgetDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CostBudgetNotified provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CostBudgetNotified(ctx context.Context, arg CostBudgetNotifiedParams) error {
 	ret := _m.Called(ctx, arg)
 
-	var r0 *ResourceUtilizationRangeForAppRow
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, CostBudgetNotifiedParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_CostBudgetNotified_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CostBudgetNotified'
+type MockQuerier_CostBudgetNotified_Call struct {
+	*mock.Call
+}
+
+// CostBudgetNotified is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CostBudgetNotifiedParams
+func (_e *MockQuerier_Expecter) CostBudgetNotified(ctx interface{}, arg interface{}) *MockQuerier_CostBudgetNotified_Call {
+	return &MockQuerier_CostBudgetNotified_Call{Call: _e.mock.On("CostBudgetNotified", ctx, arg)}
+}
+
+func (_c *MockQuerier_CostBudgetNotified_Call) Run(run func(ctx context.Context, arg CostBudgetNotifiedParams)) *MockQuerier_CostBudgetNotified_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(CostBudgetNotifiedParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CostBudgetNotified_Call) Return(_a0 error) *MockQuerier_CostBudgetNotified_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_CostBudgetNotified_Call) RunAndReturn(run func(context.Context, CostBudgetNotifiedParams) error) *MockQuerier_CostBudgetNotified_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CostBudgetUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CostBudgetUpsert(ctx context.Context, arg CostBudgetUpsertParams) (*CostBudget, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *CostBudget
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, ResourceUtilizationRangeForAppParams) (*ResourceUtilizationRangeForAppRow, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, CostBudgetUpsertParams) (*CostBudget, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, ResourceUtilizationRangeForAppParams) *ResourceUtilizationRangeForAppRow); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, CostBudgetUpsertParams) *CostBudget); ok {
 		r0 = rf(ctx, arg)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ResourceUtilizationRangeForAppRow)
+			r0 = ret.Get(0).(*CostBudget)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, ResourceUtilizationRangeForAppParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, CostBudgetUpsertParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -692,54 +789,54 @@ func (_m *MockQuerier) ResourceUtilizationRangeForApp(ctx context.Context, arg R
 	return r0, r1
 }
 
-// MockQuerier_ResourceUtilizationRangeForApp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResourceUtilizationRangeForApp'
-type MockQuerier_ResourceUtilizationRangeForApp_Call struct {
+// MockQuerier_CostBudgetUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CostBudgetUpsert'
+type MockQuerier_CostBudgetUpsert_Call struct {
 	*mock.Call
 }
 
-// ResourceUtilizationRangeForApp is a helper method to define mock.On call
+// CostBudgetUpsert is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg ResourceUtilizationRangeForAppParams
-func (_e *MockQuerier_Expecter) ResourceUtilizationRangeForApp(ctx interface{}, arg interface{}) *MockQuerier_ResourceUtilizationRangeForApp_Call {
-	return &MockQuerier_ResourceUtilizationRangeForApp_Call{Call: _e.mock.On("ResourceUtilizationRangeForApp", ctx, arg)}
+//   - arg CostBudgetUpsertParams
+func (_e *MockQuerier_Expecter) CostBudgetUpsert(ctx interface{}, arg interface{}) *MockQuerier_CostBudgetUpsert_Call {
+	return &MockQuerier_CostBudgetUpsert_Call{Call: _e.mock.On("CostBudgetUpsert", ctx, arg)}
 }
 
-func (_c *MockQuerier_ResourceUtilizationRangeForApp_Call) Run(run func(ctx context.Context, arg ResourceUtilizationRangeForAppParams)) *MockQuerier_ResourceUtilizationRangeForApp_Call {
+func (_c *MockQuerier_CostBudgetUpsert_Call) Run(run func(ctx context.Context, arg CostBudgetUpsertParams)) *MockQuerier_CostBudgetUpsert_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(ResourceUtilizationRangeForAppParams))
+		run(args[0].(context.Context), args[1].(CostBudgetUpsertParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_ResourceUtilizationRangeForApp_Call) Return(_a0 *ResourceUtilizationRangeForAppRow, _a1 error) *MockQuerier_ResourceUtilizationRangeForApp_Call {
+func (_c *MockQuerier_CostBudgetUpsert_Call) Return(_a0 *CostBudget, _a1 error) *MockQuerier_CostBudgetUpsert_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_ResourceUtilizationRangeForApp_Call) RunAndReturn(run func(context.Context, ResourceUtilizationRangeForAppParams) (*ResourceUtilizationRangeForAppRow, error)) *MockQuerier_ResourceUtilizationRangeForApp_Call {
+func (_c *MockQuerier_CostBudgetUpsert_Call) RunAndReturn(run func(context.Context, CostBudgetUpsertParams) (*CostBudget, error)) *MockQuerier_CostBudgetUpsert_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ResourceUtilizationRangeForTeam provides a mock function with given fields: ctx, team
-func (_m *MockQuerier) ResourceUtilizationRangeForTeam(ctx context.Context, team string) (*ResourceUtilizationRangeForTeamRow, error) {
-	ret := _m.Called(ctx, team)
+// CostBudgets provides a mock function with given fields: ctx
+func (_m *MockQuerier) CostBudgets(ctx context.Context) ([]*CostBudget, error) {
+	ret := _m.Called(ctx)
 
-	var r0 *ResourceUtilizationRangeForTeamRow
+	var r0 []*CostBudget
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*ResourceUtilizationRangeForTeamRow, error)); ok {
-		return rf(ctx, team)
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*CostBudget, error)); ok {
+		return rf(ctx)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *ResourceUtilizationRangeForTeamRow); ok {
-		r0 = rf(ctx, team)
+	if rf, ok := ret.Get(0).(func(context.Context) []*CostBudget); ok {
+		r0 = rf(ctx)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ResourceUtilizationRangeForTeamRow)
+			r0 = ret.Get(0).([]*CostBudget)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, team)
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -747,98 +844,107 @@ func (_m *MockQuerier) ResourceUtilizationRangeForTeam(ctx context.Context, team
 	return r0, r1
 }
 
-// MockQuerier_ResourceUtilizationRangeForTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResourceUtilizationRangeForTeam'
-type MockQuerier_ResourceUtilizationRangeForTeam_Call struct {
+// MockQuerier_CostBudgets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CostBudgets'
+type MockQuerier_CostBudgets_Call struct {
 	*mock.Call
 }
 
-// ResourceUtilizationRangeForTeam is a helper method to define mock.On call
+// CostBudgets is a helper method to define mock.On call
 //   - ctx context.Context
-//   - team string
-func (_e *MockQuerier_Expecter) ResourceUtilizationRangeForTeam(ctx interface{}, team interface{}) *MockQuerier_ResourceUtilizationRangeForTeam_Call {
-	return &MockQuerier_ResourceUtilizationRangeForTeam_Call{Call: _e.mock.On("ResourceUtilizationRangeForTeam", ctx, team)}
+func (_e *MockQuerier_Expecter) CostBudgets(ctx interface{}) *MockQuerier_CostBudgets_Call {
+	return &MockQuerier_CostBudgets_Call{Call: _e.mock.On("CostBudgets", ctx)}
 }
 
-func (_c *MockQuerier_ResourceUtilizationRangeForTeam_Call) Run(run func(ctx context.Context, team string)) *MockQuerier_ResourceUtilizationRangeForTeam_Call {
+func (_c *MockQuerier_CostBudgets_Call) Run(run func(ctx context.Context)) *MockQuerier_CostBudgets_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_ResourceUtilizationRangeForTeam_Call) Return(_a0 *ResourceUtilizationRangeForTeamRow, _a1 error) *MockQuerier_ResourceUtilizationRangeForTeam_Call {
+func (_c *MockQuerier_CostBudgets_Call) Return(_a0 []*CostBudget, _a1 error) *MockQuerier_CostBudgets_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_ResourceUtilizationRangeForTeam_Call) RunAndReturn(run func(context.Context, string) (*ResourceUtilizationRangeForTeamRow, error)) *MockQuerier_ResourceUtilizationRangeForTeam_Call {
+func (_c *MockQuerier_CostBudgets_Call) RunAndReturn(run func(context.Context) ([]*CostBudget, error)) *MockQuerier_CostBudgets_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ResourceUtilizationUpsert provides a mock function with given fields: ctx, arg
-func (_m *MockQuerier) ResourceUtilizationUpsert(ctx context.Context, arg []ResourceUtilizationUpsertParams) *ResourceUtilizationUpsertBatchResults {
+// CostExport provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CostExport(ctx context.Context, arg CostExportParams) ([]*CostExportRow, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 *ResourceUtilizationUpsertBatchResults
-	if rf, ok := ret.Get(0).(func(context.Context, []ResourceUtilizationUpsertParams) *ResourceUtilizationUpsertBatchResults); ok {
+	var r0 []*CostExportRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, CostExportParams) ([]*CostExportRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, CostExportParams) []*CostExportRow); ok {
 		r0 = rf(ctx, arg)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*ResourceUtilizationUpsertBatchResults)
+			r0 = ret.Get(0).([]*CostExportRow)
 		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, CostExportParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockQuerier_ResourceUtilizationUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResourceUtilizationUpsert'
-type MockQuerier_ResourceUtilizationUpsert_Call struct {
+// MockQuerier_CostExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CostExport'
+type MockQuerier_CostExport_Call struct {
 	*mock.Call
 }
 
-// ResourceUtilizationUpsert is a helper method to define mock.On call
+// CostExport is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg []ResourceUtilizationUpsertParams
-func (_e *MockQuerier_Expecter) ResourceUtilizationUpsert(ctx interface{}, arg interface{}) *MockQuerier_ResourceUtilizationUpsert_Call {
-	return &MockQuerier_ResourceUtilizationUpsert_Call{Call: _e.mock.On("ResourceUtilizationUpsert", ctx, arg)}
+//   - arg CostExportParams
+func (_e *MockQuerier_Expecter) CostExport(ctx interface{}, arg interface{}) *MockQuerier_CostExport_Call {
+	return &MockQuerier_CostExport_Call{Call: _e.mock.On("CostExport", ctx, arg)}
 }
 
-func (_c *MockQuerier_ResourceUtilizationUpsert_Call) Run(run func(ctx context.Context, arg []ResourceUtilizationUpsertParams)) *MockQuerier_ResourceUtilizationUpsert_Call {
+func (_c *MockQuerier_CostExport_Call) Run(run func(ctx context.Context, arg CostExportParams)) *MockQuerier_CostExport_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].([]ResourceUtilizationUpsertParams))
+		run(args[0].(context.Context), args[1].(CostExportParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_ResourceUtilizationUpsert_Call) Return(_a0 *ResourceUtilizationUpsertBatchResults) *MockQuerier_ResourceUtilizationUpsert_Call {
-	_c.Call.Return(_a0)
+func (_c *MockQuerier_CostExport_Call) Return(_a0 []*CostExportRow, _a1 error) *MockQuerier_CostExport_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_ResourceUtilizationUpsert_Call) RunAndReturn(run func(context.Context, []ResourceUtilizationUpsertParams) *ResourceUtilizationUpsertBatchResults) *MockQuerier_ResourceUtilizationUpsert_Call {
+func (_c *MockQuerier_CostExport_Call) RunAndReturn(run func(context.Context, CostExportParams) ([]*CostExportRow, error)) *MockQuerier_CostExport_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SpecificResourceUtilizationForApp provides a mock function with given fields: ctx, arg
-func (_m *MockQuerier) SpecificResourceUtilizationForApp(ctx context.Context, arg SpecificResourceUtilizationForAppParams) (*SpecificResourceUtilizationForAppRow, error) {
+// CostForTeams provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CostForTeams(ctx context.Context, arg CostForTeamsParams) ([]*CostForTeamsRow, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 *SpecificResourceUtilizationForAppRow
+	var r0 []*CostForTeamsRow
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, SpecificResourceUtilizationForAppParams) (*SpecificResourceUtilizationForAppRow, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, CostForTeamsParams) ([]*CostForTeamsRow, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, SpecificResourceUtilizationForAppParams) *SpecificResourceUtilizationForAppRow); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, CostForTeamsParams) []*CostForTeamsRow); ok {
 		r0 = rf(ctx, arg)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*SpecificResourceUtilizationForAppRow)
+			r0 = ret.Get(0).([]*CostForTeamsRow)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, SpecificResourceUtilizationForAppParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, CostForTeamsParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -847,53 +953,53 @@ func (_m *MockQuerier) SpecificResourceUtilizationForApp(ctx context.Context, ar
 	return r0, r1
 }
 
-// MockQuerier_SpecificResourceUtilizationForApp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SpecificResourceUtilizationForApp'
-type MockQuerier_SpecificResourceUtilizationForApp_Call struct {
+// MockQuerier_CostForTeams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CostForTeams'
+type MockQuerier_CostForTeams_Call struct {
 	*mock.Call
 }
 
-// SpecificResourceUtilizationForApp is a helper method to define mock.On call
+// CostForTeams is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg SpecificResourceUtilizationForAppParams
-func (_e *MockQuerier_Expecter) SpecificResourceUtilizationForApp(ctx interface{}, arg interface{}) *MockQuerier_SpecificResourceUtilizationForApp_Call {
-	return &MockQuerier_SpecificResourceUtilizationForApp_Call{Call: _e.mock.On("SpecificResourceUtilizationForApp", ctx, arg)}
+//   - arg CostForTeamsParams
+func (_e *MockQuerier_Expecter) CostForTeams(ctx interface{}, arg interface{}) *MockQuerier_CostForTeams_Call {
+	return &MockQuerier_CostForTeams_Call{Call: _e.mock.On("CostForTeams", ctx, arg)}
 }
 
-func (_c *MockQuerier_SpecificResourceUtilizationForApp_Call) Run(run func(ctx context.Context, arg SpecificResourceUtilizationForAppParams)) *MockQuerier_SpecificResourceUtilizationForApp_Call {
+func (_c *MockQuerier_CostForTeams_Call) Run(run func(ctx context.Context, arg CostForTeamsParams)) *MockQuerier_CostForTeams_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(SpecificResourceUtilizationForAppParams))
+		run(args[0].(context.Context), args[1].(CostForTeamsParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_SpecificResourceUtilizationForApp_Call) Return(_a0 *SpecificResourceUtilizationForAppRow, _a1 error) *MockQuerier_SpecificResourceUtilizationForApp_Call {
+func (_c *MockQuerier_CostForTeams_Call) Return(_a0 []*CostForTeamsRow, _a1 error) *MockQuerier_CostForTeams_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_SpecificResourceUtilizationForApp_Call) RunAndReturn(run func(context.Context, SpecificResourceUtilizationForAppParams) (*SpecificResourceUtilizationForAppRow, error)) *MockQuerier_SpecificResourceUtilizationForApp_Call {
+func (_c *MockQuerier_CostForTeams_Call) RunAndReturn(run func(context.Context, CostForTeamsParams) ([]*CostForTeamsRow, error)) *MockQuerier_CostForTeams_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SpecificResourceUtilizationForTeam provides a mock function with given fields: ctx, arg
-func (_m *MockQuerier) SpecificResourceUtilizationForTeam(ctx context.Context, arg SpecificResourceUtilizationForTeamParams) (*SpecificResourceUtilizationForTeamRow, error) {
+// CostSeries provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CostSeries(ctx context.Context, arg CostSeriesParams) ([]*CostSeriesRow, error) {
 	ret := _m.Called(ctx, arg)
 
-	var r0 *SpecificResourceUtilizationForTeamRow
+	var r0 []*CostSeriesRow
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, SpecificResourceUtilizationForTeamParams) (*SpecificResourceUtilizationForTeamRow, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, CostSeriesParams) ([]*CostSeriesRow, error)); ok {
 		return rf(ctx, arg)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, SpecificResourceUtilizationForTeamParams) *SpecificResourceUtilizationForTeamRow); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, CostSeriesParams) []*CostSeriesRow); ok {
 		r0 = rf(ctx, arg)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*SpecificResourceUtilizationForTeamRow)
+			r0 = ret.Get(0).([]*CostSeriesRow)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, SpecificResourceUtilizationForTeamParams) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, CostSeriesParams) error); ok {
 		r1 = rf(ctx, arg)
 	} else {
 		r1 = ret.Error(1)
@@ -902,31 +1008,2526 @@ func (_m *MockQuerier) SpecificResourceUtilizationForTeam(ctx context.Context, a
 	return r0, r1
 }
 
-// MockQuerier_SpecificResourceUtilizationForTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SpecificResourceUtilizationForTeam'
-type MockQuerier_SpecificResourceUtilizationForTeam_Call struct {
+// MockQuerier_CostSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CostSeries'
+type MockQuerier_CostSeries_Call struct {
 	*mock.Call
 }
 
-// SpecificResourceUtilizationForTeam is a helper method to define mock.On call
+// CostSeries is a helper method to define mock.On call
 //   - ctx context.Context
-//   - arg SpecificResourceUtilizationForTeamParams
-func (_e *MockQuerier_Expecter) SpecificResourceUtilizationForTeam(ctx interface{}, arg interface{}) *MockQuerier_SpecificResourceUtilizationForTeam_Call {
-	return &MockQuerier_SpecificResourceUtilizationForTeam_Call{Call: _e.mock.On("SpecificResourceUtilizationForTeam", ctx, arg)}
+//   - arg CostSeriesParams
+func (_e *MockQuerier_Expecter) CostSeries(ctx interface{}, arg interface{}) *MockQuerier_CostSeries_Call {
+	return &MockQuerier_CostSeries_Call{Call: _e.mock.On("CostSeries", ctx, arg)}
 }
 
-func (_c *MockQuerier_SpecificResourceUtilizationForTeam_Call) Run(run func(ctx context.Context, arg SpecificResourceUtilizationForTeamParams)) *MockQuerier_SpecificResourceUtilizationForTeam_Call {
+func (_c *MockQuerier_CostSeries_Call) Run(run func(ctx context.Context, arg CostSeriesParams)) *MockQuerier_CostSeries_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(SpecificResourceUtilizationForTeamParams))
+		run(args[0].(context.Context), args[1].(CostSeriesParams))
 	})
 	return _c
 }
 
-func (_c *MockQuerier_SpecificResourceUtilizationForTeam_Call) Return(_a0 *SpecificResourceUtilizationForTeamRow, _a1 error) *MockQuerier_SpecificResourceUtilizationForTeam_Call {
+func (_c *MockQuerier_CostSeries_Call) Return(_a0 []*CostSeriesRow, _a1 error) *MockQuerier_CostSeries_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockQuerier_SpecificResourceUtilizationForTeam_Call) RunAndReturn(run func(context.Context, SpecificResourceUtilizationForTeamParams) (*SpecificResourceUtilizationForTeamRow, error)) *MockQuerier_SpecificResourceUtilizationForTeam_Call {
+func (_c *MockQuerier_CostSeries_Call) RunAndReturn(run func(context.Context, CostSeriesParams) ([]*CostSeriesRow, error)) *MockQuerier_CostSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CostUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CostUpsert(ctx context.Context, arg []CostUpsertParams) *CostUpsertBatchResults {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *CostUpsertBatchResults
+	if rf, ok := ret.Get(0).(func(context.Context, []CostUpsertParams) *CostUpsertBatchResults); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*CostUpsertBatchResults)
+		}
+	}
+
+	return r0
+}
+
+// MockQuerier_CostUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CostUpsert'
+type MockQuerier_CostUpsert_Call struct {
+	*mock.Call
+}
+
+// CostUpsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []CostUpsertParams
+func (_e *MockQuerier_Expecter) CostUpsert(ctx interface{}, arg interface{}) *MockQuerier_CostUpsert_Call {
+	return &MockQuerier_CostUpsert_Call{Call: _e.mock.On("CostUpsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_CostUpsert_Call) Run(run func(ctx context.Context, arg []CostUpsertParams)) *MockQuerier_CostUpsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]CostUpsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CostUpsert_Call) Return(_a0 *CostUpsertBatchResults) *MockQuerier_CostUpsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_CostUpsert_Call) RunAndReturn(run func(context.Context, []CostUpsertParams) *CostUpsertBatchResults) *MockQuerier_CostUpsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CriticalFindingCreate provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CriticalFindingCreate(ctx context.Context, arg CriticalFindingCreateParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, CriticalFindingCreateParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_CriticalFindingCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CriticalFindingCreate'
+type MockQuerier_CriticalFindingCreate_Call struct {
+	*mock.Call
+}
+
+// CriticalFindingCreate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CriticalFindingCreateParams
+func (_e *MockQuerier_Expecter) CriticalFindingCreate(ctx interface{}, arg interface{}) *MockQuerier_CriticalFindingCreate_Call {
+	return &MockQuerier_CriticalFindingCreate_Call{Call: _e.mock.On("CriticalFindingCreate", ctx, arg)}
+}
+
+func (_c *MockQuerier_CriticalFindingCreate_Call) Run(run func(ctx context.Context, arg CriticalFindingCreateParams)) *MockQuerier_CriticalFindingCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(CriticalFindingCreateParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CriticalFindingCreate_Call) Return(_a0 error) *MockQuerier_CriticalFindingCreate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_CriticalFindingCreate_Call) RunAndReturn(run func(context.Context, CriticalFindingCreateParams) error) *MockQuerier_CriticalFindingCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CriticalFindingResolve provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CriticalFindingResolve(ctx context.Context, arg CriticalFindingResolveParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, CriticalFindingResolveParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_CriticalFindingResolve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CriticalFindingResolve'
+type MockQuerier_CriticalFindingResolve_Call struct {
+	*mock.Call
+}
+
+// CriticalFindingResolve is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CriticalFindingResolveParams
+func (_e *MockQuerier_Expecter) CriticalFindingResolve(ctx interface{}, arg interface{}) *MockQuerier_CriticalFindingResolve_Call {
+	return &MockQuerier_CriticalFindingResolve_Call{Call: _e.mock.On("CriticalFindingResolve", ctx, arg)}
+}
+
+func (_c *MockQuerier_CriticalFindingResolve_Call) Run(run func(ctx context.Context, arg CriticalFindingResolveParams)) *MockQuerier_CriticalFindingResolve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(CriticalFindingResolveParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CriticalFindingResolve_Call) Return(_a0 error) *MockQuerier_CriticalFindingResolve_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_CriticalFindingResolve_Call) RunAndReturn(run func(context.Context, CriticalFindingResolveParams) error) *MockQuerier_CriticalFindingResolve_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CriticalFindingsRemediation provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CriticalFindingsRemediation(ctx context.Context, arg CriticalFindingsRemediationParams) (*CriticalFindingsRemediationRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *CriticalFindingsRemediationRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, CriticalFindingsRemediationParams) (*CriticalFindingsRemediationRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, CriticalFindingsRemediationParams) *CriticalFindingsRemediationRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*CriticalFindingsRemediationRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, CriticalFindingsRemediationParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CriticalFindingsRemediation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CriticalFindingsRemediation'
+type MockQuerier_CriticalFindingsRemediation_Call struct {
+	*mock.Call
+}
+
+// CriticalFindingsRemediation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CriticalFindingsRemediationParams
+func (_e *MockQuerier_Expecter) CriticalFindingsRemediation(ctx interface{}, arg interface{}) *MockQuerier_CriticalFindingsRemediation_Call {
+	return &MockQuerier_CriticalFindingsRemediation_Call{Call: _e.mock.On("CriticalFindingsRemediation", ctx, arg)}
+}
+
+func (_c *MockQuerier_CriticalFindingsRemediation_Call) Run(run func(ctx context.Context, arg CriticalFindingsRemediationParams)) *MockQuerier_CriticalFindingsRemediation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(CriticalFindingsRemediationParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CriticalFindingsRemediation_Call) Return(_a0 *CriticalFindingsRemediationRow, _a1 error) *MockQuerier_CriticalFindingsRemediation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CriticalFindingsRemediation_Call) RunAndReturn(run func(context.Context, CriticalFindingsRemediationParams) (*CriticalFindingsRemediationRow, error)) *MockQuerier_CriticalFindingsRemediation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CriticalFindingsResolveForRemovedApps provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CriticalFindingsResolveForRemovedApps(ctx context.Context, arg CriticalFindingsResolveForRemovedAppsParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, CriticalFindingsResolveForRemovedAppsParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_CriticalFindingsResolveForRemovedApps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CriticalFindingsResolveForRemovedApps'
+type MockQuerier_CriticalFindingsResolveForRemovedApps_Call struct {
+	*mock.Call
+}
+
+// CriticalFindingsResolveForRemovedApps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg CriticalFindingsResolveForRemovedAppsParams
+func (_e *MockQuerier_Expecter) CriticalFindingsResolveForRemovedApps(ctx interface{}, arg interface{}) *MockQuerier_CriticalFindingsResolveForRemovedApps_Call {
+	return &MockQuerier_CriticalFindingsResolveForRemovedApps_Call{Call: _e.mock.On("CriticalFindingsResolveForRemovedApps", ctx, arg)}
+}
+
+func (_c *MockQuerier_CriticalFindingsResolveForRemovedApps_Call) Run(run func(ctx context.Context, arg CriticalFindingsResolveForRemovedAppsParams)) *MockQuerier_CriticalFindingsResolveForRemovedApps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(CriticalFindingsResolveForRemovedAppsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CriticalFindingsResolveForRemovedApps_Call) Return(_a0 error) *MockQuerier_CriticalFindingsResolveForRemovedApps_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_CriticalFindingsResolveForRemovedApps_Call) RunAndReturn(run func(context.Context, CriticalFindingsResolveForRemovedAppsParams) error) *MockQuerier_CriticalFindingsResolveForRemovedApps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DailyCostForApp provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) DailyCostForApp(ctx context.Context, arg DailyCostForAppParams) ([]*Cost, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*Cost
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DailyCostForAppParams) ([]*Cost, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DailyCostForAppParams) []*Cost); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Cost)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DailyCostForAppParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_DailyCostForApp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DailyCostForApp'
+type MockQuerier_DailyCostForApp_Call struct {
+	*mock.Call
+}
+
+// DailyCostForApp is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg DailyCostForAppParams
+func (_e *MockQuerier_Expecter) DailyCostForApp(ctx interface{}, arg interface{}) *MockQuerier_DailyCostForApp_Call {
+	return &MockQuerier_DailyCostForApp_Call{Call: _e.mock.On("DailyCostForApp", ctx, arg)}
+}
+
+func (_c *MockQuerier_DailyCostForApp_Call) Run(run func(ctx context.Context, arg DailyCostForAppParams)) *MockQuerier_DailyCostForApp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DailyCostForAppParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DailyCostForApp_Call) Return(_a0 []*Cost, _a1 error) *MockQuerier_DailyCostForApp_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_DailyCostForApp_Call) RunAndReturn(run func(context.Context, DailyCostForAppParams) ([]*Cost, error)) *MockQuerier_DailyCostForApp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DailyCostForTeam provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) DailyCostForTeam(ctx context.Context, arg DailyCostForTeamParams) ([]*Cost, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*Cost
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DailyCostForTeamParams) ([]*Cost, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DailyCostForTeamParams) []*Cost); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Cost)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DailyCostForTeamParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_DailyCostForTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DailyCostForTeam'
+type MockQuerier_DailyCostForTeam_Call struct {
+	*mock.Call
+}
+
+// DailyCostForTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg DailyCostForTeamParams
+func (_e *MockQuerier_Expecter) DailyCostForTeam(ctx interface{}, arg interface{}) *MockQuerier_DailyCostForTeam_Call {
+	return &MockQuerier_DailyCostForTeam_Call{Call: _e.mock.On("DailyCostForTeam", ctx, arg)}
+}
+
+func (_c *MockQuerier_DailyCostForTeam_Call) Run(run func(ctx context.Context, arg DailyCostForTeamParams)) *MockQuerier_DailyCostForTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DailyCostForTeamParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DailyCostForTeam_Call) Return(_a0 []*Cost, _a1 error) *MockQuerier_DailyCostForTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_DailyCostForTeam_Call) RunAndReturn(run func(context.Context, DailyCostForTeamParams) ([]*Cost, error)) *MockQuerier_DailyCostForTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DailyEnvCostForTeam provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) DailyEnvCostForTeam(ctx context.Context, arg DailyEnvCostForTeamParams) ([]*DailyEnvCostForTeamRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*DailyEnvCostForTeamRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DailyEnvCostForTeamParams) ([]*DailyEnvCostForTeamRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DailyEnvCostForTeamParams) []*DailyEnvCostForTeamRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*DailyEnvCostForTeamRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DailyEnvCostForTeamParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_DailyEnvCostForTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DailyEnvCostForTeam'
+type MockQuerier_DailyEnvCostForTeam_Call struct {
+	*mock.Call
+}
+
+// DailyEnvCostForTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg DailyEnvCostForTeamParams
+func (_e *MockQuerier_Expecter) DailyEnvCostForTeam(ctx interface{}, arg interface{}) *MockQuerier_DailyEnvCostForTeam_Call {
+	return &MockQuerier_DailyEnvCostForTeam_Call{Call: _e.mock.On("DailyEnvCostForTeam", ctx, arg)}
+}
+
+func (_c *MockQuerier_DailyEnvCostForTeam_Call) Run(run func(ctx context.Context, arg DailyEnvCostForTeamParams)) *MockQuerier_DailyEnvCostForTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DailyEnvCostForTeamParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DailyEnvCostForTeam_Call) Return(_a0 []*DailyEnvCostForTeamRow, _a1 error) *MockQuerier_DailyEnvCostForTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_DailyEnvCostForTeam_Call) RunAndReturn(run func(context.Context, DailyEnvCostForTeamParams) ([]*DailyEnvCostForTeamRow, error)) *MockQuerier_DailyEnvCostForTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeliveryMetricsForTeam provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) DeliveryMetricsForTeam(ctx context.Context, arg DeliveryMetricsForTeamParams) ([]*DeliveryMetric, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*DeliveryMetric
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DeliveryMetricsForTeamParams) ([]*DeliveryMetric, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DeliveryMetricsForTeamParams) []*DeliveryMetric); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*DeliveryMetric)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DeliveryMetricsForTeamParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_DeliveryMetricsForTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeliveryMetricsForTeam'
+type MockQuerier_DeliveryMetricsForTeam_Call struct {
+	*mock.Call
+}
+
+// DeliveryMetricsForTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg DeliveryMetricsForTeamParams
+func (_e *MockQuerier_Expecter) DeliveryMetricsForTeam(ctx interface{}, arg interface{}) *MockQuerier_DeliveryMetricsForTeam_Call {
+	return &MockQuerier_DeliveryMetricsForTeam_Call{Call: _e.mock.On("DeliveryMetricsForTeam", ctx, arg)}
+}
+
+func (_c *MockQuerier_DeliveryMetricsForTeam_Call) Run(run func(ctx context.Context, arg DeliveryMetricsForTeamParams)) *MockQuerier_DeliveryMetricsForTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DeliveryMetricsForTeamParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeliveryMetricsForTeam_Call) Return(_a0 []*DeliveryMetric, _a1 error) *MockQuerier_DeliveryMetricsForTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_DeliveryMetricsForTeam_Call) RunAndReturn(run func(context.Context, DeliveryMetricsForTeamParams) ([]*DeliveryMetric, error)) *MockQuerier_DeliveryMetricsForTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeliveryMetricsUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) DeliveryMetricsUpsert(ctx context.Context, arg []DeliveryMetricsUpsertParams) *DeliveryMetricsUpsertBatchResults {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *DeliveryMetricsUpsertBatchResults
+	if rf, ok := ret.Get(0).(func(context.Context, []DeliveryMetricsUpsertParams) *DeliveryMetricsUpsertBatchResults); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*DeliveryMetricsUpsertBatchResults)
+		}
+	}
+
+	return r0
+}
+
+// MockQuerier_DeliveryMetricsUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeliveryMetricsUpsert'
+type MockQuerier_DeliveryMetricsUpsert_Call struct {
+	*mock.Call
+}
+
+// DeliveryMetricsUpsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []DeliveryMetricsUpsertParams
+func (_e *MockQuerier_Expecter) DeliveryMetricsUpsert(ctx interface{}, arg interface{}) *MockQuerier_DeliveryMetricsUpsert_Call {
+	return &MockQuerier_DeliveryMetricsUpsert_Call{Call: _e.mock.On("DeliveryMetricsUpsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_DeliveryMetricsUpsert_Call) Run(run func(ctx context.Context, arg []DeliveryMetricsUpsertParams)) *MockQuerier_DeliveryMetricsUpsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]DeliveryMetricsUpsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeliveryMetricsUpsert_Call) Return(_a0 *DeliveryMetricsUpsertBatchResults) *MockQuerier_DeliveryMetricsUpsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_DeliveryMetricsUpsert_Call) RunAndReturn(run func(context.Context, []DeliveryMetricsUpsertParams) *DeliveryMetricsUpsertBatchResults) *MockQuerier_DeliveryMetricsUpsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeployKeyChannelNotified provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) DeployKeyChannelNotified(ctx context.Context, arg DeployKeyChannelNotifiedParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DeployKeyChannelNotifiedParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_DeployKeyChannelNotified_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeployKeyChannelNotified'
+type MockQuerier_DeployKeyChannelNotified_Call struct {
+	*mock.Call
+}
+
+// DeployKeyChannelNotified is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg DeployKeyChannelNotifiedParams
+func (_e *MockQuerier_Expecter) DeployKeyChannelNotified(ctx interface{}, arg interface{}) *MockQuerier_DeployKeyChannelNotified_Call {
+	return &MockQuerier_DeployKeyChannelNotified_Call{Call: _e.mock.On("DeployKeyChannelNotified", ctx, arg)}
+}
+
+func (_c *MockQuerier_DeployKeyChannelNotified_Call) Run(run func(ctx context.Context, arg DeployKeyChannelNotifiedParams)) *MockQuerier_DeployKeyChannelNotified_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DeployKeyChannelNotifiedParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeployKeyChannelNotified_Call) Return(_a0 error) *MockQuerier_DeployKeyChannelNotified_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_DeployKeyChannelNotified_Call) RunAndReturn(run func(context.Context, DeployKeyChannelNotifiedParams) error) *MockQuerier_DeployKeyChannelNotified_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeployKeyNotified provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) DeployKeyNotified(ctx context.Context, arg DeployKeyNotifiedParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DeployKeyNotifiedParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_DeployKeyNotified_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeployKeyNotified'
+type MockQuerier_DeployKeyNotified_Call struct {
+	*mock.Call
+}
+
+// DeployKeyNotified is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg DeployKeyNotifiedParams
+func (_e *MockQuerier_Expecter) DeployKeyNotified(ctx interface{}, arg interface{}) *MockQuerier_DeployKeyNotified_Call {
+	return &MockQuerier_DeployKeyNotified_Call{Call: _e.mock.On("DeployKeyNotified", ctx, arg)}
+}
+
+func (_c *MockQuerier_DeployKeyNotified_Call) Run(run func(ctx context.Context, arg DeployKeyNotifiedParams)) *MockQuerier_DeployKeyNotified_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DeployKeyNotifiedParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeployKeyNotified_Call) Return(_a0 error) *MockQuerier_DeployKeyNotified_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_DeployKeyNotified_Call) RunAndReturn(run func(context.Context, DeployKeyNotifiedParams) error) *MockQuerier_DeployKeyNotified_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeployKeyUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) DeployKeyUpsert(ctx context.Context, arg DeployKeyUpsertParams) (*DeployKey, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *DeployKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DeployKeyUpsertParams) (*DeployKey, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DeployKeyUpsertParams) *DeployKey); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*DeployKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DeployKeyUpsertParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_DeployKeyUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeployKeyUpsert'
+type MockQuerier_DeployKeyUpsert_Call struct {
+	*mock.Call
+}
+
+// DeployKeyUpsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg DeployKeyUpsertParams
+func (_e *MockQuerier_Expecter) DeployKeyUpsert(ctx interface{}, arg interface{}) *MockQuerier_DeployKeyUpsert_Call {
+	return &MockQuerier_DeployKeyUpsert_Call{Call: _e.mock.On("DeployKeyUpsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_DeployKeyUpsert_Call) Run(run func(ctx context.Context, arg DeployKeyUpsertParams)) *MockQuerier_DeployKeyUpsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DeployKeyUpsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeployKeyUpsert_Call) Return(_a0 *DeployKey, _a1 error) *MockQuerier_DeployKeyUpsert_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_DeployKeyUpsert_Call) RunAndReturn(run func(context.Context, DeployKeyUpsertParams) (*DeployKey, error)) *MockQuerier_DeployKeyUpsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeploymentResourceUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) DeploymentResourceUpsert(ctx context.Context, arg []DeploymentResourceUpsertParams) *DeploymentResourceUpsertBatchResults {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *DeploymentResourceUpsertBatchResults
+	if rf, ok := ret.Get(0).(func(context.Context, []DeploymentResourceUpsertParams) *DeploymentResourceUpsertBatchResults); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*DeploymentResourceUpsertBatchResults)
+		}
+	}
+
+	return r0
+}
+
+// MockQuerier_DeploymentResourceUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeploymentResourceUpsert'
+type MockQuerier_DeploymentResourceUpsert_Call struct {
+	*mock.Call
+}
+
+// DeploymentResourceUpsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []DeploymentResourceUpsertParams
+func (_e *MockQuerier_Expecter) DeploymentResourceUpsert(ctx interface{}, arg interface{}) *MockQuerier_DeploymentResourceUpsert_Call {
+	return &MockQuerier_DeploymentResourceUpsert_Call{Call: _e.mock.On("DeploymentResourceUpsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_DeploymentResourceUpsert_Call) Run(run func(ctx context.Context, arg []DeploymentResourceUpsertParams)) *MockQuerier_DeploymentResourceUpsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]DeploymentResourceUpsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeploymentResourceUpsert_Call) Return(_a0 *DeploymentResourceUpsertBatchResults) *MockQuerier_DeploymentResourceUpsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_DeploymentResourceUpsert_Call) RunAndReturn(run func(context.Context, []DeploymentResourceUpsertParams) *DeploymentResourceUpsertBatchResults) *MockQuerier_DeploymentResourceUpsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeploymentResourcesForDeployments provides a mock function with given fields: ctx, deploymentIds
+func (_m *MockQuerier) DeploymentResourcesForDeployments(ctx context.Context, deploymentIds []string) ([]*DeploymentResource, error) {
+	ret := _m.Called(ctx, deploymentIds)
+
+	var r0 []*DeploymentResource
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*DeploymentResource, error)); ok {
+		return rf(ctx, deploymentIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*DeploymentResource); ok {
+		r0 = rf(ctx, deploymentIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*DeploymentResource)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, deploymentIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_DeploymentResourcesForDeployments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeploymentResourcesForDeployments'
+type MockQuerier_DeploymentResourcesForDeployments_Call struct {
+	*mock.Call
+}
+
+// DeploymentResourcesForDeployments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deploymentIds []string
+func (_e *MockQuerier_Expecter) DeploymentResourcesForDeployments(ctx interface{}, deploymentIds interface{}) *MockQuerier_DeploymentResourcesForDeployments_Call {
+	return &MockQuerier_DeploymentResourcesForDeployments_Call{Call: _e.mock.On("DeploymentResourcesForDeployments", ctx, deploymentIds)}
+}
+
+func (_c *MockQuerier_DeploymentResourcesForDeployments_Call) Run(run func(ctx context.Context, deploymentIds []string)) *MockQuerier_DeploymentResourcesForDeployments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeploymentResourcesForDeployments_Call) Return(_a0 []*DeploymentResource, _a1 error) *MockQuerier_DeploymentResourcesForDeployments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_DeploymentResourcesForDeployments_Call) RunAndReturn(run func(context.Context, []string) ([]*DeploymentResource, error)) *MockQuerier_DeploymentResourcesForDeployments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeploymentStatusUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) DeploymentStatusUpsert(ctx context.Context, arg []DeploymentStatusUpsertParams) *DeploymentStatusUpsertBatchResults {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *DeploymentStatusUpsertBatchResults
+	if rf, ok := ret.Get(0).(func(context.Context, []DeploymentStatusUpsertParams) *DeploymentStatusUpsertBatchResults); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*DeploymentStatusUpsertBatchResults)
+		}
+	}
+
+	return r0
+}
+
+// MockQuerier_DeploymentStatusUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeploymentStatusUpsert'
+type MockQuerier_DeploymentStatusUpsert_Call struct {
+	*mock.Call
+}
+
+// DeploymentStatusUpsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []DeploymentStatusUpsertParams
+func (_e *MockQuerier_Expecter) DeploymentStatusUpsert(ctx interface{}, arg interface{}) *MockQuerier_DeploymentStatusUpsert_Call {
+	return &MockQuerier_DeploymentStatusUpsert_Call{Call: _e.mock.On("DeploymentStatusUpsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_DeploymentStatusUpsert_Call) Run(run func(ctx context.Context, arg []DeploymentStatusUpsertParams)) *MockQuerier_DeploymentStatusUpsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]DeploymentStatusUpsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeploymentStatusUpsert_Call) Return(_a0 *DeploymentStatusUpsertBatchResults) *MockQuerier_DeploymentStatusUpsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_DeploymentStatusUpsert_Call) RunAndReturn(run func(context.Context, []DeploymentStatusUpsertParams) *DeploymentStatusUpsertBatchResults) *MockQuerier_DeploymentStatusUpsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeploymentStatusesForDeployments provides a mock function with given fields: ctx, deploymentIds
+func (_m *MockQuerier) DeploymentStatusesForDeployments(ctx context.Context, deploymentIds []string) ([]*DeploymentStatus, error) {
+	ret := _m.Called(ctx, deploymentIds)
+
+	var r0 []*DeploymentStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*DeploymentStatus, error)); ok {
+		return rf(ctx, deploymentIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*DeploymentStatus); ok {
+		r0 = rf(ctx, deploymentIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*DeploymentStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, deploymentIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_DeploymentStatusesForDeployments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeploymentStatusesForDeployments'
+type MockQuerier_DeploymentStatusesForDeployments_Call struct {
+	*mock.Call
+}
+
+// DeploymentStatusesForDeployments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deploymentIds []string
+func (_e *MockQuerier_Expecter) DeploymentStatusesForDeployments(ctx interface{}, deploymentIds interface{}) *MockQuerier_DeploymentStatusesForDeployments_Call {
+	return &MockQuerier_DeploymentStatusesForDeployments_Call{Call: _e.mock.On("DeploymentStatusesForDeployments", ctx, deploymentIds)}
+}
+
+func (_c *MockQuerier_DeploymentStatusesForDeployments_Call) Run(run func(ctx context.Context, deploymentIds []string)) *MockQuerier_DeploymentStatusesForDeployments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeploymentStatusesForDeployments_Call) Return(_a0 []*DeploymentStatus, _a1 error) *MockQuerier_DeploymentStatusesForDeployments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_DeploymentStatusesForDeployments_Call) RunAndReturn(run func(context.Context, []string) ([]*DeploymentStatus, error)) *MockQuerier_DeploymentStatusesForDeployments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeploymentUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) DeploymentUpsert(ctx context.Context, arg DeploymentUpsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, DeploymentUpsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_DeploymentUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeploymentUpsert'
+type MockQuerier_DeploymentUpsert_Call struct {
+	*mock.Call
+}
+
+// DeploymentUpsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg DeploymentUpsertParams
+func (_e *MockQuerier_Expecter) DeploymentUpsert(ctx interface{}, arg interface{}) *MockQuerier_DeploymentUpsert_Call {
+	return &MockQuerier_DeploymentUpsert_Call{Call: _e.mock.On("DeploymentUpsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_DeploymentUpsert_Call) Run(run func(ctx context.Context, arg DeploymentUpsertParams)) *MockQuerier_DeploymentUpsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DeploymentUpsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeploymentUpsert_Call) Return(_a0 error) *MockQuerier_DeploymentUpsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_DeploymentUpsert_Call) RunAndReturn(run func(context.Context, DeploymentUpsertParams) error) *MockQuerier_DeploymentUpsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Deployments provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) Deployments(ctx context.Context, arg DeploymentsParams) ([]*Deployment, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*Deployment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, DeploymentsParams) ([]*Deployment, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, DeploymentsParams) []*Deployment); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Deployment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, DeploymentsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_Deployments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Deployments'
+type MockQuerier_Deployments_Call struct {
+	*mock.Call
+}
+
+// Deployments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg DeploymentsParams
+func (_e *MockQuerier_Expecter) Deployments(ctx interface{}, arg interface{}) *MockQuerier_Deployments_Call {
+	return &MockQuerier_Deployments_Call{Call: _e.mock.On("Deployments", ctx, arg)}
+}
+
+func (_c *MockQuerier_Deployments_Call) Run(run func(ctx context.Context, arg DeploymentsParams)) *MockQuerier_Deployments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(DeploymentsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_Deployments_Call) Return(_a0 []*Deployment, _a1 error) *MockQuerier_Deployments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_Deployments_Call) RunAndReturn(run func(context.Context, DeploymentsParams) ([]*Deployment, error)) *MockQuerier_Deployments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpiringDeployKeys provides a mock function with given fields: ctx, before
+func (_m *MockQuerier) ExpiringDeployKeys(ctx context.Context, before pgtype.Timestamptz) ([]*DeployKey, error) {
+	ret := _m.Called(ctx, before)
+
+	var r0 []*DeployKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) ([]*DeployKey, error)); ok {
+		return rf(ctx, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) []*DeployKey); ok {
+		r0 = rf(ctx, before)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*DeployKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Timestamptz) error); ok {
+		r1 = rf(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_ExpiringDeployKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpiringDeployKeys'
+type MockQuerier_ExpiringDeployKeys_Call struct {
+	*mock.Call
+}
+
+// ExpiringDeployKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before pgtype.Timestamptz
+func (_e *MockQuerier_Expecter) ExpiringDeployKeys(ctx interface{}, before interface{}) *MockQuerier_ExpiringDeployKeys_Call {
+	return &MockQuerier_ExpiringDeployKeys_Call{Call: _e.mock.On("ExpiringDeployKeys", ctx, before)}
+}
+
+func (_c *MockQuerier_ExpiringDeployKeys_Call) Run(run func(ctx context.Context, before pgtype.Timestamptz)) *MockQuerier_ExpiringDeployKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.Timestamptz))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ExpiringDeployKeys_Call) Return(_a0 []*DeployKey, _a1 error) *MockQuerier_ExpiringDeployKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_ExpiringDeployKeys_Call) RunAndReturn(run func(context.Context, pgtype.Timestamptz) ([]*DeployKey, error)) *MockQuerier_ExpiringDeployKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LastCostDate provides a mock function with given fields: ctx
+func (_m *MockQuerier) LastCostDate(ctx context.Context) (pgtype.Date, error) {
+	ret := _m.Called(ctx)
+
+	var r0 pgtype.Date
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (pgtype.Date, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) pgtype.Date); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(pgtype.Date)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_LastCostDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LastCostDate'
+type MockQuerier_LastCostDate_Call struct {
+	*mock.Call
+}
+
+// LastCostDate is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockQuerier_Expecter) LastCostDate(ctx interface{}) *MockQuerier_LastCostDate_Call {
+	return &MockQuerier_LastCostDate_Call{Call: _e.mock.On("LastCostDate", ctx)}
+}
+
+func (_c *MockQuerier_LastCostDate_Call) Run(run func(ctx context.Context)) *MockQuerier_LastCostDate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_LastCostDate_Call) Return(_a0 pgtype.Date, _a1 error) *MockQuerier_LastCostDate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_LastCostDate_Call) RunAndReturn(run func(context.Context) (pgtype.Date, error)) *MockQuerier_LastCostDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MaxResourceUtilizationDate provides a mock function with given fields: ctx
+func (_m *MockQuerier) MaxResourceUtilizationDate(ctx context.Context) (pgtype.Timestamptz, error) {
+	ret := _m.Called(ctx)
+
+	var r0 pgtype.Timestamptz
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (pgtype.Timestamptz, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) pgtype.Timestamptz); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(pgtype.Timestamptz)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_MaxResourceUtilizationDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MaxResourceUtilizationDate'
+type MockQuerier_MaxResourceUtilizationDate_Call struct {
+	*mock.Call
+}
+
+// MaxResourceUtilizationDate is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockQuerier_Expecter) MaxResourceUtilizationDate(ctx interface{}) *MockQuerier_MaxResourceUtilizationDate_Call {
+	return &MockQuerier_MaxResourceUtilizationDate_Call{Call: _e.mock.On("MaxResourceUtilizationDate", ctx)}
+}
+
+func (_c *MockQuerier_MaxResourceUtilizationDate_Call) Run(run func(ctx context.Context)) *MockQuerier_MaxResourceUtilizationDate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_MaxResourceUtilizationDate_Call) Return(_a0 pgtype.Timestamptz, _a1 error) *MockQuerier_MaxResourceUtilizationDate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_MaxResourceUtilizationDate_Call) RunAndReturn(run func(context.Context) (pgtype.Timestamptz, error)) *MockQuerier_MaxResourceUtilizationDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MonthlyCostForApp provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) MonthlyCostForApp(ctx context.Context, arg MonthlyCostForAppParams) ([]*MonthlyCostForAppRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*MonthlyCostForAppRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, MonthlyCostForAppParams) ([]*MonthlyCostForAppRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, MonthlyCostForAppParams) []*MonthlyCostForAppRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*MonthlyCostForAppRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, MonthlyCostForAppParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_MonthlyCostForApp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MonthlyCostForApp'
+type MockQuerier_MonthlyCostForApp_Call struct {
+	*mock.Call
+}
+
+// MonthlyCostForApp is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg MonthlyCostForAppParams
+func (_e *MockQuerier_Expecter) MonthlyCostForApp(ctx interface{}, arg interface{}) *MockQuerier_MonthlyCostForApp_Call {
+	return &MockQuerier_MonthlyCostForApp_Call{Call: _e.mock.On("MonthlyCostForApp", ctx, arg)}
+}
+
+func (_c *MockQuerier_MonthlyCostForApp_Call) Run(run func(ctx context.Context, arg MonthlyCostForAppParams)) *MockQuerier_MonthlyCostForApp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(MonthlyCostForAppParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_MonthlyCostForApp_Call) Return(_a0 []*MonthlyCostForAppRow, _a1 error) *MockQuerier_MonthlyCostForApp_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_MonthlyCostForApp_Call) RunAndReturn(run func(context.Context, MonthlyCostForAppParams) ([]*MonthlyCostForAppRow, error)) *MockQuerier_MonthlyCostForApp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MonthlyCostForTeam provides a mock function with given fields: ctx, team
+func (_m *MockQuerier) MonthlyCostForTeam(ctx context.Context, team *string) ([]*MonthlyCostForTeamRow, error) {
+	ret := _m.Called(ctx, team)
+
+	var r0 []*MonthlyCostForTeamRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string) ([]*MonthlyCostForTeamRow, error)); ok {
+		return rf(ctx, team)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *string) []*MonthlyCostForTeamRow); ok {
+		r0 = rf(ctx, team)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*MonthlyCostForTeamRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *string) error); ok {
+		r1 = rf(ctx, team)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_MonthlyCostForTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MonthlyCostForTeam'
+type MockQuerier_MonthlyCostForTeam_Call struct {
+	*mock.Call
+}
+
+// MonthlyCostForTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - team *string
+func (_e *MockQuerier_Expecter) MonthlyCostForTeam(ctx interface{}, team interface{}) *MockQuerier_MonthlyCostForTeam_Call {
+	return &MockQuerier_MonthlyCostForTeam_Call{Call: _e.mock.On("MonthlyCostForTeam", ctx, team)}
+}
+
+func (_c *MockQuerier_MonthlyCostForTeam_Call) Run(run func(ctx context.Context, team *string)) *MockQuerier_MonthlyCostForTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_MonthlyCostForTeam_Call) Return(_a0 []*MonthlyCostForTeamRow, _a1 error) *MockQuerier_MonthlyCostForTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_MonthlyCostForTeam_Call) RunAndReturn(run func(context.Context, *string) ([]*MonthlyCostForTeamRow, error)) *MockQuerier_MonthlyCostForTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpenCriticalFindings provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) OpenCriticalFindings(ctx context.Context, arg OpenCriticalFindingsParams) ([]*VulnerabilityCriticalFinding, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*VulnerabilityCriticalFinding
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, OpenCriticalFindingsParams) ([]*VulnerabilityCriticalFinding, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, OpenCriticalFindingsParams) []*VulnerabilityCriticalFinding); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*VulnerabilityCriticalFinding)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, OpenCriticalFindingsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_OpenCriticalFindings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenCriticalFindings'
+type MockQuerier_OpenCriticalFindings_Call struct {
+	*mock.Call
+}
+
+// OpenCriticalFindings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg OpenCriticalFindingsParams
+func (_e *MockQuerier_Expecter) OpenCriticalFindings(ctx interface{}, arg interface{}) *MockQuerier_OpenCriticalFindings_Call {
+	return &MockQuerier_OpenCriticalFindings_Call{Call: _e.mock.On("OpenCriticalFindings", ctx, arg)}
+}
+
+func (_c *MockQuerier_OpenCriticalFindings_Call) Run(run func(ctx context.Context, arg OpenCriticalFindingsParams)) *MockQuerier_OpenCriticalFindings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(OpenCriticalFindingsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_OpenCriticalFindings_Call) Return(_a0 []*VulnerabilityCriticalFinding, _a1 error) *MockQuerier_OpenCriticalFindings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_OpenCriticalFindings_Call) RunAndReturn(run func(context.Context, OpenCriticalFindingsParams) ([]*VulnerabilityCriticalFinding, error)) *MockQuerier_OpenCriticalFindings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResourceUtilizationExport provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) ResourceUtilizationExport(ctx context.Context, arg ResourceUtilizationExportParams) ([]*ResourceUtilizationMetric, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*ResourceUtilizationMetric
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ResourceUtilizationExportParams) ([]*ResourceUtilizationMetric, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ResourceUtilizationExportParams) []*ResourceUtilizationMetric); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ResourceUtilizationMetric)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ResourceUtilizationExportParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_ResourceUtilizationExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResourceUtilizationExport'
+type MockQuerier_ResourceUtilizationExport_Call struct {
+	*mock.Call
+}
+
+// ResourceUtilizationExport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg ResourceUtilizationExportParams
+func (_e *MockQuerier_Expecter) ResourceUtilizationExport(ctx interface{}, arg interface{}) *MockQuerier_ResourceUtilizationExport_Call {
+	return &MockQuerier_ResourceUtilizationExport_Call{Call: _e.mock.On("ResourceUtilizationExport", ctx, arg)}
+}
+
+func (_c *MockQuerier_ResourceUtilizationExport_Call) Run(run func(ctx context.Context, arg ResourceUtilizationExportParams)) *MockQuerier_ResourceUtilizationExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ResourceUtilizationExportParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ResourceUtilizationExport_Call) Return(_a0 []*ResourceUtilizationMetric, _a1 error) *MockQuerier_ResourceUtilizationExport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_ResourceUtilizationExport_Call) RunAndReturn(run func(context.Context, ResourceUtilizationExportParams) ([]*ResourceUtilizationMetric, error)) *MockQuerier_ResourceUtilizationExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResourceUtilizationForApp provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) ResourceUtilizationForApp(ctx context.Context, arg ResourceUtilizationForAppParams) ([]*ResourceUtilizationMetric, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*ResourceUtilizationMetric
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ResourceUtilizationForAppParams) ([]*ResourceUtilizationMetric, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ResourceUtilizationForAppParams) []*ResourceUtilizationMetric); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ResourceUtilizationMetric)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ResourceUtilizationForAppParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_ResourceUtilizationForApp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResourceUtilizationForApp'
+type MockQuerier_ResourceUtilizationForApp_Call struct {
+	*mock.Call
+}
+
+// ResourceUtilizationForApp is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg ResourceUtilizationForAppParams
+func (_e *MockQuerier_Expecter) ResourceUtilizationForApp(ctx interface{}, arg interface{}) *MockQuerier_ResourceUtilizationForApp_Call {
+	return &MockQuerier_ResourceUtilizationForApp_Call{Call: _e.mock.On("ResourceUtilizationForApp", ctx, arg)}
+}
+
+func (_c *MockQuerier_ResourceUtilizationForApp_Call) Run(run func(ctx context.Context, arg ResourceUtilizationForAppParams)) *MockQuerier_ResourceUtilizationForApp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ResourceUtilizationForAppParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ResourceUtilizationForApp_Call) Return(_a0 []*ResourceUtilizationMetric, _a1 error) *MockQuerier_ResourceUtilizationForApp_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_ResourceUtilizationForApp_Call) RunAndReturn(run func(context.Context, ResourceUtilizationForAppParams) ([]*ResourceUtilizationMetric, error)) *MockQuerier_ResourceUtilizationForApp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResourceUtilizationForTeam provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) ResourceUtilizationForTeam(ctx context.Context, arg ResourceUtilizationForTeamParams) ([]*ResourceUtilizationForTeamRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*ResourceUtilizationForTeamRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ResourceUtilizationForTeamParams) ([]*ResourceUtilizationForTeamRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ResourceUtilizationForTeamParams) []*ResourceUtilizationForTeamRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ResourceUtilizationForTeamRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ResourceUtilizationForTeamParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_ResourceUtilizationForTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResourceUtilizationForTeam'
+type MockQuerier_ResourceUtilizationForTeam_Call struct {
+	*mock.Call
+}
+
+// ResourceUtilizationForTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg ResourceUtilizationForTeamParams
+func (_e *MockQuerier_Expecter) ResourceUtilizationForTeam(ctx interface{}, arg interface{}) *MockQuerier_ResourceUtilizationForTeam_Call {
+	return &MockQuerier_ResourceUtilizationForTeam_Call{Call: _e.mock.On("ResourceUtilizationForTeam", ctx, arg)}
+}
+
+func (_c *MockQuerier_ResourceUtilizationForTeam_Call) Run(run func(ctx context.Context, arg ResourceUtilizationForTeamParams)) *MockQuerier_ResourceUtilizationForTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ResourceUtilizationForTeamParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ResourceUtilizationForTeam_Call) Return(_a0 []*ResourceUtilizationForTeamRow, _a1 error) *MockQuerier_ResourceUtilizationForTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_ResourceUtilizationForTeam_Call) RunAndReturn(run func(context.Context, ResourceUtilizationForTeamParams) ([]*ResourceUtilizationForTeamRow, error)) *MockQuerier_ResourceUtilizationForTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResourceUtilizationOverageForTeam provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) ResourceUtilizationOverageForTeam(ctx context.Context, arg ResourceUtilizationOverageForTeamParams) ([]*ResourceUtilizationOverageForTeamRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*ResourceUtilizationOverageForTeamRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ResourceUtilizationOverageForTeamParams) ([]*ResourceUtilizationOverageForTeamRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ResourceUtilizationOverageForTeamParams) []*ResourceUtilizationOverageForTeamRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ResourceUtilizationOverageForTeamRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ResourceUtilizationOverageForTeamParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_ResourceUtilizationOverageForTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResourceUtilizationOverageForTeam'
+type MockQuerier_ResourceUtilizationOverageForTeam_Call struct {
+	*mock.Call
+}
+
+// ResourceUtilizationOverageForTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg ResourceUtilizationOverageForTeamParams
+func (_e *MockQuerier_Expecter) ResourceUtilizationOverageForTeam(ctx interface{}, arg interface{}) *MockQuerier_ResourceUtilizationOverageForTeam_Call {
+	return &MockQuerier_ResourceUtilizationOverageForTeam_Call{Call: _e.mock.On("ResourceUtilizationOverageForTeam", ctx, arg)}
+}
+
+func (_c *MockQuerier_ResourceUtilizationOverageForTeam_Call) Run(run func(ctx context.Context, arg ResourceUtilizationOverageForTeamParams)) *MockQuerier_ResourceUtilizationOverageForTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ResourceUtilizationOverageForTeamParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ResourceUtilizationOverageForTeam_Call) Return(_a0 []*ResourceUtilizationOverageForTeamRow, _a1 error) *MockQuerier_ResourceUtilizationOverageForTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_ResourceUtilizationOverageForTeam_Call) RunAndReturn(run func(context.Context, ResourceUtilizationOverageForTeamParams) ([]*ResourceUtilizationOverageForTeamRow, error)) *MockQuerier_ResourceUtilizationOverageForTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResourceUtilizationRangeForApp provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) ResourceUtilizationRangeForApp(ctx context.Context, arg ResourceUtilizationRangeForAppParams) (*ResourceUtilizationRangeForAppRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *ResourceUtilizationRangeForAppRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ResourceUtilizationRangeForAppParams) (*ResourceUtilizationRangeForAppRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ResourceUtilizationRangeForAppParams) *ResourceUtilizationRangeForAppRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ResourceUtilizationRangeForAppRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ResourceUtilizationRangeForAppParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_ResourceUtilizationRangeForApp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResourceUtilizationRangeForApp'
+type MockQuerier_ResourceUtilizationRangeForApp_Call struct {
+	*mock.Call
+}
+
+// ResourceUtilizationRangeForApp is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg ResourceUtilizationRangeForAppParams
+func (_e *MockQuerier_Expecter) ResourceUtilizationRangeForApp(ctx interface{}, arg interface{}) *MockQuerier_ResourceUtilizationRangeForApp_Call {
+	return &MockQuerier_ResourceUtilizationRangeForApp_Call{Call: _e.mock.On("ResourceUtilizationRangeForApp", ctx, arg)}
+}
+
+func (_c *MockQuerier_ResourceUtilizationRangeForApp_Call) Run(run func(ctx context.Context, arg ResourceUtilizationRangeForAppParams)) *MockQuerier_ResourceUtilizationRangeForApp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(ResourceUtilizationRangeForAppParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ResourceUtilizationRangeForApp_Call) Return(_a0 *ResourceUtilizationRangeForAppRow, _a1 error) *MockQuerier_ResourceUtilizationRangeForApp_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_ResourceUtilizationRangeForApp_Call) RunAndReturn(run func(context.Context, ResourceUtilizationRangeForAppParams) (*ResourceUtilizationRangeForAppRow, error)) *MockQuerier_ResourceUtilizationRangeForApp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResourceUtilizationRangeForTeam provides a mock function with given fields: ctx, team
+func (_m *MockQuerier) ResourceUtilizationRangeForTeam(ctx context.Context, team string) (*ResourceUtilizationRangeForTeamRow, error) {
+	ret := _m.Called(ctx, team)
+
+	var r0 *ResourceUtilizationRangeForTeamRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*ResourceUtilizationRangeForTeamRow, error)); ok {
+		return rf(ctx, team)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *ResourceUtilizationRangeForTeamRow); ok {
+		r0 = rf(ctx, team)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ResourceUtilizationRangeForTeamRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, team)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_ResourceUtilizationRangeForTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResourceUtilizationRangeForTeam'
+type MockQuerier_ResourceUtilizationRangeForTeam_Call struct {
+	*mock.Call
+}
+
+// ResourceUtilizationRangeForTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - team string
+func (_e *MockQuerier_Expecter) ResourceUtilizationRangeForTeam(ctx interface{}, team interface{}) *MockQuerier_ResourceUtilizationRangeForTeam_Call {
+	return &MockQuerier_ResourceUtilizationRangeForTeam_Call{Call: _e.mock.On("ResourceUtilizationRangeForTeam", ctx, team)}
+}
+
+func (_c *MockQuerier_ResourceUtilizationRangeForTeam_Call) Run(run func(ctx context.Context, team string)) *MockQuerier_ResourceUtilizationRangeForTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ResourceUtilizationRangeForTeam_Call) Return(_a0 *ResourceUtilizationRangeForTeamRow, _a1 error) *MockQuerier_ResourceUtilizationRangeForTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_ResourceUtilizationRangeForTeam_Call) RunAndReturn(run func(context.Context, string) (*ResourceUtilizationRangeForTeamRow, error)) *MockQuerier_ResourceUtilizationRangeForTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResourceUtilizationUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) ResourceUtilizationUpsert(ctx context.Context, arg []ResourceUtilizationUpsertParams) *ResourceUtilizationUpsertBatchResults {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *ResourceUtilizationUpsertBatchResults
+	if rf, ok := ret.Get(0).(func(context.Context, []ResourceUtilizationUpsertParams) *ResourceUtilizationUpsertBatchResults); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ResourceUtilizationUpsertBatchResults)
+		}
+	}
+
+	return r0
+}
+
+// MockQuerier_ResourceUtilizationUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResourceUtilizationUpsert'
+type MockQuerier_ResourceUtilizationUpsert_Call struct {
+	*mock.Call
+}
+
+// ResourceUtilizationUpsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []ResourceUtilizationUpsertParams
+func (_e *MockQuerier_Expecter) ResourceUtilizationUpsert(ctx interface{}, arg interface{}) *MockQuerier_ResourceUtilizationUpsert_Call {
+	return &MockQuerier_ResourceUtilizationUpsert_Call{Call: _e.mock.On("ResourceUtilizationUpsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_ResourceUtilizationUpsert_Call) Run(run func(ctx context.Context, arg []ResourceUtilizationUpsertParams)) *MockQuerier_ResourceUtilizationUpsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]ResourceUtilizationUpsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ResourceUtilizationUpsert_Call) Return(_a0 *ResourceUtilizationUpsertBatchResults) *MockQuerier_ResourceUtilizationUpsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_ResourceUtilizationUpsert_Call) RunAndReturn(run func(context.Context, []ResourceUtilizationUpsertParams) *ResourceUtilizationUpsertBatchResults) *MockQuerier_ResourceUtilizationUpsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SpecificResourceUtilizationForApp provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) SpecificResourceUtilizationForApp(ctx context.Context, arg SpecificResourceUtilizationForAppParams) (*SpecificResourceUtilizationForAppRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *SpecificResourceUtilizationForAppRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, SpecificResourceUtilizationForAppParams) (*SpecificResourceUtilizationForAppRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, SpecificResourceUtilizationForAppParams) *SpecificResourceUtilizationForAppRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*SpecificResourceUtilizationForAppRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, SpecificResourceUtilizationForAppParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_SpecificResourceUtilizationForApp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SpecificResourceUtilizationForApp'
+type MockQuerier_SpecificResourceUtilizationForApp_Call struct {
+	*mock.Call
+}
+
+// SpecificResourceUtilizationForApp is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg SpecificResourceUtilizationForAppParams
+func (_e *MockQuerier_Expecter) SpecificResourceUtilizationForApp(ctx interface{}, arg interface{}) *MockQuerier_SpecificResourceUtilizationForApp_Call {
+	return &MockQuerier_SpecificResourceUtilizationForApp_Call{Call: _e.mock.On("SpecificResourceUtilizationForApp", ctx, arg)}
+}
+
+func (_c *MockQuerier_SpecificResourceUtilizationForApp_Call) Run(run func(ctx context.Context, arg SpecificResourceUtilizationForAppParams)) *MockQuerier_SpecificResourceUtilizationForApp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(SpecificResourceUtilizationForAppParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_SpecificResourceUtilizationForApp_Call) Return(_a0 *SpecificResourceUtilizationForAppRow, _a1 error) *MockQuerier_SpecificResourceUtilizationForApp_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_SpecificResourceUtilizationForApp_Call) RunAndReturn(run func(context.Context, SpecificResourceUtilizationForAppParams) (*SpecificResourceUtilizationForAppRow, error)) *MockQuerier_SpecificResourceUtilizationForApp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SpecificResourceUtilizationForTeam provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) SpecificResourceUtilizationForTeam(ctx context.Context, arg SpecificResourceUtilizationForTeamParams) (*SpecificResourceUtilizationForTeamRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *SpecificResourceUtilizationForTeamRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, SpecificResourceUtilizationForTeamParams) (*SpecificResourceUtilizationForTeamRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, SpecificResourceUtilizationForTeamParams) *SpecificResourceUtilizationForTeamRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*SpecificResourceUtilizationForTeamRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, SpecificResourceUtilizationForTeamParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_SpecificResourceUtilizationForTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SpecificResourceUtilizationForTeam'
+type MockQuerier_SpecificResourceUtilizationForTeam_Call struct {
+	*mock.Call
+}
+
+// SpecificResourceUtilizationForTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg SpecificResourceUtilizationForTeamParams
+func (_e *MockQuerier_Expecter) SpecificResourceUtilizationForTeam(ctx interface{}, arg interface{}) *MockQuerier_SpecificResourceUtilizationForTeam_Call {
+	return &MockQuerier_SpecificResourceUtilizationForTeam_Call{Call: _e.mock.On("SpecificResourceUtilizationForTeam", ctx, arg)}
+}
+
+func (_c *MockQuerier_SpecificResourceUtilizationForTeam_Call) Run(run func(ctx context.Context, arg SpecificResourceUtilizationForTeamParams)) *MockQuerier_SpecificResourceUtilizationForTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(SpecificResourceUtilizationForTeamParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_SpecificResourceUtilizationForTeam_Call) Return(_a0 *SpecificResourceUtilizationForTeamRow, _a1 error) *MockQuerier_SpecificResourceUtilizationForTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_SpecificResourceUtilizationForTeam_Call) RunAndReturn(run func(context.Context, SpecificResourceUtilizationForTeamParams) (*SpecificResourceUtilizationForTeamRow, error)) *MockQuerier_SpecificResourceUtilizationForTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TenantAppCostGrowth provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) TenantAppCostGrowth(ctx context.Context, arg TenantAppCostGrowthParams) ([]*TenantAppCostGrowthRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*TenantAppCostGrowthRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, TenantAppCostGrowthParams) ([]*TenantAppCostGrowthRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, TenantAppCostGrowthParams) []*TenantAppCostGrowthRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*TenantAppCostGrowthRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, TenantAppCostGrowthParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_TenantAppCostGrowth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TenantAppCostGrowth'
+type MockQuerier_TenantAppCostGrowth_Call struct {
+	*mock.Call
+}
+
+// TenantAppCostGrowth is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg TenantAppCostGrowthParams
+func (_e *MockQuerier_Expecter) TenantAppCostGrowth(ctx interface{}, arg interface{}) *MockQuerier_TenantAppCostGrowth_Call {
+	return &MockQuerier_TenantAppCostGrowth_Call{Call: _e.mock.On("TenantAppCostGrowth", ctx, arg)}
+}
+
+func (_c *MockQuerier_TenantAppCostGrowth_Call) Run(run func(ctx context.Context, arg TenantAppCostGrowthParams)) *MockQuerier_TenantAppCostGrowth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(TenantAppCostGrowthParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_TenantAppCostGrowth_Call) Return(_a0 []*TenantAppCostGrowthRow, _a1 error) *MockQuerier_TenantAppCostGrowth_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_TenantAppCostGrowth_Call) RunAndReturn(run func(context.Context, TenantAppCostGrowthParams) ([]*TenantAppCostGrowthRow, error)) *MockQuerier_TenantAppCostGrowth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TenantCostPerEnv provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) TenantCostPerEnv(ctx context.Context, arg TenantCostPerEnvParams) ([]*TenantCostPerEnvRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*TenantCostPerEnvRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, TenantCostPerEnvParams) ([]*TenantCostPerEnvRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, TenantCostPerEnvParams) []*TenantCostPerEnvRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*TenantCostPerEnvRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, TenantCostPerEnvParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_TenantCostPerEnv_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TenantCostPerEnv'
+type MockQuerier_TenantCostPerEnv_Call struct {
+	*mock.Call
+}
+
+// TenantCostPerEnv is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg TenantCostPerEnvParams
+func (_e *MockQuerier_Expecter) TenantCostPerEnv(ctx interface{}, arg interface{}) *MockQuerier_TenantCostPerEnv_Call {
+	return &MockQuerier_TenantCostPerEnv_Call{Call: _e.mock.On("TenantCostPerEnv", ctx, arg)}
+}
+
+func (_c *MockQuerier_TenantCostPerEnv_Call) Run(run func(ctx context.Context, arg TenantCostPerEnvParams)) *MockQuerier_TenantCostPerEnv_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(TenantCostPerEnvParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_TenantCostPerEnv_Call) Return(_a0 []*TenantCostPerEnvRow, _a1 error) *MockQuerier_TenantCostPerEnv_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_TenantCostPerEnv_Call) RunAndReturn(run func(context.Context, TenantCostPerEnvParams) ([]*TenantCostPerEnvRow, error)) *MockQuerier_TenantCostPerEnv_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TenantTeamCostGrowth provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) TenantTeamCostGrowth(ctx context.Context, arg TenantTeamCostGrowthParams) ([]*TenantTeamCostGrowthRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*TenantTeamCostGrowthRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, TenantTeamCostGrowthParams) ([]*TenantTeamCostGrowthRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, TenantTeamCostGrowthParams) []*TenantTeamCostGrowthRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*TenantTeamCostGrowthRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, TenantTeamCostGrowthParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_TenantTeamCostGrowth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TenantTeamCostGrowth'
+type MockQuerier_TenantTeamCostGrowth_Call struct {
+	*mock.Call
+}
+
+// TenantTeamCostGrowth is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg TenantTeamCostGrowthParams
+func (_e *MockQuerier_Expecter) TenantTeamCostGrowth(ctx interface{}, arg interface{}) *MockQuerier_TenantTeamCostGrowth_Call {
+	return &MockQuerier_TenantTeamCostGrowth_Call{Call: _e.mock.On("TenantTeamCostGrowth", ctx, arg)}
+}
+
+func (_c *MockQuerier_TenantTeamCostGrowth_Call) Run(run func(ctx context.Context, arg TenantTeamCostGrowthParams)) *MockQuerier_TenantTeamCostGrowth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(TenantTeamCostGrowthParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_TenantTeamCostGrowth_Call) Return(_a0 []*TenantTeamCostGrowthRow, _a1 error) *MockQuerier_TenantTeamCostGrowth_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_TenantTeamCostGrowth_Call) RunAndReturn(run func(context.Context, TenantTeamCostGrowthParams) ([]*TenantTeamCostGrowthRow, error)) *MockQuerier_TenantTeamCostGrowth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TenantTopApps provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) TenantTopApps(ctx context.Context, arg TenantTopAppsParams) ([]*TenantTopAppsRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*TenantTopAppsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, TenantTopAppsParams) ([]*TenantTopAppsRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, TenantTopAppsParams) []*TenantTopAppsRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*TenantTopAppsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, TenantTopAppsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_TenantTopApps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TenantTopApps'
+type MockQuerier_TenantTopApps_Call struct {
+	*mock.Call
+}
+
+// TenantTopApps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg TenantTopAppsParams
+func (_e *MockQuerier_Expecter) TenantTopApps(ctx interface{}, arg interface{}) *MockQuerier_TenantTopApps_Call {
+	return &MockQuerier_TenantTopApps_Call{Call: _e.mock.On("TenantTopApps", ctx, arg)}
+}
+
+func (_c *MockQuerier_TenantTopApps_Call) Run(run func(ctx context.Context, arg TenantTopAppsParams)) *MockQuerier_TenantTopApps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(TenantTopAppsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_TenantTopApps_Call) Return(_a0 []*TenantTopAppsRow, _a1 error) *MockQuerier_TenantTopApps_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_TenantTopApps_Call) RunAndReturn(run func(context.Context, TenantTopAppsParams) ([]*TenantTopAppsRow, error)) *MockQuerier_TenantTopApps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TenantTopTeams provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) TenantTopTeams(ctx context.Context, arg TenantTopTeamsParams) ([]*TenantTopTeamsRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*TenantTopTeamsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, TenantTopTeamsParams) ([]*TenantTopTeamsRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, TenantTopTeamsParams) []*TenantTopTeamsRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*TenantTopTeamsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, TenantTopTeamsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_TenantTopTeams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TenantTopTeams'
+type MockQuerier_TenantTopTeams_Call struct {
+	*mock.Call
+}
+
+// TenantTopTeams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg TenantTopTeamsParams
+func (_e *MockQuerier_Expecter) TenantTopTeams(ctx interface{}, arg interface{}) *MockQuerier_TenantTopTeams_Call {
+	return &MockQuerier_TenantTopTeams_Call{Call: _e.mock.On("TenantTopTeams", ctx, arg)}
+}
+
+func (_c *MockQuerier_TenantTopTeams_Call) Run(run func(ctx context.Context, arg TenantTopTeamsParams)) *MockQuerier_TenantTopTeams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(TenantTopTeamsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_TenantTopTeams_Call) Return(_a0 []*TenantTopTeamsRow, _a1 error) *MockQuerier_TenantTopTeams_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_TenantTopTeams_Call) RunAndReturn(run func(context.Context, TenantTopTeamsParams) ([]*TenantTopTeamsRow, error)) *MockQuerier_TenantTopTeams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VulnerabilityAnalysisAuditCreate provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) VulnerabilityAnalysisAuditCreate(ctx context.Context, arg VulnerabilityAnalysisAuditCreateParams) (int32, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, VulnerabilityAnalysisAuditCreateParams) (int32, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, VulnerabilityAnalysisAuditCreateParams) int32); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, VulnerabilityAnalysisAuditCreateParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_VulnerabilityAnalysisAuditCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VulnerabilityAnalysisAuditCreate'
+type MockQuerier_VulnerabilityAnalysisAuditCreate_Call struct {
+	*mock.Call
+}
+
+// VulnerabilityAnalysisAuditCreate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg VulnerabilityAnalysisAuditCreateParams
+func (_e *MockQuerier_Expecter) VulnerabilityAnalysisAuditCreate(ctx interface{}, arg interface{}) *MockQuerier_VulnerabilityAnalysisAuditCreate_Call {
+	return &MockQuerier_VulnerabilityAnalysisAuditCreate_Call{Call: _e.mock.On("VulnerabilityAnalysisAuditCreate", ctx, arg)}
+}
+
+func (_c *MockQuerier_VulnerabilityAnalysisAuditCreate_Call) Run(run func(ctx context.Context, arg VulnerabilityAnalysisAuditCreateParams)) *MockQuerier_VulnerabilityAnalysisAuditCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(VulnerabilityAnalysisAuditCreateParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilityAnalysisAuditCreate_Call) Return(_a0 int32, _a1 error) *MockQuerier_VulnerabilityAnalysisAuditCreate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilityAnalysisAuditCreate_Call) RunAndReturn(run func(context.Context, VulnerabilityAnalysisAuditCreateParams) (int32, error)) *MockQuerier_VulnerabilityAnalysisAuditCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VulnerabilityAnalysisAuditDelete provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) VulnerabilityAnalysisAuditDelete(ctx context.Context, id int32) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_VulnerabilityAnalysisAuditDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VulnerabilityAnalysisAuditDelete'
+type MockQuerier_VulnerabilityAnalysisAuditDelete_Call struct {
+	*mock.Call
+}
+
+// VulnerabilityAnalysisAuditDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockQuerier_Expecter) VulnerabilityAnalysisAuditDelete(ctx interface{}, id interface{}) *MockQuerier_VulnerabilityAnalysisAuditDelete_Call {
+	return &MockQuerier_VulnerabilityAnalysisAuditDelete_Call{Call: _e.mock.On("VulnerabilityAnalysisAuditDelete", ctx, id)}
+}
+
+func (_c *MockQuerier_VulnerabilityAnalysisAuditDelete_Call) Run(run func(ctx context.Context, id int32)) *MockQuerier_VulnerabilityAnalysisAuditDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilityAnalysisAuditDelete_Call) Return(_a0 error) *MockQuerier_VulnerabilityAnalysisAuditDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilityAnalysisAuditDelete_Call) RunAndReturn(run func(context.Context, int32) error) *MockQuerier_VulnerabilityAnalysisAuditDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VulnerabilityIndexComponentsDelete provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) VulnerabilityIndexComponentsDelete(ctx context.Context, arg VulnerabilityIndexComponentsDeleteParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, VulnerabilityIndexComponentsDeleteParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_VulnerabilityIndexComponentsDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VulnerabilityIndexComponentsDelete'
+type MockQuerier_VulnerabilityIndexComponentsDelete_Call struct {
+	*mock.Call
+}
+
+// VulnerabilityIndexComponentsDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg VulnerabilityIndexComponentsDeleteParams
+func (_e *MockQuerier_Expecter) VulnerabilityIndexComponentsDelete(ctx interface{}, arg interface{}) *MockQuerier_VulnerabilityIndexComponentsDelete_Call {
+	return &MockQuerier_VulnerabilityIndexComponentsDelete_Call{Call: _e.mock.On("VulnerabilityIndexComponentsDelete", ctx, arg)}
+}
+
+func (_c *MockQuerier_VulnerabilityIndexComponentsDelete_Call) Run(run func(ctx context.Context, arg VulnerabilityIndexComponentsDeleteParams)) *MockQuerier_VulnerabilityIndexComponentsDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(VulnerabilityIndexComponentsDeleteParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilityIndexComponentsDelete_Call) Return(_a0 error) *MockQuerier_VulnerabilityIndexComponentsDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilityIndexComponentsDelete_Call) RunAndReturn(run func(context.Context, VulnerabilityIndexComponentsDeleteParams) error) *MockQuerier_VulnerabilityIndexComponentsDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VulnerabilityIndexComponentsInsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) VulnerabilityIndexComponentsInsert(ctx context.Context, arg []VulnerabilityIndexComponentsInsertParams) *VulnerabilityIndexComponentsInsertBatchResults {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *VulnerabilityIndexComponentsInsertBatchResults
+	if rf, ok := ret.Get(0).(func(context.Context, []VulnerabilityIndexComponentsInsertParams) *VulnerabilityIndexComponentsInsertBatchResults); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*VulnerabilityIndexComponentsInsertBatchResults)
+		}
+	}
+
+	return r0
+}
+
+// MockQuerier_VulnerabilityIndexComponentsInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VulnerabilityIndexComponentsInsert'
+type MockQuerier_VulnerabilityIndexComponentsInsert_Call struct {
+	*mock.Call
+}
+
+// VulnerabilityIndexComponentsInsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []VulnerabilityIndexComponentsInsertParams
+func (_e *MockQuerier_Expecter) VulnerabilityIndexComponentsInsert(ctx interface{}, arg interface{}) *MockQuerier_VulnerabilityIndexComponentsInsert_Call {
+	return &MockQuerier_VulnerabilityIndexComponentsInsert_Call{Call: _e.mock.On("VulnerabilityIndexComponentsInsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_VulnerabilityIndexComponentsInsert_Call) Run(run func(ctx context.Context, arg []VulnerabilityIndexComponentsInsertParams)) *MockQuerier_VulnerabilityIndexComponentsInsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]VulnerabilityIndexComponentsInsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilityIndexComponentsInsert_Call) Return(_a0 *VulnerabilityIndexComponentsInsertBatchResults) *MockQuerier_VulnerabilityIndexComponentsInsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilityIndexComponentsInsert_Call) RunAndReturn(run func(context.Context, []VulnerabilityIndexComponentsInsertParams) *VulnerabilityIndexComponentsInsertBatchResults) *MockQuerier_VulnerabilityIndexComponentsInsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VulnerabilityIndexDeleteStale provides a mock function with given fields: ctx, before
+func (_m *MockQuerier) VulnerabilityIndexDeleteStale(ctx context.Context, before pgtype.Timestamptz) error {
+	ret := _m.Called(ctx, before)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) error); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_VulnerabilityIndexDeleteStale_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VulnerabilityIndexDeleteStale'
+type MockQuerier_VulnerabilityIndexDeleteStale_Call struct {
+	*mock.Call
+}
+
+// VulnerabilityIndexDeleteStale is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before pgtype.Timestamptz
+func (_e *MockQuerier_Expecter) VulnerabilityIndexDeleteStale(ctx interface{}, before interface{}) *MockQuerier_VulnerabilityIndexDeleteStale_Call {
+	return &MockQuerier_VulnerabilityIndexDeleteStale_Call{Call: _e.mock.On("VulnerabilityIndexDeleteStale", ctx, before)}
+}
+
+func (_c *MockQuerier_VulnerabilityIndexDeleteStale_Call) Run(run func(ctx context.Context, before pgtype.Timestamptz)) *MockQuerier_VulnerabilityIndexDeleteStale_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.Timestamptz))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilityIndexDeleteStale_Call) Return(_a0 error) *MockQuerier_VulnerabilityIndexDeleteStale_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilityIndexDeleteStale_Call) RunAndReturn(run func(context.Context, pgtype.Timestamptz) error) *MockQuerier_VulnerabilityIndexDeleteStale_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VulnerabilityIndexUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) VulnerabilityIndexUpsert(ctx context.Context, arg VulnerabilityIndexUpsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, VulnerabilityIndexUpsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_VulnerabilityIndexUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VulnerabilityIndexUpsert'
+type MockQuerier_VulnerabilityIndexUpsert_Call struct {
+	*mock.Call
+}
+
+// VulnerabilityIndexUpsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg VulnerabilityIndexUpsertParams
+func (_e *MockQuerier_Expecter) VulnerabilityIndexUpsert(ctx interface{}, arg interface{}) *MockQuerier_VulnerabilityIndexUpsert_Call {
+	return &MockQuerier_VulnerabilityIndexUpsert_Call{Call: _e.mock.On("VulnerabilityIndexUpsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_VulnerabilityIndexUpsert_Call) Run(run func(ctx context.Context, arg VulnerabilityIndexUpsertParams)) *MockQuerier_VulnerabilityIndexUpsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(VulnerabilityIndexUpsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilityIndexUpsert_Call) Return(_a0 error) *MockQuerier_VulnerabilityIndexUpsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilityIndexUpsert_Call) RunAndReturn(run func(context.Context, VulnerabilityIndexUpsertParams) error) *MockQuerier_VulnerabilityIndexUpsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VulnerabilitySnapshotUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) VulnerabilitySnapshotUpsert(ctx context.Context, arg VulnerabilitySnapshotUpsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, VulnerabilitySnapshotUpsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_VulnerabilitySnapshotUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VulnerabilitySnapshotUpsert'
+type MockQuerier_VulnerabilitySnapshotUpsert_Call struct {
+	*mock.Call
+}
+
+// VulnerabilitySnapshotUpsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg VulnerabilitySnapshotUpsertParams
+func (_e *MockQuerier_Expecter) VulnerabilitySnapshotUpsert(ctx interface{}, arg interface{}) *MockQuerier_VulnerabilitySnapshotUpsert_Call {
+	return &MockQuerier_VulnerabilitySnapshotUpsert_Call{Call: _e.mock.On("VulnerabilitySnapshotUpsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_VulnerabilitySnapshotUpsert_Call) Run(run func(ctx context.Context, arg VulnerabilitySnapshotUpsertParams)) *MockQuerier_VulnerabilitySnapshotUpsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(VulnerabilitySnapshotUpsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilitySnapshotUpsert_Call) Return(_a0 error) *MockQuerier_VulnerabilitySnapshotUpsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilitySnapshotUpsert_Call) RunAndReturn(run func(context.Context, VulnerabilitySnapshotUpsertParams) error) *MockQuerier_VulnerabilitySnapshotUpsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VulnerabilitySnapshotsForApp provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) VulnerabilitySnapshotsForApp(ctx context.Context, arg VulnerabilitySnapshotsForAppParams) ([]*VulnerabilitySnapshot, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*VulnerabilitySnapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, VulnerabilitySnapshotsForAppParams) ([]*VulnerabilitySnapshot, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, VulnerabilitySnapshotsForAppParams) []*VulnerabilitySnapshot); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*VulnerabilitySnapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, VulnerabilitySnapshotsForAppParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_VulnerabilitySnapshotsForApp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VulnerabilitySnapshotsForApp'
+type MockQuerier_VulnerabilitySnapshotsForApp_Call struct {
+	*mock.Call
+}
+
+// VulnerabilitySnapshotsForApp is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg VulnerabilitySnapshotsForAppParams
+func (_e *MockQuerier_Expecter) VulnerabilitySnapshotsForApp(ctx interface{}, arg interface{}) *MockQuerier_VulnerabilitySnapshotsForApp_Call {
+	return &MockQuerier_VulnerabilitySnapshotsForApp_Call{Call: _e.mock.On("VulnerabilitySnapshotsForApp", ctx, arg)}
+}
+
+func (_c *MockQuerier_VulnerabilitySnapshotsForApp_Call) Run(run func(ctx context.Context, arg VulnerabilitySnapshotsForAppParams)) *MockQuerier_VulnerabilitySnapshotsForApp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(VulnerabilitySnapshotsForAppParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilitySnapshotsForApp_Call) Return(_a0 []*VulnerabilitySnapshot, _a1 error) *MockQuerier_VulnerabilitySnapshotsForApp_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilitySnapshotsForApp_Call) RunAndReturn(run func(context.Context, VulnerabilitySnapshotsForAppParams) ([]*VulnerabilitySnapshot, error)) *MockQuerier_VulnerabilitySnapshotsForApp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VulnerabilitySnapshotsForTeam provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) VulnerabilitySnapshotsForTeam(ctx context.Context, arg VulnerabilitySnapshotsForTeamParams) ([]*VulnerabilitySnapshot, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*VulnerabilitySnapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, VulnerabilitySnapshotsForTeamParams) ([]*VulnerabilitySnapshot, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, VulnerabilitySnapshotsForTeamParams) []*VulnerabilitySnapshot); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*VulnerabilitySnapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, VulnerabilitySnapshotsForTeamParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_VulnerabilitySnapshotsForTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VulnerabilitySnapshotsForTeam'
+type MockQuerier_VulnerabilitySnapshotsForTeam_Call struct {
+	*mock.Call
+}
+
+// VulnerabilitySnapshotsForTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg VulnerabilitySnapshotsForTeamParams
+func (_e *MockQuerier_Expecter) VulnerabilitySnapshotsForTeam(ctx interface{}, arg interface{}) *MockQuerier_VulnerabilitySnapshotsForTeam_Call {
+	return &MockQuerier_VulnerabilitySnapshotsForTeam_Call{Call: _e.mock.On("VulnerabilitySnapshotsForTeam", ctx, arg)}
+}
+
+func (_c *MockQuerier_VulnerabilitySnapshotsForTeam_Call) Run(run func(ctx context.Context, arg VulnerabilitySnapshotsForTeamParams)) *MockQuerier_VulnerabilitySnapshotsForTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(VulnerabilitySnapshotsForTeamParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilitySnapshotsForTeam_Call) Return(_a0 []*VulnerabilitySnapshot, _a1 error) *MockQuerier_VulnerabilitySnapshotsForTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilitySnapshotsForTeam_Call) RunAndReturn(run func(context.Context, VulnerabilitySnapshotsForTeamParams) ([]*VulnerabilitySnapshot, error)) *MockQuerier_VulnerabilitySnapshotsForTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VulnerabilitySummaries provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) VulnerabilitySummaries(ctx context.Context, arg VulnerabilitySummariesParams) ([]*VulnerabilitySummariesRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*VulnerabilitySummariesRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, VulnerabilitySummariesParams) ([]*VulnerabilitySummariesRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, VulnerabilitySummariesParams) []*VulnerabilitySummariesRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*VulnerabilitySummariesRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, VulnerabilitySummariesParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_VulnerabilitySummaries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VulnerabilitySummaries'
+type MockQuerier_VulnerabilitySummaries_Call struct {
+	*mock.Call
+}
+
+// VulnerabilitySummaries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg VulnerabilitySummariesParams
+func (_e *MockQuerier_Expecter) VulnerabilitySummaries(ctx interface{}, arg interface{}) *MockQuerier_VulnerabilitySummaries_Call {
+	return &MockQuerier_VulnerabilitySummaries_Call{Call: _e.mock.On("VulnerabilitySummaries", ctx, arg)}
+}
+
+func (_c *MockQuerier_VulnerabilitySummaries_Call) Run(run func(ctx context.Context, arg VulnerabilitySummariesParams)) *MockQuerier_VulnerabilitySummaries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(VulnerabilitySummariesParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilitySummaries_Call) Return(_a0 []*VulnerabilitySummariesRow, _a1 error) *MockQuerier_VulnerabilitySummaries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilitySummaries_Call) RunAndReturn(run func(context.Context, VulnerabilitySummariesParams) ([]*VulnerabilitySummariesRow, error)) *MockQuerier_VulnerabilitySummaries_Call {
 	_c.Call.Return(run)
 	return _c
 }
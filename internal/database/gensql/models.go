@@ -69,6 +69,32 @@ func AllResourceTypeValues() []ResourceType {
 	}
 }
 
+type AppCommit struct {
+	Team      string
+	Env       string
+	App       string
+	CommitSha string
+	LastSeen  pgtype.Timestamptz
+}
+
+type AppFailure struct {
+	ID      int32
+	Team    string
+	Env     string
+	App     string
+	Started pgtype.Timestamptz
+	Ended   pgtype.Timestamptz
+}
+
+type AppRollback struct {
+	ID        int32
+	Team      string
+	Env       string
+	App       string
+	CommitSha string
+	Detected  pgtype.Timestamptz
+}
+
 type Cost struct {
 	ID        int32
 	Env       *string
@@ -79,6 +105,78 @@ type Cost struct {
 	DailyCost float32
 }
 
+type CostAnomaly struct {
+	ID        int32
+	Team      string
+	Env       string
+	App       string
+	CostType  string
+	FirstSeen pgtype.Date
+	LastSeen  pgtype.Date
+	Cost      float32
+	Baseline  float32
+}
+
+type CostBudget struct {
+	Team              string
+	Amount            float32
+	UpdatedBy         string
+	Updated           pgtype.Timestamptz
+	NotifiedMonth     pgtype.Date
+	NotifiedThreshold *int32
+}
+
+type DeliveryMetric struct {
+	ID                 int32
+	Date               pgtype.Date
+	Env                string
+	Team               string
+	App                string
+	Deployments        int32
+	FailedDeployments  int32
+	Rollbacks          int32
+	Restores           int32
+	RestoreTimeSeconds int64
+	LeadTimes          int32
+	LeadTimeSeconds    int64
+}
+
+type DeployKey struct {
+	Team             string
+	Created          pgtype.Timestamptz
+	Expires          pgtype.Timestamptz
+	CheckedAt        pgtype.Timestamptz
+	NotifiedExpires  pgtype.Timestamptz
+	NotifiedChannels []string
+}
+
+type Deployment struct {
+	ID              string
+	Team            string
+	Env             string
+	Repository      string
+	Created         pgtype.Timestamptz
+	CommitTimestamp pgtype.Timestamptz
+}
+
+type DeploymentResource struct {
+	ID           string
+	DeploymentID string
+	Group        string
+	Kind         string
+	Name         string
+	Version      string
+	Namespace    string
+}
+
+type DeploymentStatus struct {
+	ID           string
+	DeploymentID string
+	Status       string
+	Message      string
+	Created      pgtype.Timestamptz
+}
+
 type ResourceUtilizationMetric struct {
 	ID           int32
 	Timestamp    pgtype.Timestamptz
@@ -89,3 +187,67 @@ type ResourceUtilizationMetric struct {
 	Usage        float64
 	Request      float64
 }
+
+type VulnerabilityAnalysisAudit struct {
+	ID            int32
+	Created       pgtype.Timestamptz
+	Actor         string
+	Team          string
+	Env           string
+	App           string
+	FindingID     string
+	State         string
+	Justification *string
+	Comment       *string
+	Suppressed    *bool
+}
+
+type VulnerabilityCriticalFinding struct {
+	ID        int32
+	Team      string
+	Env       string
+	App       string
+	Finding   string
+	FirstSeen pgtype.Timestamptz
+	Resolved  pgtype.Timestamptz
+}
+
+type VulnerabilityIndex struct {
+	Team       string
+	Env        string
+	Kind       string
+	App        string
+	Image      string
+	HasBom     bool
+	Critical   int32
+	High       int32
+	Medium     int32
+	Low        int32
+	Unassigned int32
+	RiskScore  int32
+	UpdatedAt  pgtype.Timestamptz
+}
+
+type VulnerabilityIndexComponent struct {
+	Team    string
+	Env     string
+	Kind    string
+	App     string
+	Group   string
+	Name    string
+	Version string
+	Purl    string
+}
+
+type VulnerabilitySnapshot struct {
+	Date       pgtype.Date
+	Team       string
+	Env        string
+	App        string
+	Critical   int32
+	High       int32
+	Medium     int32
+	Low        int32
+	Unassigned int32
+	RiskScore  int32
+}
@@ -11,11 +11,65 @@ import (
 )
 
 type Querier interface {
+	// AppCommitUpsert will record that an app is running a commit.
+	AppCommitUpsert(ctx context.Context, arg AppCommitUpsertParams) error
+	// AppCommits will fetch the commits an app has run, the last one seen first.
+	AppCommits(ctx context.Context, arg AppCommitsParams) ([]string, error)
+	// AppFailureEnd will record that an app is no longer failing.
+	AppFailureEnd(ctx context.Context, arg AppFailureEndParams) error
+	// AppFailureStart will record that an app has started failing, unless the app is already failing.
+	AppFailureStart(ctx context.Context, arg AppFailureStartParams) error
+	// AppFailures will fetch the failures of all apps that have not ended, or ended after the given time.
+	AppFailures(ctx context.Context, since pgtype.Timestamptz) ([]*AppFailure, error)
+	// AppRollbackInsert will record that an app has been rolled back to a commit.
+	AppRollbackInsert(ctx context.Context, arg AppRollbackInsertParams) error
+	// AppRollbacks will fetch the rollbacks of all apps detected after the given time.
+	AppRollbacks(ctx context.Context, since pgtype.Timestamptz) ([]*AppRollback, error)
 	// AverageResourceUtilizationForTeam will return the average resource utilization for a team for a week.
 	AverageResourceUtilizationForTeam(ctx context.Context, arg AverageResourceUtilizationForTeamParams) (*AverageResourceUtilizationForTeamRow, error)
+	// ComponentUsage will fetch components from the vulnerability index by name or package URL. The name matches with or
+	// without the group, and the package URL matches regardless of version.
+	ComponentUsage(ctx context.Context, arg ComponentUsageParams) ([]*VulnerabilityIndexComponent, error)
+	// CostAnomaliesForTeam will fetch the cost anomalies of a team that were seen in a date range, most recent first.
+	CostAnomaliesForTeam(ctx context.Context, arg CostAnomaliesForTeamParams) ([]*CostAnomaly, error)
+	// CostAnomalyUpsert will store an anomaly of a cost series. If the series had an anomaly the day before, the anomaly
+	// is extended to the date, and keeps the date it was first seen.
+	CostAnomalyUpsert(ctx context.Context, arg CostAnomalyUpsertParams) error
+	// CostBreakdownForTeam will fetch the total cost of a team in a date range for each environment, app and cost type.
+	CostBreakdownForTeam(ctx context.Context, arg CostBreakdownForTeamParams) ([]*CostBreakdownForTeamRow, error)
+	// CostBudget will fetch the monthly cost budget of a team.
+	CostBudget(ctx context.Context, team string) (*CostBudget, error)
+	// CostBudgetDelete will remove the monthly cost budget of a team.
+	CostBudgetDelete(ctx context.Context, team string) error
+	// CostBudgetNotified will record the highest threshold of the budget the team has been notified about in a month.
+	CostBudgetNotified(ctx context.Context, arg CostBudgetNotifiedParams) error
+	// CostBudgetUpsert will set the monthly cost budget of a team, and return the stored row. Notifications are reset, so
+	// that thresholds of the new budget will be notified about.
+	CostBudgetUpsert(ctx context.Context, arg CostBudgetUpsertParams) (*CostBudget, error)
+	// CostBudgets will fetch the monthly cost budgets of all teams.
+	CostBudgets(ctx context.Context) ([]*CostBudget, error)
+	// CostExport will fetch a page of the daily cost of a team in a date range, optionally for a single environment and
+	// app, ordered by date and id. The page starts after the given date and id, so that large ranges can be read in pages.
+	CostExport(ctx context.Context, arg CostExportParams) ([]*CostExportRow, error)
+	// CostForTeams will fetch the total cost for each of the given teams in a date range, across all apps, envs and cost
+	// types.
+	CostForTeams(ctx context.Context, arg CostForTeamsParams) ([]*CostForTeamsRow, error)
+	// CostSeries will fetch the daily cost of each team, app, environment and cost type in a date range, ordered by series
+	// and date. Cost without a team is not included.
+	CostSeries(ctx context.Context, arg CostSeriesParams) ([]*CostSeriesRow, error)
 	// CostUpsert will insert or update a cost record. If there is a conflict on the daily_cost_key constrant, the
 	// daily_cost column will be updated.
 	CostUpsert(ctx context.Context, arg []CostUpsertParams) *CostUpsertBatchResults
+	// CriticalFindingCreate will record that a critical finding has appeared in an app.
+	CriticalFindingCreate(ctx context.Context, arg CriticalFindingCreateParams) error
+	// CriticalFindingResolve will record that a critical finding has disappeared from an app.
+	CriticalFindingResolve(ctx context.Context, arg CriticalFindingResolveParams) error
+	// CriticalFindingsRemediation will calculate the mean time to remediate critical findings of a team that were resolved
+	// in a date range. The findings can optionally be limited to a single app.
+	CriticalFindingsRemediation(ctx context.Context, arg CriticalFindingsRemediationParams) (*CriticalFindingsRemediationRow, error)
+	// CriticalFindingsResolveForRemovedApps will resolve the open critical findings of all apps that are not in the given
+	// lists of teams, envs and apps, where the apps are identified by the elements at the same index in each list.
+	CriticalFindingsResolveForRemovedApps(ctx context.Context, arg CriticalFindingsResolveForRemovedAppsParams) error
 	// DailyCostForApp will fetch the daily cost for a specific team app in a specific environment, across all cost types
 	// in a date range.
 	DailyCostForApp(ctx context.Context, arg DailyCostForAppParams) ([]*Cost, error)
@@ -23,12 +77,48 @@ type Querier interface {
 	DailyCostForTeam(ctx context.Context, arg DailyCostForTeamParams) ([]*Cost, error)
 	// DailyEnvCostForTeam will fetch the daily cost for a specific team and env across all apps in a date range.
 	DailyEnvCostForTeam(ctx context.Context, arg DailyEnvCostForTeamParams) ([]*DailyEnvCostForTeamRow, error)
+	// DeliveryMetricsForTeam will fetch the daily delivery metrics for all apps of a team in a date range.
+	DeliveryMetricsForTeam(ctx context.Context, arg DeliveryMetricsForTeamParams) ([]*DeliveryMetric, error)
+	// DeliveryMetricsUpsert will insert or update the daily delivery metrics for an app. If there is a conflict on the
+	// delivery_metric constraint, all metrics for the day will be replaced.
+	DeliveryMetricsUpsert(ctx context.Context, arg []DeliveryMetricsUpsertParams) *DeliveryMetricsUpsertBatchResults
+	// DeployKeyChannelNotified will record that a Slack channel of the team has been notified about their current deploy
+	// key.
+	DeployKeyChannelNotified(ctx context.Context, arg DeployKeyChannelNotifiedParams) error
+	// DeployKeyNotified will record that the team has been notified about their deploy key with the given expiry.
+	DeployKeyNotified(ctx context.Context, arg DeployKeyNotifiedParams) error
+	// DeployKeyUpsert will insert or update the deploy key expiry of a team, and return the stored row. The notified
+	// channels are reset when the expiry changes.
+	DeployKeyUpsert(ctx context.Context, arg DeployKeyUpsertParams) (*DeployKey, error)
+	// DeploymentResourceUpsert will insert or update resources of deployments.
+	DeploymentResourceUpsert(ctx context.Context, arg []DeploymentResourceUpsertParams) *DeploymentResourceUpsertBatchResults
+	// DeploymentResourcesForDeployments will fetch the resources of the given deployments.
+	DeploymentResourcesForDeployments(ctx context.Context, deploymentIds []string) ([]*DeploymentResource, error)
+	// DeploymentStatusUpsert will insert or update statuses of deployments.
+	DeploymentStatusUpsert(ctx context.Context, arg []DeploymentStatusUpsertParams) *DeploymentStatusUpsertBatchResults
+	// DeploymentStatusesForDeployments will fetch the statuses of the given deployments.
+	DeploymentStatusesForDeployments(ctx context.Context, deploymentIds []string) ([]*DeploymentStatus, error)
+	// DeploymentUpsert will insert or update a deployment. A known commit timestamp is kept when the deployment is
+	// updated without one, as hookd does not return commit timestamps.
+	DeploymentUpsert(ctx context.Context, arg DeploymentUpsertParams) error
+	// Deployments will fetch deployments, newest first. All filters are optional, an empty list of teams includes all teams. The state of a deployment is given by its
+	// most recent status, and a deployment without statuses is in progress. created_from is inclusive and created_before is
+	// exclusive.
+	Deployments(ctx context.Context, arg DeploymentsParams) ([]*Deployment, error)
+	// ExpiringDeployKeys will fetch deploy keys that expire before the given time, soonest first.
+	ExpiringDeployKeys(ctx context.Context, before pgtype.Timestamptz) ([]*DeployKey, error)
 	// LastCostDate will return the last date that has a cost.
 	LastCostDate(ctx context.Context) (pgtype.Date, error)
 	// MaxResourceUtilizationDate will return the max date for resource utilization records.
 	MaxResourceUtilizationDate(ctx context.Context) (pgtype.Timestamptz, error)
 	MonthlyCostForApp(ctx context.Context, arg MonthlyCostForAppParams) ([]*MonthlyCostForAppRow, error)
 	MonthlyCostForTeam(ctx context.Context, team *string) ([]*MonthlyCostForTeamRow, error)
+	// OpenCriticalFindings will fetch the critical findings of an app that have not been resolved.
+	OpenCriticalFindings(ctx context.Context, arg OpenCriticalFindingsParams) ([]*VulnerabilityCriticalFinding, error)
+	// ResourceUtilizationExport will fetch a page of the resource utilization records of a team in a time range,
+	// optionally for a single environment and app, ordered by timestamp and id. The page starts after the given timestamp
+	// and id, so that large ranges can be read in pages.
+	ResourceUtilizationExport(ctx context.Context, arg ResourceUtilizationExportParams) ([]*ResourceUtilizationMetric, error)
 	// ResourceUtilizationForApp will return resource utilization records for a given app.
 	ResourceUtilizationForApp(ctx context.Context, arg ResourceUtilizationForAppParams) ([]*ResourceUtilizationMetric, error)
 	// ResourceUtilizationForTeam will return resource utilization records for a given team.
@@ -46,6 +136,39 @@ type Querier interface {
 	// SpecificResourceUtilizationForTeam will return resource utilization for a team at a specific timestamp. Applications
 	// with a usage greater than request will be ignored.
 	SpecificResourceUtilizationForTeam(ctx context.Context, arg SpecificResourceUtilizationForTeamParams) (*SpecificResourceUtilizationForTeamRow, error)
+	// TenantAppCostGrowth will fetch the apps with the highest increase in cost from a previous period to a current period.
+	TenantAppCostGrowth(ctx context.Context, arg TenantAppCostGrowthParams) ([]*TenantAppCostGrowthRow, error)
+	// TenantCostPerEnv will fetch the total cost of all teams in a date range for each environment, highest cost first.
+	TenantCostPerEnv(ctx context.Context, arg TenantCostPerEnvParams) ([]*TenantCostPerEnvRow, error)
+	// TenantTeamCostGrowth will fetch the teams with the highest increase in cost from a previous period to a current
+	// period.
+	TenantTeamCostGrowth(ctx context.Context, arg TenantTeamCostGrowthParams) ([]*TenantTeamCostGrowthRow, error)
+	// TenantTopApps will fetch the apps with the highest total cost in a date range.
+	TenantTopApps(ctx context.Context, arg TenantTopAppsParams) ([]*TenantTopAppsRow, error)
+	// TenantTopTeams will fetch the teams with the highest total cost in a date range.
+	TenantTopTeams(ctx context.Context, arg TenantTopTeamsParams) ([]*TenantTopTeamsRow, error)
+	// VulnerabilityAnalysisAuditCreate will record an analysis of a vulnerability finding in the audit trail, and return
+	// the ID of the entry.
+	VulnerabilityAnalysisAuditCreate(ctx context.Context, arg VulnerabilityAnalysisAuditCreateParams) (int32, error)
+	// VulnerabilityAnalysisAuditDelete will remove an entry from the audit trail, for analyses that could not be recorded.
+	VulnerabilityAnalysisAuditDelete(ctx context.Context, id int32) error
+	// VulnerabilityIndexComponentsDelete will remove all components of a workload from the vulnerability index.
+	VulnerabilityIndexComponentsDelete(ctx context.Context, arg VulnerabilityIndexComponentsDeleteParams) error
+	// VulnerabilityIndexComponentsInsert will add components of a workload to the vulnerability index.
+	VulnerabilityIndexComponentsInsert(ctx context.Context, arg []VulnerabilityIndexComponentsInsertParams) *VulnerabilityIndexComponentsInsertBatchResults
+	// VulnerabilityIndexDeleteStale will remove workloads that have not been updated since the given time from the
+	// vulnerability index.
+	VulnerabilityIndexDeleteStale(ctx context.Context, before pgtype.Timestamptz) error
+	// VulnerabilityIndexUpsert will insert or update the vulnerability summary of a workload in the vulnerability index.
+	VulnerabilityIndexUpsert(ctx context.Context, arg VulnerabilityIndexUpsertParams) error
+	// VulnerabilitySnapshotUpsert will insert or update the daily vulnerability snapshot of an app.
+	VulnerabilitySnapshotUpsert(ctx context.Context, arg VulnerabilitySnapshotUpsertParams) error
+	// VulnerabilitySnapshotsForApp will fetch the daily vulnerability snapshots of an app in a date range.
+	VulnerabilitySnapshotsForApp(ctx context.Context, arg VulnerabilitySnapshotsForAppParams) ([]*VulnerabilitySnapshot, error)
+	// VulnerabilitySnapshotsForTeam will fetch the daily vulnerability snapshots of all apps of a team in a date range.
+	VulnerabilitySnapshotsForTeam(ctx context.Context, arg VulnerabilitySnapshotsForTeamParams) ([]*VulnerabilitySnapshot, error)
+	// VulnerabilitySummaries will fetch vulnerability summaries from the vulnerability index, aggregated per team and env.
+	VulnerabilitySummaries(ctx context.Context, arg VulnerabilitySummariesParams) ([]*VulnerabilitySummariesRow, error)
 }
 
 var _ Querier = (*Queries)(nil)
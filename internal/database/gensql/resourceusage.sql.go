@@ -56,6 +56,76 @@ func (q *Queries) MaxResourceUtilizationDate(ctx context.Context) (pgtype.Timest
 	return column_1, err
 }
 
+const resourceUtilizationExport = `-- name: ResourceUtilizationExport :many
+SELECT
+    id, timestamp, env, team, app, resource_type, usage, request
+FROM
+    resource_utilization_metrics
+WHERE
+    team = $1
+    AND ($2::text IS NULL OR env = $2::text)
+    AND ($3::text IS NULL OR app = $3::text)
+    AND timestamp >= $4::timestamptz
+    AND timestamp < $5::timestamptz
+    AND (timestamp, id) > ($6::timestamptz, $7::integer)
+ORDER BY
+    timestamp, id ASC
+LIMIT
+    $8
+`
+
+type ResourceUtilizationExportParams struct {
+	Team           string
+	Env            *string
+	App            *string
+	Start          pgtype.Timestamptz
+	End            pgtype.Timestamptz
+	AfterTimestamp pgtype.Timestamptz
+	AfterID        int32
+	PageSize       int32
+}
+
+// ResourceUtilizationExport will fetch a page of the resource utilization records of a team in a time range,
+// optionally for a single environment and app, ordered by timestamp and id. The page starts after the given timestamp
+// and id, so that large ranges can be read in pages.
+func (q *Queries) ResourceUtilizationExport(ctx context.Context, arg ResourceUtilizationExportParams) ([]*ResourceUtilizationMetric, error) {
+	rows, err := q.db.Query(ctx, resourceUtilizationExport,
+		arg.Team,
+		arg.Env,
+		arg.App,
+		arg.Start,
+		arg.End,
+		arg.AfterTimestamp,
+		arg.AfterID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ResourceUtilizationMetric
+	for rows.Next() {
+		var i ResourceUtilizationMetric
+		if err := rows.Scan(
+			&i.ID,
+			&i.Timestamp,
+			&i.Env,
+			&i.Team,
+			&i.App,
+			&i.ResourceType,
+			&i.Usage,
+			&i.Request,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const resourceUtilizationForApp = `-- name: ResourceUtilizationForApp :many
 SELECT
     id, timestamp, env, team, app, resource_type, usage, request
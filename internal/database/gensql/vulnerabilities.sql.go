@@ -0,0 +1,276 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.23.0
+// source: vulnerabilities.sql
+
+package gensql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const componentUsage = `-- name: ComponentUsage :many
+SELECT
+    team, env, kind, app, "group", name, version, purl
+FROM
+    vulnerability_index_components
+WHERE
+    (
+        $1::text IS NULL
+        OR LOWER(name) = LOWER($1::text)
+        OR LOWER("group" || '/' || name) = LOWER($1::text)
+    )
+    AND (
+        $2::text IS NULL
+        OR split_part(purl, '@', 1) = split_part($2::text, '@', 1)
+    )
+ORDER BY
+    team, env, app, kind, "group", name, version ASC
+`
+
+type ComponentUsageParams struct {
+	Name *string
+	Purl *string
+}
+
+// ComponentUsage will fetch components from the vulnerability index by name or package URL. The name matches with or
+// without the group, and the package URL matches regardless of version.
+func (q *Queries) ComponentUsage(ctx context.Context, arg ComponentUsageParams) ([]*VulnerabilityIndexComponent, error) {
+	rows, err := q.db.Query(ctx, componentUsage, arg.Name, arg.Purl)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*VulnerabilityIndexComponent
+	for rows.Next() {
+		var i VulnerabilityIndexComponent
+		if err := rows.Scan(
+			&i.Team,
+			&i.Env,
+			&i.Kind,
+			&i.App,
+			&i.Group,
+			&i.Name,
+			&i.Version,
+			&i.Purl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const vulnerabilityAnalysisAuditCreate = `-- name: VulnerabilityAnalysisAuditCreate :one
+INSERT INTO vulnerability_analysis_audit (actor, team, env, app, finding_id, state, justification, comment, suppressed)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING id
+`
+
+type VulnerabilityAnalysisAuditCreateParams struct {
+	Actor         string
+	Team          string
+	Env           string
+	App           string
+	FindingID     string
+	State         string
+	Justification *string
+	Comment       *string
+	Suppressed    *bool
+}
+
+// VulnerabilityAnalysisAuditCreate will record an analysis of a vulnerability finding in the audit trail, and return
+// the ID of the entry.
+func (q *Queries) VulnerabilityAnalysisAuditCreate(ctx context.Context, arg VulnerabilityAnalysisAuditCreateParams) (int32, error) {
+	row := q.db.QueryRow(ctx, vulnerabilityAnalysisAuditCreate,
+		arg.Actor,
+		arg.Team,
+		arg.Env,
+		arg.App,
+		arg.FindingID,
+		arg.State,
+		arg.Justification,
+		arg.Comment,
+		arg.Suppressed,
+	)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
+
+const vulnerabilityAnalysisAuditDelete = `-- name: VulnerabilityAnalysisAuditDelete :exec
+DELETE FROM vulnerability_analysis_audit
+WHERE id = $1
+`
+
+// VulnerabilityAnalysisAuditDelete will remove an entry from the audit trail, for analyses that could not be recorded.
+func (q *Queries) VulnerabilityAnalysisAuditDelete(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, vulnerabilityAnalysisAuditDelete, id)
+	return err
+}
+
+const vulnerabilityIndexComponentsDelete = `-- name: VulnerabilityIndexComponentsDelete :exec
+DELETE FROM vulnerability_index_components
+WHERE team = $1 AND env = $2 AND kind = $3 AND app = $4
+`
+
+type VulnerabilityIndexComponentsDeleteParams struct {
+	Team string
+	Env  string
+	Kind string
+	App  string
+}
+
+// VulnerabilityIndexComponentsDelete will remove all components of a workload from the vulnerability index.
+func (q *Queries) VulnerabilityIndexComponentsDelete(ctx context.Context, arg VulnerabilityIndexComponentsDeleteParams) error {
+	_, err := q.db.Exec(ctx, vulnerabilityIndexComponentsDelete,
+		arg.Team,
+		arg.Env,
+		arg.Kind,
+		arg.App,
+	)
+	return err
+}
+
+const vulnerabilityIndexDeleteStale = `-- name: VulnerabilityIndexDeleteStale :exec
+DELETE FROM vulnerability_index
+WHERE updated_at < $1::timestamptz
+`
+
+// VulnerabilityIndexDeleteStale will remove workloads that have not been updated since the given time from the
+// vulnerability index.
+func (q *Queries) VulnerabilityIndexDeleteStale(ctx context.Context, before pgtype.Timestamptz) error {
+	_, err := q.db.Exec(ctx, vulnerabilityIndexDeleteStale, before)
+	return err
+}
+
+const vulnerabilityIndexUpsert = `-- name: VulnerabilityIndexUpsert :exec
+INSERT INTO vulnerability_index (team, env, kind, app, image, has_bom, critical, high, medium, low, unassigned, risk_score)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+ON CONFLICT (team, env, kind, app) DO
+    UPDATE SET
+        image = EXCLUDED.image,
+        has_bom = EXCLUDED.has_bom,
+        critical = EXCLUDED.critical,
+        high = EXCLUDED.high,
+        medium = EXCLUDED.medium,
+        low = EXCLUDED.low,
+        unassigned = EXCLUDED.unassigned,
+        risk_score = EXCLUDED.risk_score,
+        updated_at = NOW()
+`
+
+type VulnerabilityIndexUpsertParams struct {
+	Team       string
+	Env        string
+	Kind       string
+	App        string
+	Image      string
+	HasBom     bool
+	Critical   int32
+	High       int32
+	Medium     int32
+	Low        int32
+	Unassigned int32
+	RiskScore  int32
+}
+
+// VulnerabilityIndexUpsert will insert or update the vulnerability summary of a workload in the vulnerability index.
+func (q *Queries) VulnerabilityIndexUpsert(ctx context.Context, arg VulnerabilityIndexUpsertParams) error {
+	_, err := q.db.Exec(ctx, vulnerabilityIndexUpsert,
+		arg.Team,
+		arg.Env,
+		arg.Kind,
+		arg.App,
+		arg.Image,
+		arg.HasBom,
+		arg.Critical,
+		arg.High,
+		arg.Medium,
+		arg.Low,
+		arg.Unassigned,
+		arg.RiskScore,
+	)
+	return err
+}
+
+const vulnerabilitySummaries = `-- name: VulnerabilitySummaries :many
+SELECT
+    team,
+    env,
+    COUNT(*)::integer AS apps,
+    (COUNT(*) FILTER (WHERE NOT has_bom))::integer AS apps_without_bom,
+    SUM(critical)::integer AS critical,
+    SUM(high)::integer AS high,
+    SUM(medium)::integer AS medium,
+    SUM(low)::integer AS low,
+    SUM(unassigned)::integer AS unassigned,
+    SUM(risk_score)::integer AS risk_score,
+    MAX(updated_at)::timestamptz AS updated_at
+FROM
+    vulnerability_index
+WHERE
+    ($1::text IS NULL OR team = $1::text)
+    AND ($2::text IS NULL OR env = $2::text)
+GROUP BY
+    team, env
+ORDER BY
+    team, env ASC
+`
+
+type VulnerabilitySummariesParams struct {
+	Team *string
+	Env  *string
+}
+
+type VulnerabilitySummariesRow struct {
+	Team           string
+	Env            string
+	Apps           int32
+	AppsWithoutBom int32
+	Critical       int32
+	High           int32
+	Medium         int32
+	Low            int32
+	Unassigned     int32
+	RiskScore      int32
+	UpdatedAt      pgtype.Timestamptz
+}
+
+// VulnerabilitySummaries will fetch vulnerability summaries from the vulnerability index, aggregated per team and env.
+func (q *Queries) VulnerabilitySummaries(ctx context.Context, arg VulnerabilitySummariesParams) ([]*VulnerabilitySummariesRow, error) {
+	rows, err := q.db.Query(ctx, vulnerabilitySummaries, arg.Team, arg.Env)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*VulnerabilitySummariesRow
+	for rows.Next() {
+		var i VulnerabilitySummariesRow
+		if err := rows.Scan(
+			&i.Team,
+			&i.Env,
+			&i.Apps,
+			&i.AppsWithoutBom,
+			&i.Critical,
+			&i.High,
+			&i.Medium,
+			&i.Low,
+			&i.Unassigned,
+			&i.RiskScore,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
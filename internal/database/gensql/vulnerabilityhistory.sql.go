@@ -0,0 +1,327 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.23.0
+// source: vulnerabilityhistory.sql
+
+package gensql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const criticalFindingCreate = `-- name: CriticalFindingCreate :exec
+INSERT INTO vulnerability_critical_findings (team, env, app, finding, first_seen)
+VALUES ($1, $2, $3, $4, $5)
+`
+
+type CriticalFindingCreateParams struct {
+	Team      string
+	Env       string
+	App       string
+	Finding   string
+	FirstSeen pgtype.Timestamptz
+}
+
+// CriticalFindingCreate will record that a critical finding has appeared in an app.
+func (q *Queries) CriticalFindingCreate(ctx context.Context, arg CriticalFindingCreateParams) error {
+	_, err := q.db.Exec(ctx, criticalFindingCreate,
+		arg.Team,
+		arg.Env,
+		arg.App,
+		arg.Finding,
+		arg.FirstSeen,
+	)
+	return err
+}
+
+const criticalFindingResolve = `-- name: CriticalFindingResolve :exec
+UPDATE vulnerability_critical_findings
+SET resolved = $2
+WHERE id = $1
+`
+
+type CriticalFindingResolveParams struct {
+	ID       int32
+	Resolved pgtype.Timestamptz
+}
+
+// CriticalFindingResolve will record that a critical finding has disappeared from an app.
+func (q *Queries) CriticalFindingResolve(ctx context.Context, arg CriticalFindingResolveParams) error {
+	_, err := q.db.Exec(ctx, criticalFindingResolve, arg.ID, arg.Resolved)
+	return err
+}
+
+const criticalFindingsRemediation = `-- name: CriticalFindingsRemediation :one
+SELECT
+    COUNT(*)::integer AS resolved,
+    COALESCE(AVG(EXTRACT(EPOCH FROM resolved - first_seen)), 0)::float8 AS mean_seconds
+FROM
+    vulnerability_critical_findings
+WHERE
+    team = $1
+    AND ($2::text IS NULL OR env = $2::text)
+    AND ($3::text IS NULL OR app = $3::text)
+    AND resolved >= $4::date
+    AND resolved < $5::date + 1
+`
+
+type CriticalFindingsRemediationParams struct {
+	Team     string
+	Env      *string
+	App      *string
+	FromDate pgtype.Date
+	ToDate   pgtype.Date
+}
+
+type CriticalFindingsRemediationRow struct {
+	Resolved    int32
+	MeanSeconds float64
+}
+
+// CriticalFindingsRemediation will calculate the mean time to remediate critical findings of a team that were resolved
+// in a date range. The findings can optionally be limited to a single app.
+func (q *Queries) CriticalFindingsRemediation(ctx context.Context, arg CriticalFindingsRemediationParams) (*CriticalFindingsRemediationRow, error) {
+	row := q.db.QueryRow(ctx, criticalFindingsRemediation,
+		arg.Team,
+		arg.Env,
+		arg.App,
+		arg.FromDate,
+		arg.ToDate,
+	)
+	var i CriticalFindingsRemediationRow
+	err := row.Scan(&i.Resolved, &i.MeanSeconds)
+	return &i, err
+}
+
+const criticalFindingsResolveForRemovedApps = `-- name: CriticalFindingsResolveForRemovedApps :exec
+UPDATE vulnerability_critical_findings
+SET resolved = $1
+WHERE
+    resolved IS NULL
+    AND (team, env, app) NOT IN (
+        SELECT unnest($2::text[]), unnest($3::text[]), unnest($4::text[])
+    )
+`
+
+type CriticalFindingsResolveForRemovedAppsParams struct {
+	Resolved pgtype.Timestamptz
+	Teams    []string
+	Envs     []string
+	Apps     []string
+}
+
+// CriticalFindingsResolveForRemovedApps will resolve the open critical findings of all apps that are not in the given
+// lists of teams, envs and apps, where the apps are identified by the elements at the same index in each list.
+func (q *Queries) CriticalFindingsResolveForRemovedApps(ctx context.Context, arg CriticalFindingsResolveForRemovedAppsParams) error {
+	_, err := q.db.Exec(ctx, criticalFindingsResolveForRemovedApps,
+		arg.Resolved,
+		arg.Teams,
+		arg.Envs,
+		arg.Apps,
+	)
+	return err
+}
+
+const openCriticalFindings = `-- name: OpenCriticalFindings :many
+SELECT
+    id, team, env, app, finding, first_seen, resolved
+FROM
+    vulnerability_critical_findings
+WHERE
+    team = $1
+    AND env = $2
+    AND app = $3
+    AND resolved IS NULL
+`
+
+type OpenCriticalFindingsParams struct {
+	Team string
+	Env  string
+	App  string
+}
+
+// OpenCriticalFindings will fetch the critical findings of an app that have not been resolved.
+func (q *Queries) OpenCriticalFindings(ctx context.Context, arg OpenCriticalFindingsParams) ([]*VulnerabilityCriticalFinding, error) {
+	rows, err := q.db.Query(ctx, openCriticalFindings, arg.Team, arg.Env, arg.App)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*VulnerabilityCriticalFinding
+	for rows.Next() {
+		var i VulnerabilityCriticalFinding
+		if err := rows.Scan(
+			&i.ID,
+			&i.Team,
+			&i.Env,
+			&i.App,
+			&i.Finding,
+			&i.FirstSeen,
+			&i.Resolved,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const vulnerabilitySnapshotUpsert = `-- name: VulnerabilitySnapshotUpsert :exec
+INSERT INTO vulnerability_snapshots (date, team, env, app, critical, high, medium, low, unassigned, risk_score)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+ON CONFLICT (date, team, env, app) DO
+    UPDATE SET
+        critical = EXCLUDED.critical,
+        high = EXCLUDED.high,
+        medium = EXCLUDED.medium,
+        low = EXCLUDED.low,
+        unassigned = EXCLUDED.unassigned,
+        risk_score = EXCLUDED.risk_score
+`
+
+type VulnerabilitySnapshotUpsertParams struct {
+	Date       pgtype.Date
+	Team       string
+	Env        string
+	App        string
+	Critical   int32
+	High       int32
+	Medium     int32
+	Low        int32
+	Unassigned int32
+	RiskScore  int32
+}
+
+// VulnerabilitySnapshotUpsert will insert or update the daily vulnerability snapshot of an app.
+func (q *Queries) VulnerabilitySnapshotUpsert(ctx context.Context, arg VulnerabilitySnapshotUpsertParams) error {
+	_, err := q.db.Exec(ctx, vulnerabilitySnapshotUpsert,
+		arg.Date,
+		arg.Team,
+		arg.Env,
+		arg.App,
+		arg.Critical,
+		arg.High,
+		arg.Medium,
+		arg.Low,
+		arg.Unassigned,
+		arg.RiskScore,
+	)
+	return err
+}
+
+const vulnerabilitySnapshotsForApp = `-- name: VulnerabilitySnapshotsForApp :many
+SELECT
+    date, team, env, app, critical, high, medium, low, unassigned, risk_score
+FROM
+    vulnerability_snapshots
+WHERE
+    team = $1
+    AND env = $2
+    AND app = $3
+    AND date >= $4::date
+    AND date <= $5::date
+ORDER BY
+    date ASC
+`
+
+type VulnerabilitySnapshotsForAppParams struct {
+	Team     string
+	Env      string
+	App      string
+	FromDate pgtype.Date
+	ToDate   pgtype.Date
+}
+
+// VulnerabilitySnapshotsForApp will fetch the daily vulnerability snapshots of an app in a date range.
+func (q *Queries) VulnerabilitySnapshotsForApp(ctx context.Context, arg VulnerabilitySnapshotsForAppParams) ([]*VulnerabilitySnapshot, error) {
+	rows, err := q.db.Query(ctx, vulnerabilitySnapshotsForApp,
+		arg.Team,
+		arg.Env,
+		arg.App,
+		arg.FromDate,
+		arg.ToDate,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*VulnerabilitySnapshot
+	for rows.Next() {
+		var i VulnerabilitySnapshot
+		if err := rows.Scan(
+			&i.Date,
+			&i.Team,
+			&i.Env,
+			&i.App,
+			&i.Critical,
+			&i.High,
+			&i.Medium,
+			&i.Low,
+			&i.Unassigned,
+			&i.RiskScore,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const vulnerabilitySnapshotsForTeam = `-- name: VulnerabilitySnapshotsForTeam :many
+SELECT
+    date, team, env, app, critical, high, medium, low, unassigned, risk_score
+FROM
+    vulnerability_snapshots
+WHERE
+    team = $1
+    AND date >= $2::date
+    AND date <= $3::date
+ORDER BY
+    date, env, app ASC
+`
+
+type VulnerabilitySnapshotsForTeamParams struct {
+	Team     string
+	FromDate pgtype.Date
+	ToDate   pgtype.Date
+}
+
+// VulnerabilitySnapshotsForTeam will fetch the daily vulnerability snapshots of all apps of a team in a date range.
+func (q *Queries) VulnerabilitySnapshotsForTeam(ctx context.Context, arg VulnerabilitySnapshotsForTeamParams) ([]*VulnerabilitySnapshot, error) {
+	rows, err := q.db.Query(ctx, vulnerabilitySnapshotsForTeam, arg.Team, arg.FromDate, arg.ToDate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*VulnerabilitySnapshot
+	for rows.Next() {
+		var i VulnerabilitySnapshot
+		if err := rows.Scan(
+			&i.Date,
+			&i.Team,
+			&i.Env,
+			&i.App,
+			&i.Critical,
+			&i.High,
+			&i.Medium,
+			&i.Low,
+			&i.Unassigned,
+			&i.RiskScore,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
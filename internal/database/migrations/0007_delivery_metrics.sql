@@ -0,0 +1,57 @@
+-- +goose Up
+CREATE TABLE delivery_metrics (
+    id serial PRIMARY KEY,
+    date date NOT NULL,
+    env text NOT NULL,
+    team text NOT NULL,
+    app text NOT NULL,
+    deployments integer NOT NULL,
+    failed_deployments integer NOT NULL,
+    rollbacks integer NOT NULL,
+    restores integer NOT NULL,
+    restore_time_seconds bigint NOT NULL,
+    lead_times integer NOT NULL,
+    lead_time_seconds bigint NOT NULL,
+    CONSTRAINT delivery_metric UNIQUE (date, env, team, app)
+);
+
+CREATE INDEX ON delivery_metrics (date);
+CREATE INDEX ON delivery_metrics (team);
+
+CREATE TABLE app_failures (
+    id serial PRIMARY KEY,
+    team text NOT NULL,
+    env text NOT NULL,
+    app text NOT NULL,
+    started timestamp with time zone NOT NULL,
+    ended timestamp with time zone
+);
+
+CREATE UNIQUE INDEX app_failures_open ON app_failures (team, env, app) WHERE ended IS NULL;
+CREATE INDEX ON app_failures (ended);
+
+CREATE TABLE app_commits (
+    team text NOT NULL,
+    env text NOT NULL,
+    app text NOT NULL,
+    commit_sha text NOT NULL,
+    last_seen timestamp with time zone NOT NULL,
+    PRIMARY KEY (team, env, app, commit_sha)
+);
+
+CREATE TABLE app_rollbacks (
+    id serial PRIMARY KEY,
+    team text NOT NULL,
+    env text NOT NULL,
+    app text NOT NULL,
+    commit_sha text NOT NULL,
+    detected timestamp with time zone NOT NULL
+);
+
+CREATE INDEX ON app_rollbacks (detected);
+
+-- +goose Down
+DROP TABLE app_rollbacks;
+DROP TABLE app_commits;
+DROP TABLE app_failures;
+DROP TABLE delivery_metrics;
@@ -0,0 +1,39 @@
+-- +goose Up
+CREATE TABLE deployments (
+    id text PRIMARY KEY,
+    team text NOT NULL,
+    env text NOT NULL,
+    repository text NOT NULL,
+    created timestamp with time zone NOT NULL,
+    commit_timestamp timestamp with time zone
+);
+
+CREATE INDEX ON deployments (team);
+CREATE INDEX ON deployments (created);
+
+CREATE TABLE deployment_statuses (
+    id text PRIMARY KEY,
+    deployment_id text NOT NULL REFERENCES deployments (id) ON DELETE CASCADE,
+    status text NOT NULL,
+    message text NOT NULL,
+    created timestamp with time zone NOT NULL
+);
+
+CREATE INDEX ON deployment_statuses (deployment_id);
+
+CREATE TABLE deployment_resources (
+    id text PRIMARY KEY,
+    deployment_id text NOT NULL REFERENCES deployments (id) ON DELETE CASCADE,
+    "group" text NOT NULL,
+    kind text NOT NULL,
+    name text NOT NULL,
+    version text NOT NULL,
+    namespace text NOT NULL
+);
+
+CREATE INDEX ON deployment_resources (deployment_id);
+
+-- +goose Down
+DROP TABLE deployment_resources;
+DROP TABLE deployment_statuses;
+DROP TABLE deployments;
@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE deploy_keys (
+    team text PRIMARY KEY,
+    created timestamp with time zone NOT NULL,
+    expires timestamp with time zone NOT NULL,
+    checked_at timestamp with time zone NOT NULL DEFAULT NOW(),
+    notified_expires timestamp with time zone,
+    notified_channels text[] NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX ON deploy_keys (expires);
+
+-- +goose Down
+DROP TABLE deploy_keys;
@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE TABLE vulnerability_analysis_audit (
+    id serial PRIMARY KEY,
+    created timestamp with time zone NOT NULL DEFAULT NOW(),
+    actor text NOT NULL,
+    team text NOT NULL,
+    env text NOT NULL,
+    app text NOT NULL,
+    finding_id text NOT NULL,
+    state text NOT NULL,
+    justification text,
+    comment text,
+    suppressed boolean
+);
+
+CREATE INDEX ON vulnerability_analysis_audit (team);
+CREATE INDEX ON vulnerability_analysis_audit (finding_id);
+
+-- +goose Down
+DROP TABLE vulnerability_analysis_audit;
@@ -0,0 +1,37 @@
+-- +goose Up
+CREATE TABLE vulnerability_index (
+    team text NOT NULL,
+    env text NOT NULL,
+    kind text NOT NULL,
+    app text NOT NULL,
+    image text NOT NULL,
+    has_bom boolean NOT NULL,
+    critical integer NOT NULL,
+    high integer NOT NULL,
+    medium integer NOT NULL,
+    low integer NOT NULL,
+    unassigned integer NOT NULL,
+    risk_score integer NOT NULL,
+    updated_at timestamp with time zone NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (team, env, kind, app)
+);
+
+CREATE TABLE vulnerability_index_components (
+    team text NOT NULL,
+    env text NOT NULL,
+    kind text NOT NULL,
+    app text NOT NULL,
+    "group" text NOT NULL,
+    name text NOT NULL,
+    version text NOT NULL,
+    purl text NOT NULL,
+    FOREIGN KEY (team, env, kind, app) REFERENCES vulnerability_index (team, env, kind, app) ON DELETE CASCADE
+);
+
+CREATE INDEX ON vulnerability_index_components (team, env, kind, app);
+CREATE INDEX ON vulnerability_index_components (LOWER(name));
+CREATE INDEX ON vulnerability_index_components (split_part(purl, '@', 1));
+
+-- +goose Down
+DROP TABLE vulnerability_index_components;
+DROP TABLE vulnerability_index;
@@ -0,0 +1,33 @@
+-- +goose Up
+CREATE TABLE vulnerability_snapshots (
+    date date NOT NULL,
+    team text NOT NULL,
+    env text NOT NULL,
+    app text NOT NULL,
+    critical integer NOT NULL,
+    high integer NOT NULL,
+    medium integer NOT NULL,
+    low integer NOT NULL,
+    unassigned integer NOT NULL,
+    risk_score integer NOT NULL,
+    PRIMARY KEY (date, team, env, app)
+);
+
+CREATE INDEX ON vulnerability_snapshots (team, date);
+
+CREATE TABLE vulnerability_critical_findings (
+    id serial PRIMARY KEY,
+    team text NOT NULL,
+    env text NOT NULL,
+    app text NOT NULL,
+    finding text NOT NULL,
+    first_seen timestamp with time zone NOT NULL,
+    resolved timestamp with time zone
+);
+
+CREATE UNIQUE INDEX ON vulnerability_critical_findings (team, env, app, finding) WHERE resolved IS NULL;
+CREATE INDEX ON vulnerability_critical_findings (team, resolved);
+
+-- +goose Down
+DROP TABLE vulnerability_critical_findings;
+DROP TABLE vulnerability_snapshots;
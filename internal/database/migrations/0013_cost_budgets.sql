@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE cost_budgets (
+    team text PRIMARY KEY,
+    amount real NOT NULL CHECK (amount > 0),
+    updated_by text NOT NULL,
+    updated timestamp with time zone NOT NULL DEFAULT NOW(),
+    -- the month and highest threshold, in percent of the budget, the team was last notified about
+    notified_month date,
+    notified_threshold integer
+);
+
+-- +goose Down
+DROP TABLE cost_budgets;
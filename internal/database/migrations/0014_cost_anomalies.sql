@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE TABLE cost_anomalies (
+    id serial PRIMARY KEY,
+    team text NOT NULL,
+    env text NOT NULL,
+    app text NOT NULL,
+    cost_type text NOT NULL,
+    -- the first and last day of consecutive days where the cost deviated from the baseline
+    first_seen date NOT NULL,
+    last_seen date NOT NULL,
+    -- the cost and baseline of the last day
+    cost real NOT NULL,
+    baseline real NOT NULL,
+    CONSTRAINT cost_anomaly_key UNIQUE (team, env, app, cost_type, first_seen)
+);
+
+CREATE INDEX cost_anomalies_team_idx ON cost_anomalies (team, last_seen);
+
+-- +goose Down
+DROP TABLE cost_anomalies;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- tenant-wide cost queries aggregate all cost in a date range, so the cost can be read from the index alone
+CREATE INDEX cost_date_team_env_app_idx ON cost (date, team, env, app) INCLUDE (daily_cost);
+
+-- +goose Down
+DROP INDEX cost_date_team_env_app_idx;
@@ -0,0 +1,3593 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package database
+
+import (
+	context "context"
+
+	gensql "github.com/nais/console-backend/internal/database/gensql"
+	mock "github.com/stretchr/testify/mock"
+
+	pgtype "github.com/jackc/pgx/v5/pgtype"
+)
+
+// MockQuerier is an autogenerated mock type for the Querier type
+type MockQuerier struct {
+	mock.Mock
+}
+
+type MockQuerier_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockQuerier) EXPECT() *MockQuerier_Expecter {
+	return &MockQuerier_Expecter{mock: &_m.Mock}
+}
+
+// AppCommitUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) AppCommitUpsert(ctx context.Context, arg gensql.AppCommitUpsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.AppCommitUpsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_AppCommitUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AppCommitUpsert'
+type MockQuerier_AppCommitUpsert_Call struct {
+	*mock.Call
+}
+
+// AppCommitUpsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.AppCommitUpsertParams
+func (_e *MockQuerier_Expecter) AppCommitUpsert(ctx interface{}, arg interface{}) *MockQuerier_AppCommitUpsert_Call {
+	return &MockQuerier_AppCommitUpsert_Call{Call: _e.mock.On("AppCommitUpsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_AppCommitUpsert_Call) Run(run func(ctx context.Context, arg gensql.AppCommitUpsertParams)) *MockQuerier_AppCommitUpsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.AppCommitUpsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_AppCommitUpsert_Call) Return(_a0 error) *MockQuerier_AppCommitUpsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_AppCommitUpsert_Call) RunAndReturn(run func(context.Context, gensql.AppCommitUpsertParams) error) *MockQuerier_AppCommitUpsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AppCommits provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) AppCommits(ctx context.Context, arg gensql.AppCommitsParams) ([]string, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.AppCommitsParams) ([]string, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.AppCommitsParams) []string); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.AppCommitsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_AppCommits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AppCommits'
+type MockQuerier_AppCommits_Call struct {
+	*mock.Call
+}
+
+// AppCommits is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.AppCommitsParams
+func (_e *MockQuerier_Expecter) AppCommits(ctx interface{}, arg interface{}) *MockQuerier_AppCommits_Call {
+	return &MockQuerier_AppCommits_Call{Call: _e.mock.On("AppCommits", ctx, arg)}
+}
+
+func (_c *MockQuerier_AppCommits_Call) Run(run func(ctx context.Context, arg gensql.AppCommitsParams)) *MockQuerier_AppCommits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.AppCommitsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_AppCommits_Call) Return(_a0 []string, _a1 error) *MockQuerier_AppCommits_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_AppCommits_Call) RunAndReturn(run func(context.Context, gensql.AppCommitsParams) ([]string, error)) *MockQuerier_AppCommits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AppFailureEnd provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) AppFailureEnd(ctx context.Context, arg gensql.AppFailureEndParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.AppFailureEndParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_AppFailureEnd_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AppFailureEnd'
+type MockQuerier_AppFailureEnd_Call struct {
+	*mock.Call
+}
+
+// AppFailureEnd is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.AppFailureEndParams
+func (_e *MockQuerier_Expecter) AppFailureEnd(ctx interface{}, arg interface{}) *MockQuerier_AppFailureEnd_Call {
+	return &MockQuerier_AppFailureEnd_Call{Call: _e.mock.On("AppFailureEnd", ctx, arg)}
+}
+
+func (_c *MockQuerier_AppFailureEnd_Call) Run(run func(ctx context.Context, arg gensql.AppFailureEndParams)) *MockQuerier_AppFailureEnd_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.AppFailureEndParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_AppFailureEnd_Call) Return(_a0 error) *MockQuerier_AppFailureEnd_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_AppFailureEnd_Call) RunAndReturn(run func(context.Context, gensql.AppFailureEndParams) error) *MockQuerier_AppFailureEnd_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AppFailureStart provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) AppFailureStart(ctx context.Context, arg gensql.AppFailureStartParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.AppFailureStartParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_AppFailureStart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AppFailureStart'
+type MockQuerier_AppFailureStart_Call struct {
+	*mock.Call
+}
+
+// AppFailureStart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.AppFailureStartParams
+func (_e *MockQuerier_Expecter) AppFailureStart(ctx interface{}, arg interface{}) *MockQuerier_AppFailureStart_Call {
+	return &MockQuerier_AppFailureStart_Call{Call: _e.mock.On("AppFailureStart", ctx, arg)}
+}
+
+func (_c *MockQuerier_AppFailureStart_Call) Run(run func(ctx context.Context, arg gensql.AppFailureStartParams)) *MockQuerier_AppFailureStart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.AppFailureStartParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_AppFailureStart_Call) Return(_a0 error) *MockQuerier_AppFailureStart_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_AppFailureStart_Call) RunAndReturn(run func(context.Context, gensql.AppFailureStartParams) error) *MockQuerier_AppFailureStart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AppFailures provides a mock function with given fields: ctx, since
+func (_m *MockQuerier) AppFailures(ctx context.Context, since pgtype.Timestamptz) ([]*gensql.AppFailure, error) {
+	ret := _m.Called(ctx, since)
+
+	var r0 []*gensql.AppFailure
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) ([]*gensql.AppFailure, error)); ok {
+		return rf(ctx, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) []*gensql.AppFailure); ok {
+		r0 = rf(ctx, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gensql.AppFailure)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Timestamptz) error); ok {
+		r1 = rf(ctx, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_AppFailures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AppFailures'
+type MockQuerier_AppFailures_Call struct {
+	*mock.Call
+}
+
+// AppFailures is a helper method to define mock.On call
+//   - ctx context.Context
+//   - since pgtype.Timestamptz
+func (_e *MockQuerier_Expecter) AppFailures(ctx interface{}, since interface{}) *MockQuerier_AppFailures_Call {
+	return &MockQuerier_AppFailures_Call{Call: _e.mock.On("AppFailures", ctx, since)}
+}
+
+func (_c *MockQuerier_AppFailures_Call) Run(run func(ctx context.Context, since pgtype.Timestamptz)) *MockQuerier_AppFailures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.Timestamptz))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_AppFailures_Call) Return(_a0 []*gensql.AppFailure, _a1 error) *MockQuerier_AppFailures_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_AppFailures_Call) RunAndReturn(run func(context.Context, pgtype.Timestamptz) ([]*gensql.AppFailure, error)) *MockQuerier_AppFailures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AppRollbackInsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) AppRollbackInsert(ctx context.Context, arg gensql.AppRollbackInsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.AppRollbackInsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_AppRollbackInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AppRollbackInsert'
+type MockQuerier_AppRollbackInsert_Call struct {
+	*mock.Call
+}
+
+// AppRollbackInsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.AppRollbackInsertParams
+func (_e *MockQuerier_Expecter) AppRollbackInsert(ctx interface{}, arg interface{}) *MockQuerier_AppRollbackInsert_Call {
+	return &MockQuerier_AppRollbackInsert_Call{Call: _e.mock.On("AppRollbackInsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_AppRollbackInsert_Call) Run(run func(ctx context.Context, arg gensql.AppRollbackInsertParams)) *MockQuerier_AppRollbackInsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.AppRollbackInsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_AppRollbackInsert_Call) Return(_a0 error) *MockQuerier_AppRollbackInsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_AppRollbackInsert_Call) RunAndReturn(run func(context.Context, gensql.AppRollbackInsertParams) error) *MockQuerier_AppRollbackInsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AppRollbacks provides a mock function with given fields: ctx, since
+func (_m *MockQuerier) AppRollbacks(ctx context.Context, since pgtype.Timestamptz) ([]*gensql.AppRollback, error) {
+	ret := _m.Called(ctx, since)
+
+	var r0 []*gensql.AppRollback
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) ([]*gensql.AppRollback, error)); ok {
+		return rf(ctx, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) []*gensql.AppRollback); ok {
+		r0 = rf(ctx, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gensql.AppRollback)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Timestamptz) error); ok {
+		r1 = rf(ctx, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_AppRollbacks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AppRollbacks'
+type MockQuerier_AppRollbacks_Call struct {
+	*mock.Call
+}
+
+// AppRollbacks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - since pgtype.Timestamptz
+func (_e *MockQuerier_Expecter) AppRollbacks(ctx interface{}, since interface{}) *MockQuerier_AppRollbacks_Call {
+	return &MockQuerier_AppRollbacks_Call{Call: _e.mock.On("AppRollbacks", ctx, since)}
+}
+
+func (_c *MockQuerier_AppRollbacks_Call) Run(run func(ctx context.Context, since pgtype.Timestamptz)) *MockQuerier_AppRollbacks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.Timestamptz))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_AppRollbacks_Call) Return(_a0 []*gensql.AppRollback, _a1 error) *MockQuerier_AppRollbacks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_AppRollbacks_Call) RunAndReturn(run func(context.Context, pgtype.Timestamptz) ([]*gensql.AppRollback, error)) *MockQuerier_AppRollbacks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AverageResourceUtilizationForTeam provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) AverageResourceUtilizationForTeam(ctx context.Context, arg gensql.AverageResourceUtilizationForTeamParams) (*gensql.AverageResourceUtilizationForTeamRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *gensql.AverageResourceUtilizationForTeamRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.AverageResourceUtilizationForTeamParams) (*gensql.AverageResourceUtilizationForTeamRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.AverageResourceUtilizationForTeamParams) *gensql.AverageResourceUtilizationForTeamRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gensql.AverageResourceUtilizationForTeamRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.AverageResourceUtilizationForTeamParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_AverageResourceUtilizationForTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AverageResourceUtilizationForTeam'
+type MockQuerier_AverageResourceUtilizationForTeam_Call struct {
+	*mock.Call
+}
+
+// AverageResourceUtilizationForTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.AverageResourceUtilizationForTeamParams
+func (_e *MockQuerier_Expecter) AverageResourceUtilizationForTeam(ctx interface{}, arg interface{}) *MockQuerier_AverageResourceUtilizationForTeam_Call {
+	return &MockQuerier_AverageResourceUtilizationForTeam_Call{Call: _e.mock.On("AverageResourceUtilizationForTeam", ctx, arg)}
+}
+
+func (_c *MockQuerier_AverageResourceUtilizationForTeam_Call) Run(run func(ctx context.Context, arg gensql.AverageResourceUtilizationForTeamParams)) *MockQuerier_AverageResourceUtilizationForTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.AverageResourceUtilizationForTeamParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_AverageResourceUtilizationForTeam_Call) Return(_a0 *gensql.AverageResourceUtilizationForTeamRow, _a1 error) *MockQuerier_AverageResourceUtilizationForTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_AverageResourceUtilizationForTeam_Call) RunAndReturn(run func(context.Context, gensql.AverageResourceUtilizationForTeamParams) (*gensql.AverageResourceUtilizationForTeamRow, error)) *MockQuerier_AverageResourceUtilizationForTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ComponentUsage provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) ComponentUsage(ctx context.Context, arg gensql.ComponentUsageParams) ([]*gensql.VulnerabilityIndexComponent, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*gensql.VulnerabilityIndexComponent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.ComponentUsageParams) ([]*gensql.VulnerabilityIndexComponent, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.ComponentUsageParams) []*gensql.VulnerabilityIndexComponent); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gensql.VulnerabilityIndexComponent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.ComponentUsageParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_ComponentUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ComponentUsage'
+type MockQuerier_ComponentUsage_Call struct {
+	*mock.Call
+}
+
+// ComponentUsage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.ComponentUsageParams
+func (_e *MockQuerier_Expecter) ComponentUsage(ctx interface{}, arg interface{}) *MockQuerier_ComponentUsage_Call {
+	return &MockQuerier_ComponentUsage_Call{Call: _e.mock.On("ComponentUsage", ctx, arg)}
+}
+
+func (_c *MockQuerier_ComponentUsage_Call) Run(run func(ctx context.Context, arg gensql.ComponentUsageParams)) *MockQuerier_ComponentUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.ComponentUsageParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ComponentUsage_Call) Return(_a0 []*gensql.VulnerabilityIndexComponent, _a1 error) *MockQuerier_ComponentUsage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_ComponentUsage_Call) RunAndReturn(run func(context.Context, gensql.ComponentUsageParams) ([]*gensql.VulnerabilityIndexComponent, error)) *MockQuerier_ComponentUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CostAnomaliesForTeam provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CostAnomaliesForTeam(ctx context.Context, arg gensql.CostAnomaliesForTeamParams) ([]*gensql.CostAnomaly, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*gensql.CostAnomaly
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.CostAnomaliesForTeamParams) ([]*gensql.CostAnomaly, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.CostAnomaliesForTeamParams) []*gensql.CostAnomaly); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gensql.CostAnomaly)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.CostAnomaliesForTeamParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CostAnomaliesForTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CostAnomaliesForTeam'
+type MockQuerier_CostAnomaliesForTeam_Call struct {
+	*mock.Call
+}
+
+// CostAnomaliesForTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.CostAnomaliesForTeamParams
+func (_e *MockQuerier_Expecter) CostAnomaliesForTeam(ctx interface{}, arg interface{}) *MockQuerier_CostAnomaliesForTeam_Call {
+	return &MockQuerier_CostAnomaliesForTeam_Call{Call: _e.mock.On("CostAnomaliesForTeam", ctx, arg)}
+}
+
+func (_c *MockQuerier_CostAnomaliesForTeam_Call) Run(run func(ctx context.Context, arg gensql.CostAnomaliesForTeamParams)) *MockQuerier_CostAnomaliesForTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.CostAnomaliesForTeamParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CostAnomaliesForTeam_Call) Return(_a0 []*gensql.CostAnomaly, _a1 error) *MockQuerier_CostAnomaliesForTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CostAnomaliesForTeam_Call) RunAndReturn(run func(context.Context, gensql.CostAnomaliesForTeamParams) ([]*gensql.CostAnomaly, error)) *MockQuerier_CostAnomaliesForTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CostAnomalyUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CostAnomalyUpsert(ctx context.Context, arg gensql.CostAnomalyUpsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.CostAnomalyUpsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_CostAnomalyUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CostAnomalyUpsert'
+type MockQuerier_CostAnomalyUpsert_Call struct {
+	*mock.Call
+}
+
+// CostAnomalyUpsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.CostAnomalyUpsertParams
+func (_e *MockQuerier_Expecter) CostAnomalyUpsert(ctx interface{}, arg interface{}) *MockQuerier_CostAnomalyUpsert_Call {
+	return &MockQuerier_CostAnomalyUpsert_Call{Call: _e.mock.On("CostAnomalyUpsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_CostAnomalyUpsert_Call) Run(run func(ctx context.Context, arg gensql.CostAnomalyUpsertParams)) *MockQuerier_CostAnomalyUpsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.CostAnomalyUpsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CostAnomalyUpsert_Call) Return(_a0 error) *MockQuerier_CostAnomalyUpsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_CostAnomalyUpsert_Call) RunAndReturn(run func(context.Context, gensql.CostAnomalyUpsertParams) error) *MockQuerier_CostAnomalyUpsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CostBreakdownForTeam provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CostBreakdownForTeam(ctx context.Context, arg gensql.CostBreakdownForTeamParams) ([]*gensql.CostBreakdownForTeamRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*gensql.CostBreakdownForTeamRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.CostBreakdownForTeamParams) ([]*gensql.CostBreakdownForTeamRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.CostBreakdownForTeamParams) []*gensql.CostBreakdownForTeamRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gensql.CostBreakdownForTeamRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.CostBreakdownForTeamParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CostBreakdownForTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CostBreakdownForTeam'
+type MockQuerier_CostBreakdownForTeam_Call struct {
+	*mock.Call
+}
+
+// CostBreakdownForTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.CostBreakdownForTeamParams
+func (_e *MockQuerier_Expecter) CostBreakdownForTeam(ctx interface{}, arg interface{}) *MockQuerier_CostBreakdownForTeam_Call {
+	return &MockQuerier_CostBreakdownForTeam_Call{Call: _e.mock.On("CostBreakdownForTeam", ctx, arg)}
+}
+
+func (_c *MockQuerier_CostBreakdownForTeam_Call) Run(run func(ctx context.Context, arg gensql.CostBreakdownForTeamParams)) *MockQuerier_CostBreakdownForTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.CostBreakdownForTeamParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CostBreakdownForTeam_Call) Return(_a0 []*gensql.CostBreakdownForTeamRow, _a1 error) *MockQuerier_CostBreakdownForTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CostBreakdownForTeam_Call) RunAndReturn(run func(context.Context, gensql.CostBreakdownForTeamParams) ([]*gensql.CostBreakdownForTeamRow, error)) *MockQuerier_CostBreakdownForTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CostBudget provides a mock function with given fields: ctx, team
+func (_m *MockQuerier) CostBudget(ctx context.Context, team string) (*gensql.CostBudget, error) {
+	ret := _m.Called(ctx, team)
+
+	var r0 *gensql.CostBudget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*gensql.CostBudget, error)); ok {
+		return rf(ctx, team)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *gensql.CostBudget); ok {
+		r0 = rf(ctx, team)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gensql.CostBudget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, team)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CostBudget_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CostBudget'
+type MockQuerier_CostBudget_Call struct {
+	*mock.Call
+}
+
+// CostBudget is a helper method to define mock.On call
+//   - ctx context.Context
+//   - team string
+func (_e *MockQuerier_Expecter) CostBudget(ctx interface{}, team interface{}) *MockQuerier_CostBudget_Call {
+	return &MockQuerier_CostBudget_Call{Call: _e.mock.On("CostBudget", ctx, team)}
+}
+
+func (_c *MockQuerier_CostBudget_Call) Run(run func(ctx context.Context, team string)) *MockQuerier_CostBudget_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CostBudget_Call) Return(_a0 *gensql.CostBudget, _a1 error) *MockQuerier_CostBudget_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CostBudget_Call) RunAndReturn(run func(context.Context, string) (*gensql.CostBudget, error)) *MockQuerier_CostBudget_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CostBudgetDelete provides a mock function with given fields: ctx, team
+func (_m *MockQuerier) CostBudgetDelete(ctx context.Context, team string) error {
+	ret := _m.Called(ctx, team)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, team)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_CostBudgetDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CostBudgetDelete'
+type MockQuerier_CostBudgetDelete_Call struct {
+	*mock.Call
+}
+
+// CostBudgetDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - team string
+func (_e *MockQuerier_Expecter) CostBudgetDelete(ctx interface{}, team interface{}) *MockQuerier_CostBudgetDelete_Call {
+	return &MockQuerier_CostBudgetDelete_Call{Call: _e.mock.On("CostBudgetDelete", ctx, team)}
+}
+
+func (_c *MockQuerier_CostBudgetDelete_Call) Run(run func(ctx context.Context, team string)) *MockQuerier_CostBudgetDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CostBudgetDelete_Call) Return(_a0 error) *MockQuerier_CostBudgetDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_CostBudgetDelete_Call) RunAndReturn(run func(context.Context, string) error) *MockQuerier_CostBudgetDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CostBudgetNotified provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CostBudgetNotified(ctx context.Context, arg gensql.CostBudgetNotifiedParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.CostBudgetNotifiedParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_CostBudgetNotified_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CostBudgetNotified'
+type MockQuerier_CostBudgetNotified_Call struct {
+	*mock.Call
+}
+
+// CostBudgetNotified is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.CostBudgetNotifiedParams
+func (_e *MockQuerier_Expecter) CostBudgetNotified(ctx interface{}, arg interface{}) *MockQuerier_CostBudgetNotified_Call {
+	return &MockQuerier_CostBudgetNotified_Call{Call: _e.mock.On("CostBudgetNotified", ctx, arg)}
+}
+
+func (_c *MockQuerier_CostBudgetNotified_Call) Run(run func(ctx context.Context, arg gensql.CostBudgetNotifiedParams)) *MockQuerier_CostBudgetNotified_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.CostBudgetNotifiedParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CostBudgetNotified_Call) Return(_a0 error) *MockQuerier_CostBudgetNotified_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_CostBudgetNotified_Call) RunAndReturn(run func(context.Context, gensql.CostBudgetNotifiedParams) error) *MockQuerier_CostBudgetNotified_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CostBudgetUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CostBudgetUpsert(ctx context.Context, arg gensql.CostBudgetUpsertParams) (*gensql.CostBudget, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *gensql.CostBudget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.CostBudgetUpsertParams) (*gensql.CostBudget, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.CostBudgetUpsertParams) *gensql.CostBudget); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gensql.CostBudget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.CostBudgetUpsertParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CostBudgetUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CostBudgetUpsert'
+type MockQuerier_CostBudgetUpsert_Call struct {
+	*mock.Call
+}
+
+// CostBudgetUpsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.CostBudgetUpsertParams
+func (_e *MockQuerier_Expecter) CostBudgetUpsert(ctx interface{}, arg interface{}) *MockQuerier_CostBudgetUpsert_Call {
+	return &MockQuerier_CostBudgetUpsert_Call{Call: _e.mock.On("CostBudgetUpsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_CostBudgetUpsert_Call) Run(run func(ctx context.Context, arg gensql.CostBudgetUpsertParams)) *MockQuerier_CostBudgetUpsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.CostBudgetUpsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CostBudgetUpsert_Call) Return(_a0 *gensql.CostBudget, _a1 error) *MockQuerier_CostBudgetUpsert_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CostBudgetUpsert_Call) RunAndReturn(run func(context.Context, gensql.CostBudgetUpsertParams) (*gensql.CostBudget, error)) *MockQuerier_CostBudgetUpsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CostBudgets provides a mock function with given fields: ctx
+func (_m *MockQuerier) CostBudgets(ctx context.Context) ([]*gensql.CostBudget, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*gensql.CostBudget
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*gensql.CostBudget, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*gensql.CostBudget); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gensql.CostBudget)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CostBudgets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CostBudgets'
+type MockQuerier_CostBudgets_Call struct {
+	*mock.Call
+}
+
+// CostBudgets is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockQuerier_Expecter) CostBudgets(ctx interface{}) *MockQuerier_CostBudgets_Call {
+	return &MockQuerier_CostBudgets_Call{Call: _e.mock.On("CostBudgets", ctx)}
+}
+
+func (_c *MockQuerier_CostBudgets_Call) Run(run func(ctx context.Context)) *MockQuerier_CostBudgets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CostBudgets_Call) Return(_a0 []*gensql.CostBudget, _a1 error) *MockQuerier_CostBudgets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CostBudgets_Call) RunAndReturn(run func(context.Context) ([]*gensql.CostBudget, error)) *MockQuerier_CostBudgets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CostExport provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CostExport(ctx context.Context, arg gensql.CostExportParams) ([]*gensql.CostExportRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*gensql.CostExportRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.CostExportParams) ([]*gensql.CostExportRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.CostExportParams) []*gensql.CostExportRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gensql.CostExportRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.CostExportParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CostExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CostExport'
+type MockQuerier_CostExport_Call struct {
+	*mock.Call
+}
+
+// CostExport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.CostExportParams
+func (_e *MockQuerier_Expecter) CostExport(ctx interface{}, arg interface{}) *MockQuerier_CostExport_Call {
+	return &MockQuerier_CostExport_Call{Call: _e.mock.On("CostExport", ctx, arg)}
+}
+
+func (_c *MockQuerier_CostExport_Call) Run(run func(ctx context.Context, arg gensql.CostExportParams)) *MockQuerier_CostExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.CostExportParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CostExport_Call) Return(_a0 []*gensql.CostExportRow, _a1 error) *MockQuerier_CostExport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CostExport_Call) RunAndReturn(run func(context.Context, gensql.CostExportParams) ([]*gensql.CostExportRow, error)) *MockQuerier_CostExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CostForTeams provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CostForTeams(ctx context.Context, arg gensql.CostForTeamsParams) ([]*gensql.CostForTeamsRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*gensql.CostForTeamsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.CostForTeamsParams) ([]*gensql.CostForTeamsRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.CostForTeamsParams) []*gensql.CostForTeamsRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gensql.CostForTeamsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.CostForTeamsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CostForTeams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CostForTeams'
+type MockQuerier_CostForTeams_Call struct {
+	*mock.Call
+}
+
+// CostForTeams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.CostForTeamsParams
+func (_e *MockQuerier_Expecter) CostForTeams(ctx interface{}, arg interface{}) *MockQuerier_CostForTeams_Call {
+	return &MockQuerier_CostForTeams_Call{Call: _e.mock.On("CostForTeams", ctx, arg)}
+}
+
+func (_c *MockQuerier_CostForTeams_Call) Run(run func(ctx context.Context, arg gensql.CostForTeamsParams)) *MockQuerier_CostForTeams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.CostForTeamsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CostForTeams_Call) Return(_a0 []*gensql.CostForTeamsRow, _a1 error) *MockQuerier_CostForTeams_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CostForTeams_Call) RunAndReturn(run func(context.Context, gensql.CostForTeamsParams) ([]*gensql.CostForTeamsRow, error)) *MockQuerier_CostForTeams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CostSeries provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CostSeries(ctx context.Context, arg gensql.CostSeriesParams) ([]*gensql.CostSeriesRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*gensql.CostSeriesRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.CostSeriesParams) ([]*gensql.CostSeriesRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.CostSeriesParams) []*gensql.CostSeriesRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gensql.CostSeriesRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.CostSeriesParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CostSeries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CostSeries'
+type MockQuerier_CostSeries_Call struct {
+	*mock.Call
+}
+
+// CostSeries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.CostSeriesParams
+func (_e *MockQuerier_Expecter) CostSeries(ctx interface{}, arg interface{}) *MockQuerier_CostSeries_Call {
+	return &MockQuerier_CostSeries_Call{Call: _e.mock.On("CostSeries", ctx, arg)}
+}
+
+func (_c *MockQuerier_CostSeries_Call) Run(run func(ctx context.Context, arg gensql.CostSeriesParams)) *MockQuerier_CostSeries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.CostSeriesParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CostSeries_Call) Return(_a0 []*gensql.CostSeriesRow, _a1 error) *MockQuerier_CostSeries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CostSeries_Call) RunAndReturn(run func(context.Context, gensql.CostSeriesParams) ([]*gensql.CostSeriesRow, error)) *MockQuerier_CostSeries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CostUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CostUpsert(ctx context.Context, arg []gensql.CostUpsertParams) *gensql.CostUpsertBatchResults {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *gensql.CostUpsertBatchResults
+	if rf, ok := ret.Get(0).(func(context.Context, []gensql.CostUpsertParams) *gensql.CostUpsertBatchResults); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gensql.CostUpsertBatchResults)
+		}
+	}
+
+	return r0
+}
+
+// MockQuerier_CostUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CostUpsert'
+type MockQuerier_CostUpsert_Call struct {
+	*mock.Call
+}
+
+// CostUpsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []gensql.CostUpsertParams
+func (_e *MockQuerier_Expecter) CostUpsert(ctx interface{}, arg interface{}) *MockQuerier_CostUpsert_Call {
+	return &MockQuerier_CostUpsert_Call{Call: _e.mock.On("CostUpsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_CostUpsert_Call) Run(run func(ctx context.Context, arg []gensql.CostUpsertParams)) *MockQuerier_CostUpsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]gensql.CostUpsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CostUpsert_Call) Return(_a0 *gensql.CostUpsertBatchResults) *MockQuerier_CostUpsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_CostUpsert_Call) RunAndReturn(run func(context.Context, []gensql.CostUpsertParams) *gensql.CostUpsertBatchResults) *MockQuerier_CostUpsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CriticalFindingCreate provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CriticalFindingCreate(ctx context.Context, arg gensql.CriticalFindingCreateParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.CriticalFindingCreateParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_CriticalFindingCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CriticalFindingCreate'
+type MockQuerier_CriticalFindingCreate_Call struct {
+	*mock.Call
+}
+
+// CriticalFindingCreate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.CriticalFindingCreateParams
+func (_e *MockQuerier_Expecter) CriticalFindingCreate(ctx interface{}, arg interface{}) *MockQuerier_CriticalFindingCreate_Call {
+	return &MockQuerier_CriticalFindingCreate_Call{Call: _e.mock.On("CriticalFindingCreate", ctx, arg)}
+}
+
+func (_c *MockQuerier_CriticalFindingCreate_Call) Run(run func(ctx context.Context, arg gensql.CriticalFindingCreateParams)) *MockQuerier_CriticalFindingCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.CriticalFindingCreateParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CriticalFindingCreate_Call) Return(_a0 error) *MockQuerier_CriticalFindingCreate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_CriticalFindingCreate_Call) RunAndReturn(run func(context.Context, gensql.CriticalFindingCreateParams) error) *MockQuerier_CriticalFindingCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CriticalFindingResolve provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CriticalFindingResolve(ctx context.Context, arg gensql.CriticalFindingResolveParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.CriticalFindingResolveParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_CriticalFindingResolve_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CriticalFindingResolve'
+type MockQuerier_CriticalFindingResolve_Call struct {
+	*mock.Call
+}
+
+// CriticalFindingResolve is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.CriticalFindingResolveParams
+func (_e *MockQuerier_Expecter) CriticalFindingResolve(ctx interface{}, arg interface{}) *MockQuerier_CriticalFindingResolve_Call {
+	return &MockQuerier_CriticalFindingResolve_Call{Call: _e.mock.On("CriticalFindingResolve", ctx, arg)}
+}
+
+func (_c *MockQuerier_CriticalFindingResolve_Call) Run(run func(ctx context.Context, arg gensql.CriticalFindingResolveParams)) *MockQuerier_CriticalFindingResolve_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.CriticalFindingResolveParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CriticalFindingResolve_Call) Return(_a0 error) *MockQuerier_CriticalFindingResolve_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_CriticalFindingResolve_Call) RunAndReturn(run func(context.Context, gensql.CriticalFindingResolveParams) error) *MockQuerier_CriticalFindingResolve_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CriticalFindingsRemediation provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CriticalFindingsRemediation(ctx context.Context, arg gensql.CriticalFindingsRemediationParams) (*gensql.CriticalFindingsRemediationRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *gensql.CriticalFindingsRemediationRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.CriticalFindingsRemediationParams) (*gensql.CriticalFindingsRemediationRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.CriticalFindingsRemediationParams) *gensql.CriticalFindingsRemediationRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gensql.CriticalFindingsRemediationRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.CriticalFindingsRemediationParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_CriticalFindingsRemediation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CriticalFindingsRemediation'
+type MockQuerier_CriticalFindingsRemediation_Call struct {
+	*mock.Call
+}
+
+// CriticalFindingsRemediation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.CriticalFindingsRemediationParams
+func (_e *MockQuerier_Expecter) CriticalFindingsRemediation(ctx interface{}, arg interface{}) *MockQuerier_CriticalFindingsRemediation_Call {
+	return &MockQuerier_CriticalFindingsRemediation_Call{Call: _e.mock.On("CriticalFindingsRemediation", ctx, arg)}
+}
+
+func (_c *MockQuerier_CriticalFindingsRemediation_Call) Run(run func(ctx context.Context, arg gensql.CriticalFindingsRemediationParams)) *MockQuerier_CriticalFindingsRemediation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.CriticalFindingsRemediationParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CriticalFindingsRemediation_Call) Return(_a0 *gensql.CriticalFindingsRemediationRow, _a1 error) *MockQuerier_CriticalFindingsRemediation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_CriticalFindingsRemediation_Call) RunAndReturn(run func(context.Context, gensql.CriticalFindingsRemediationParams) (*gensql.CriticalFindingsRemediationRow, error)) *MockQuerier_CriticalFindingsRemediation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CriticalFindingsResolveForRemovedApps provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) CriticalFindingsResolveForRemovedApps(ctx context.Context, arg gensql.CriticalFindingsResolveForRemovedAppsParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.CriticalFindingsResolveForRemovedAppsParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_CriticalFindingsResolveForRemovedApps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CriticalFindingsResolveForRemovedApps'
+type MockQuerier_CriticalFindingsResolveForRemovedApps_Call struct {
+	*mock.Call
+}
+
+// CriticalFindingsResolveForRemovedApps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.CriticalFindingsResolveForRemovedAppsParams
+func (_e *MockQuerier_Expecter) CriticalFindingsResolveForRemovedApps(ctx interface{}, arg interface{}) *MockQuerier_CriticalFindingsResolveForRemovedApps_Call {
+	return &MockQuerier_CriticalFindingsResolveForRemovedApps_Call{Call: _e.mock.On("CriticalFindingsResolveForRemovedApps", ctx, arg)}
+}
+
+func (_c *MockQuerier_CriticalFindingsResolveForRemovedApps_Call) Run(run func(ctx context.Context, arg gensql.CriticalFindingsResolveForRemovedAppsParams)) *MockQuerier_CriticalFindingsResolveForRemovedApps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.CriticalFindingsResolveForRemovedAppsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_CriticalFindingsResolveForRemovedApps_Call) Return(_a0 error) *MockQuerier_CriticalFindingsResolveForRemovedApps_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_CriticalFindingsResolveForRemovedApps_Call) RunAndReturn(run func(context.Context, gensql.CriticalFindingsResolveForRemovedAppsParams) error) *MockQuerier_CriticalFindingsResolveForRemovedApps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DailyCostForApp provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) DailyCostForApp(ctx context.Context, arg gensql.DailyCostForAppParams) ([]*gensql.Cost, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*gensql.Cost
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.DailyCostForAppParams) ([]*gensql.Cost, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.DailyCostForAppParams) []*gensql.Cost); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gensql.Cost)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.DailyCostForAppParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_DailyCostForApp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DailyCostForApp'
+type MockQuerier_DailyCostForApp_Call struct {
+	*mock.Call
+}
+
+// DailyCostForApp is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.DailyCostForAppParams
+func (_e *MockQuerier_Expecter) DailyCostForApp(ctx interface{}, arg interface{}) *MockQuerier_DailyCostForApp_Call {
+	return &MockQuerier_DailyCostForApp_Call{Call: _e.mock.On("DailyCostForApp", ctx, arg)}
+}
+
+func (_c *MockQuerier_DailyCostForApp_Call) Run(run func(ctx context.Context, arg gensql.DailyCostForAppParams)) *MockQuerier_DailyCostForApp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.DailyCostForAppParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DailyCostForApp_Call) Return(_a0 []*gensql.Cost, _a1 error) *MockQuerier_DailyCostForApp_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_DailyCostForApp_Call) RunAndReturn(run func(context.Context, gensql.DailyCostForAppParams) ([]*gensql.Cost, error)) *MockQuerier_DailyCostForApp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DailyCostForTeam provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) DailyCostForTeam(ctx context.Context, arg gensql.DailyCostForTeamParams) ([]*gensql.Cost, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*gensql.Cost
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.DailyCostForTeamParams) ([]*gensql.Cost, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.DailyCostForTeamParams) []*gensql.Cost); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gensql.Cost)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.DailyCostForTeamParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_DailyCostForTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DailyCostForTeam'
+type MockQuerier_DailyCostForTeam_Call struct {
+	*mock.Call
+}
+
+// DailyCostForTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.DailyCostForTeamParams
+func (_e *MockQuerier_Expecter) DailyCostForTeam(ctx interface{}, arg interface{}) *MockQuerier_DailyCostForTeam_Call {
+	return &MockQuerier_DailyCostForTeam_Call{Call: _e.mock.On("DailyCostForTeam", ctx, arg)}
+}
+
+func (_c *MockQuerier_DailyCostForTeam_Call) Run(run func(ctx context.Context, arg gensql.DailyCostForTeamParams)) *MockQuerier_DailyCostForTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.DailyCostForTeamParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DailyCostForTeam_Call) Return(_a0 []*gensql.Cost, _a1 error) *MockQuerier_DailyCostForTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_DailyCostForTeam_Call) RunAndReturn(run func(context.Context, gensql.DailyCostForTeamParams) ([]*gensql.Cost, error)) *MockQuerier_DailyCostForTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DailyEnvCostForTeam provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) DailyEnvCostForTeam(ctx context.Context, arg gensql.DailyEnvCostForTeamParams) ([]*gensql.DailyEnvCostForTeamRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*gensql.DailyEnvCostForTeamRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.DailyEnvCostForTeamParams) ([]*gensql.DailyEnvCostForTeamRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.DailyEnvCostForTeamParams) []*gensql.DailyEnvCostForTeamRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gensql.DailyEnvCostForTeamRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.DailyEnvCostForTeamParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_DailyEnvCostForTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DailyEnvCostForTeam'
+type MockQuerier_DailyEnvCostForTeam_Call struct {
+	*mock.Call
+}
+
+// DailyEnvCostForTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.DailyEnvCostForTeamParams
+func (_e *MockQuerier_Expecter) DailyEnvCostForTeam(ctx interface{}, arg interface{}) *MockQuerier_DailyEnvCostForTeam_Call {
+	return &MockQuerier_DailyEnvCostForTeam_Call{Call: _e.mock.On("DailyEnvCostForTeam", ctx, arg)}
+}
+
+func (_c *MockQuerier_DailyEnvCostForTeam_Call) Run(run func(ctx context.Context, arg gensql.DailyEnvCostForTeamParams)) *MockQuerier_DailyEnvCostForTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.DailyEnvCostForTeamParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DailyEnvCostForTeam_Call) Return(_a0 []*gensql.DailyEnvCostForTeamRow, _a1 error) *MockQuerier_DailyEnvCostForTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_DailyEnvCostForTeam_Call) RunAndReturn(run func(context.Context, gensql.DailyEnvCostForTeamParams) ([]*gensql.DailyEnvCostForTeamRow, error)) *MockQuerier_DailyEnvCostForTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeliveryMetricsForTeam provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) DeliveryMetricsForTeam(ctx context.Context, arg gensql.DeliveryMetricsForTeamParams) ([]*gensql.DeliveryMetric, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*gensql.DeliveryMetric
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.DeliveryMetricsForTeamParams) ([]*gensql.DeliveryMetric, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.DeliveryMetricsForTeamParams) []*gensql.DeliveryMetric); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gensql.DeliveryMetric)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.DeliveryMetricsForTeamParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_DeliveryMetricsForTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeliveryMetricsForTeam'
+type MockQuerier_DeliveryMetricsForTeam_Call struct {
+	*mock.Call
+}
+
+// DeliveryMetricsForTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.DeliveryMetricsForTeamParams
+func (_e *MockQuerier_Expecter) DeliveryMetricsForTeam(ctx interface{}, arg interface{}) *MockQuerier_DeliveryMetricsForTeam_Call {
+	return &MockQuerier_DeliveryMetricsForTeam_Call{Call: _e.mock.On("DeliveryMetricsForTeam", ctx, arg)}
+}
+
+func (_c *MockQuerier_DeliveryMetricsForTeam_Call) Run(run func(ctx context.Context, arg gensql.DeliveryMetricsForTeamParams)) *MockQuerier_DeliveryMetricsForTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.DeliveryMetricsForTeamParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeliveryMetricsForTeam_Call) Return(_a0 []*gensql.DeliveryMetric, _a1 error) *MockQuerier_DeliveryMetricsForTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_DeliveryMetricsForTeam_Call) RunAndReturn(run func(context.Context, gensql.DeliveryMetricsForTeamParams) ([]*gensql.DeliveryMetric, error)) *MockQuerier_DeliveryMetricsForTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeliveryMetricsUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) DeliveryMetricsUpsert(ctx context.Context, arg []gensql.DeliveryMetricsUpsertParams) *gensql.DeliveryMetricsUpsertBatchResults {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *gensql.DeliveryMetricsUpsertBatchResults
+	if rf, ok := ret.Get(0).(func(context.Context, []gensql.DeliveryMetricsUpsertParams) *gensql.DeliveryMetricsUpsertBatchResults); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gensql.DeliveryMetricsUpsertBatchResults)
+		}
+	}
+
+	return r0
+}
+
+// MockQuerier_DeliveryMetricsUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeliveryMetricsUpsert'
+type MockQuerier_DeliveryMetricsUpsert_Call struct {
+	*mock.Call
+}
+
+// DeliveryMetricsUpsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []gensql.DeliveryMetricsUpsertParams
+func (_e *MockQuerier_Expecter) DeliveryMetricsUpsert(ctx interface{}, arg interface{}) *MockQuerier_DeliveryMetricsUpsert_Call {
+	return &MockQuerier_DeliveryMetricsUpsert_Call{Call: _e.mock.On("DeliveryMetricsUpsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_DeliveryMetricsUpsert_Call) Run(run func(ctx context.Context, arg []gensql.DeliveryMetricsUpsertParams)) *MockQuerier_DeliveryMetricsUpsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]gensql.DeliveryMetricsUpsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeliveryMetricsUpsert_Call) Return(_a0 *gensql.DeliveryMetricsUpsertBatchResults) *MockQuerier_DeliveryMetricsUpsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_DeliveryMetricsUpsert_Call) RunAndReturn(run func(context.Context, []gensql.DeliveryMetricsUpsertParams) *gensql.DeliveryMetricsUpsertBatchResults) *MockQuerier_DeliveryMetricsUpsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeployKeyChannelNotified provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) DeployKeyChannelNotified(ctx context.Context, arg gensql.DeployKeyChannelNotifiedParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.DeployKeyChannelNotifiedParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_DeployKeyChannelNotified_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeployKeyChannelNotified'
+type MockQuerier_DeployKeyChannelNotified_Call struct {
+	*mock.Call
+}
+
+// DeployKeyChannelNotified is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.DeployKeyChannelNotifiedParams
+func (_e *MockQuerier_Expecter) DeployKeyChannelNotified(ctx interface{}, arg interface{}) *MockQuerier_DeployKeyChannelNotified_Call {
+	return &MockQuerier_DeployKeyChannelNotified_Call{Call: _e.mock.On("DeployKeyChannelNotified", ctx, arg)}
+}
+
+func (_c *MockQuerier_DeployKeyChannelNotified_Call) Run(run func(ctx context.Context, arg gensql.DeployKeyChannelNotifiedParams)) *MockQuerier_DeployKeyChannelNotified_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.DeployKeyChannelNotifiedParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeployKeyChannelNotified_Call) Return(_a0 error) *MockQuerier_DeployKeyChannelNotified_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_DeployKeyChannelNotified_Call) RunAndReturn(run func(context.Context, gensql.DeployKeyChannelNotifiedParams) error) *MockQuerier_DeployKeyChannelNotified_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeployKeyNotified provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) DeployKeyNotified(ctx context.Context, arg gensql.DeployKeyNotifiedParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.DeployKeyNotifiedParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_DeployKeyNotified_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeployKeyNotified'
+type MockQuerier_DeployKeyNotified_Call struct {
+	*mock.Call
+}
+
+// DeployKeyNotified is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.DeployKeyNotifiedParams
+func (_e *MockQuerier_Expecter) DeployKeyNotified(ctx interface{}, arg interface{}) *MockQuerier_DeployKeyNotified_Call {
+	return &MockQuerier_DeployKeyNotified_Call{Call: _e.mock.On("DeployKeyNotified", ctx, arg)}
+}
+
+func (_c *MockQuerier_DeployKeyNotified_Call) Run(run func(ctx context.Context, arg gensql.DeployKeyNotifiedParams)) *MockQuerier_DeployKeyNotified_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.DeployKeyNotifiedParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeployKeyNotified_Call) Return(_a0 error) *MockQuerier_DeployKeyNotified_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_DeployKeyNotified_Call) RunAndReturn(run func(context.Context, gensql.DeployKeyNotifiedParams) error) *MockQuerier_DeployKeyNotified_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeployKeyUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) DeployKeyUpsert(ctx context.Context, arg gensql.DeployKeyUpsertParams) (*gensql.DeployKey, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *gensql.DeployKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.DeployKeyUpsertParams) (*gensql.DeployKey, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.DeployKeyUpsertParams) *gensql.DeployKey); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gensql.DeployKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.DeployKeyUpsertParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_DeployKeyUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeployKeyUpsert'
+type MockQuerier_DeployKeyUpsert_Call struct {
+	*mock.Call
+}
+
+// DeployKeyUpsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.DeployKeyUpsertParams
+func (_e *MockQuerier_Expecter) DeployKeyUpsert(ctx interface{}, arg interface{}) *MockQuerier_DeployKeyUpsert_Call {
+	return &MockQuerier_DeployKeyUpsert_Call{Call: _e.mock.On("DeployKeyUpsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_DeployKeyUpsert_Call) Run(run func(ctx context.Context, arg gensql.DeployKeyUpsertParams)) *MockQuerier_DeployKeyUpsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.DeployKeyUpsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeployKeyUpsert_Call) Return(_a0 *gensql.DeployKey, _a1 error) *MockQuerier_DeployKeyUpsert_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_DeployKeyUpsert_Call) RunAndReturn(run func(context.Context, gensql.DeployKeyUpsertParams) (*gensql.DeployKey, error)) *MockQuerier_DeployKeyUpsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeploymentResourceUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) DeploymentResourceUpsert(ctx context.Context, arg []gensql.DeploymentResourceUpsertParams) *gensql.DeploymentResourceUpsertBatchResults {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *gensql.DeploymentResourceUpsertBatchResults
+	if rf, ok := ret.Get(0).(func(context.Context, []gensql.DeploymentResourceUpsertParams) *gensql.DeploymentResourceUpsertBatchResults); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gensql.DeploymentResourceUpsertBatchResults)
+		}
+	}
+
+	return r0
+}
+
+// MockQuerier_DeploymentResourceUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeploymentResourceUpsert'
+type MockQuerier_DeploymentResourceUpsert_Call struct {
+	*mock.Call
+}
+
+// DeploymentResourceUpsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []gensql.DeploymentResourceUpsertParams
+func (_e *MockQuerier_Expecter) DeploymentResourceUpsert(ctx interface{}, arg interface{}) *MockQuerier_DeploymentResourceUpsert_Call {
+	return &MockQuerier_DeploymentResourceUpsert_Call{Call: _e.mock.On("DeploymentResourceUpsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_DeploymentResourceUpsert_Call) Run(run func(ctx context.Context, arg []gensql.DeploymentResourceUpsertParams)) *MockQuerier_DeploymentResourceUpsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]gensql.DeploymentResourceUpsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeploymentResourceUpsert_Call) Return(_a0 *gensql.DeploymentResourceUpsertBatchResults) *MockQuerier_DeploymentResourceUpsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_DeploymentResourceUpsert_Call) RunAndReturn(run func(context.Context, []gensql.DeploymentResourceUpsertParams) *gensql.DeploymentResourceUpsertBatchResults) *MockQuerier_DeploymentResourceUpsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeploymentResourcesForDeployments provides a mock function with given fields: ctx, deploymentIds
+func (_m *MockQuerier) DeploymentResourcesForDeployments(ctx context.Context, deploymentIds []string) ([]*gensql.DeploymentResource, error) {
+	ret := _m.Called(ctx, deploymentIds)
+
+	var r0 []*gensql.DeploymentResource
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*gensql.DeploymentResource, error)); ok {
+		return rf(ctx, deploymentIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*gensql.DeploymentResource); ok {
+		r0 = rf(ctx, deploymentIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gensql.DeploymentResource)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, deploymentIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_DeploymentResourcesForDeployments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeploymentResourcesForDeployments'
+type MockQuerier_DeploymentResourcesForDeployments_Call struct {
+	*mock.Call
+}
+
+// DeploymentResourcesForDeployments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deploymentIds []string
+func (_e *MockQuerier_Expecter) DeploymentResourcesForDeployments(ctx interface{}, deploymentIds interface{}) *MockQuerier_DeploymentResourcesForDeployments_Call {
+	return &MockQuerier_DeploymentResourcesForDeployments_Call{Call: _e.mock.On("DeploymentResourcesForDeployments", ctx, deploymentIds)}
+}
+
+func (_c *MockQuerier_DeploymentResourcesForDeployments_Call) Run(run func(ctx context.Context, deploymentIds []string)) *MockQuerier_DeploymentResourcesForDeployments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeploymentResourcesForDeployments_Call) Return(_a0 []*gensql.DeploymentResource, _a1 error) *MockQuerier_DeploymentResourcesForDeployments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_DeploymentResourcesForDeployments_Call) RunAndReturn(run func(context.Context, []string) ([]*gensql.DeploymentResource, error)) *MockQuerier_DeploymentResourcesForDeployments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeploymentStatusUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) DeploymentStatusUpsert(ctx context.Context, arg []gensql.DeploymentStatusUpsertParams) *gensql.DeploymentStatusUpsertBatchResults {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *gensql.DeploymentStatusUpsertBatchResults
+	if rf, ok := ret.Get(0).(func(context.Context, []gensql.DeploymentStatusUpsertParams) *gensql.DeploymentStatusUpsertBatchResults); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gensql.DeploymentStatusUpsertBatchResults)
+		}
+	}
+
+	return r0
+}
+
+// MockQuerier_DeploymentStatusUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeploymentStatusUpsert'
+type MockQuerier_DeploymentStatusUpsert_Call struct {
+	*mock.Call
+}
+
+// DeploymentStatusUpsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []gensql.DeploymentStatusUpsertParams
+func (_e *MockQuerier_Expecter) DeploymentStatusUpsert(ctx interface{}, arg interface{}) *MockQuerier_DeploymentStatusUpsert_Call {
+	return &MockQuerier_DeploymentStatusUpsert_Call{Call: _e.mock.On("DeploymentStatusUpsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_DeploymentStatusUpsert_Call) Run(run func(ctx context.Context, arg []gensql.DeploymentStatusUpsertParams)) *MockQuerier_DeploymentStatusUpsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]gensql.DeploymentStatusUpsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeploymentStatusUpsert_Call) Return(_a0 *gensql.DeploymentStatusUpsertBatchResults) *MockQuerier_DeploymentStatusUpsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_DeploymentStatusUpsert_Call) RunAndReturn(run func(context.Context, []gensql.DeploymentStatusUpsertParams) *gensql.DeploymentStatusUpsertBatchResults) *MockQuerier_DeploymentStatusUpsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeploymentStatusesForDeployments provides a mock function with given fields: ctx, deploymentIds
+func (_m *MockQuerier) DeploymentStatusesForDeployments(ctx context.Context, deploymentIds []string) ([]*gensql.DeploymentStatus, error) {
+	ret := _m.Called(ctx, deploymentIds)
+
+	var r0 []*gensql.DeploymentStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*gensql.DeploymentStatus, error)); ok {
+		return rf(ctx, deploymentIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*gensql.DeploymentStatus); ok {
+		r0 = rf(ctx, deploymentIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gensql.DeploymentStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, deploymentIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_DeploymentStatusesForDeployments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeploymentStatusesForDeployments'
+type MockQuerier_DeploymentStatusesForDeployments_Call struct {
+	*mock.Call
+}
+
+// DeploymentStatusesForDeployments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deploymentIds []string
+func (_e *MockQuerier_Expecter) DeploymentStatusesForDeployments(ctx interface{}, deploymentIds interface{}) *MockQuerier_DeploymentStatusesForDeployments_Call {
+	return &MockQuerier_DeploymentStatusesForDeployments_Call{Call: _e.mock.On("DeploymentStatusesForDeployments", ctx, deploymentIds)}
+}
+
+func (_c *MockQuerier_DeploymentStatusesForDeployments_Call) Run(run func(ctx context.Context, deploymentIds []string)) *MockQuerier_DeploymentStatusesForDeployments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeploymentStatusesForDeployments_Call) Return(_a0 []*gensql.DeploymentStatus, _a1 error) *MockQuerier_DeploymentStatusesForDeployments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_DeploymentStatusesForDeployments_Call) RunAndReturn(run func(context.Context, []string) ([]*gensql.DeploymentStatus, error)) *MockQuerier_DeploymentStatusesForDeployments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeploymentUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) DeploymentUpsert(ctx context.Context, arg gensql.DeploymentUpsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.DeploymentUpsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_DeploymentUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeploymentUpsert'
+type MockQuerier_DeploymentUpsert_Call struct {
+	*mock.Call
+}
+
+// DeploymentUpsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.DeploymentUpsertParams
+func (_e *MockQuerier_Expecter) DeploymentUpsert(ctx interface{}, arg interface{}) *MockQuerier_DeploymentUpsert_Call {
+	return &MockQuerier_DeploymentUpsert_Call{Call: _e.mock.On("DeploymentUpsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_DeploymentUpsert_Call) Run(run func(ctx context.Context, arg gensql.DeploymentUpsertParams)) *MockQuerier_DeploymentUpsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.DeploymentUpsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_DeploymentUpsert_Call) Return(_a0 error) *MockQuerier_DeploymentUpsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_DeploymentUpsert_Call) RunAndReturn(run func(context.Context, gensql.DeploymentUpsertParams) error) *MockQuerier_DeploymentUpsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Deployments provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) Deployments(ctx context.Context, arg gensql.DeploymentsParams) ([]*gensql.Deployment, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*gensql.Deployment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.DeploymentsParams) ([]*gensql.Deployment, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.DeploymentsParams) []*gensql.Deployment); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gensql.Deployment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.DeploymentsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_Deployments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Deployments'
+type MockQuerier_Deployments_Call struct {
+	*mock.Call
+}
+
+// Deployments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.DeploymentsParams
+func (_e *MockQuerier_Expecter) Deployments(ctx interface{}, arg interface{}) *MockQuerier_Deployments_Call {
+	return &MockQuerier_Deployments_Call{Call: _e.mock.On("Deployments", ctx, arg)}
+}
+
+func (_c *MockQuerier_Deployments_Call) Run(run func(ctx context.Context, arg gensql.DeploymentsParams)) *MockQuerier_Deployments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.DeploymentsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_Deployments_Call) Return(_a0 []*gensql.Deployment, _a1 error) *MockQuerier_Deployments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_Deployments_Call) RunAndReturn(run func(context.Context, gensql.DeploymentsParams) ([]*gensql.Deployment, error)) *MockQuerier_Deployments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpiringDeployKeys provides a mock function with given fields: ctx, before
+func (_m *MockQuerier) ExpiringDeployKeys(ctx context.Context, before pgtype.Timestamptz) ([]*gensql.DeployKey, error) {
+	ret := _m.Called(ctx, before)
+
+	var r0 []*gensql.DeployKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) ([]*gensql.DeployKey, error)); ok {
+		return rf(ctx, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) []*gensql.DeployKey); ok {
+		r0 = rf(ctx, before)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gensql.DeployKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, pgtype.Timestamptz) error); ok {
+		r1 = rf(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_ExpiringDeployKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpiringDeployKeys'
+type MockQuerier_ExpiringDeployKeys_Call struct {
+	*mock.Call
+}
+
+// ExpiringDeployKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before pgtype.Timestamptz
+func (_e *MockQuerier_Expecter) ExpiringDeployKeys(ctx interface{}, before interface{}) *MockQuerier_ExpiringDeployKeys_Call {
+	return &MockQuerier_ExpiringDeployKeys_Call{Call: _e.mock.On("ExpiringDeployKeys", ctx, before)}
+}
+
+func (_c *MockQuerier_ExpiringDeployKeys_Call) Run(run func(ctx context.Context, before pgtype.Timestamptz)) *MockQuerier_ExpiringDeployKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.Timestamptz))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ExpiringDeployKeys_Call) Return(_a0 []*gensql.DeployKey, _a1 error) *MockQuerier_ExpiringDeployKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_ExpiringDeployKeys_Call) RunAndReturn(run func(context.Context, pgtype.Timestamptz) ([]*gensql.DeployKey, error)) *MockQuerier_ExpiringDeployKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LastCostDate provides a mock function with given fields: ctx
+func (_m *MockQuerier) LastCostDate(ctx context.Context) (pgtype.Date, error) {
+	ret := _m.Called(ctx)
+
+	var r0 pgtype.Date
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (pgtype.Date, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) pgtype.Date); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(pgtype.Date)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_LastCostDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LastCostDate'
+type MockQuerier_LastCostDate_Call struct {
+	*mock.Call
+}
+
+// LastCostDate is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockQuerier_Expecter) LastCostDate(ctx interface{}) *MockQuerier_LastCostDate_Call {
+	return &MockQuerier_LastCostDate_Call{Call: _e.mock.On("LastCostDate", ctx)}
+}
+
+func (_c *MockQuerier_LastCostDate_Call) Run(run func(ctx context.Context)) *MockQuerier_LastCostDate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_LastCostDate_Call) Return(_a0 pgtype.Date, _a1 error) *MockQuerier_LastCostDate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_LastCostDate_Call) RunAndReturn(run func(context.Context) (pgtype.Date, error)) *MockQuerier_LastCostDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MaxResourceUtilizationDate provides a mock function with given fields: ctx
+func (_m *MockQuerier) MaxResourceUtilizationDate(ctx context.Context) (pgtype.Timestamptz, error) {
+	ret := _m.Called(ctx)
+
+	var r0 pgtype.Timestamptz
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (pgtype.Timestamptz, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) pgtype.Timestamptz); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(pgtype.Timestamptz)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_MaxResourceUtilizationDate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MaxResourceUtilizationDate'
+type MockQuerier_MaxResourceUtilizationDate_Call struct {
+	*mock.Call
+}
+
+// MaxResourceUtilizationDate is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockQuerier_Expecter) MaxResourceUtilizationDate(ctx interface{}) *MockQuerier_MaxResourceUtilizationDate_Call {
+	return &MockQuerier_MaxResourceUtilizationDate_Call{Call: _e.mock.On("MaxResourceUtilizationDate", ctx)}
+}
+
+func (_c *MockQuerier_MaxResourceUtilizationDate_Call) Run(run func(ctx context.Context)) *MockQuerier_MaxResourceUtilizationDate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_MaxResourceUtilizationDate_Call) Return(_a0 pgtype.Timestamptz, _a1 error) *MockQuerier_MaxResourceUtilizationDate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_MaxResourceUtilizationDate_Call) RunAndReturn(run func(context.Context) (pgtype.Timestamptz, error)) *MockQuerier_MaxResourceUtilizationDate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MonthlyCostForApp provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) MonthlyCostForApp(ctx context.Context, arg gensql.MonthlyCostForAppParams) ([]*gensql.MonthlyCostForAppRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*gensql.MonthlyCostForAppRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.MonthlyCostForAppParams) ([]*gensql.MonthlyCostForAppRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.MonthlyCostForAppParams) []*gensql.MonthlyCostForAppRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gensql.MonthlyCostForAppRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.MonthlyCostForAppParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_MonthlyCostForApp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MonthlyCostForApp'
+type MockQuerier_MonthlyCostForApp_Call struct {
+	*mock.Call
+}
+
+// MonthlyCostForApp is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.MonthlyCostForAppParams
+func (_e *MockQuerier_Expecter) MonthlyCostForApp(ctx interface{}, arg interface{}) *MockQuerier_MonthlyCostForApp_Call {
+	return &MockQuerier_MonthlyCostForApp_Call{Call: _e.mock.On("MonthlyCostForApp", ctx, arg)}
+}
+
+func (_c *MockQuerier_MonthlyCostForApp_Call) Run(run func(ctx context.Context, arg gensql.MonthlyCostForAppParams)) *MockQuerier_MonthlyCostForApp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.MonthlyCostForAppParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_MonthlyCostForApp_Call) Return(_a0 []*gensql.MonthlyCostForAppRow, _a1 error) *MockQuerier_MonthlyCostForApp_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_MonthlyCostForApp_Call) RunAndReturn(run func(context.Context, gensql.MonthlyCostForAppParams) ([]*gensql.MonthlyCostForAppRow, error)) *MockQuerier_MonthlyCostForApp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MonthlyCostForTeam provides a mock function with given fields: ctx, team
+func (_m *MockQuerier) MonthlyCostForTeam(ctx context.Context, team *string) ([]*gensql.MonthlyCostForTeamRow, error) {
+	ret := _m.Called(ctx, team)
+
+	var r0 []*gensql.MonthlyCostForTeamRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *string) ([]*gensql.MonthlyCostForTeamRow, error)); ok {
+		return rf(ctx, team)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *string) []*gensql.MonthlyCostForTeamRow); ok {
+		r0 = rf(ctx, team)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gensql.MonthlyCostForTeamRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *string) error); ok {
+		r1 = rf(ctx, team)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_MonthlyCostForTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MonthlyCostForTeam'
+type MockQuerier_MonthlyCostForTeam_Call struct {
+	*mock.Call
+}
+
+// MonthlyCostForTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - team *string
+func (_e *MockQuerier_Expecter) MonthlyCostForTeam(ctx interface{}, team interface{}) *MockQuerier_MonthlyCostForTeam_Call {
+	return &MockQuerier_MonthlyCostForTeam_Call{Call: _e.mock.On("MonthlyCostForTeam", ctx, team)}
+}
+
+func (_c *MockQuerier_MonthlyCostForTeam_Call) Run(run func(ctx context.Context, team *string)) *MockQuerier_MonthlyCostForTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_MonthlyCostForTeam_Call) Return(_a0 []*gensql.MonthlyCostForTeamRow, _a1 error) *MockQuerier_MonthlyCostForTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_MonthlyCostForTeam_Call) RunAndReturn(run func(context.Context, *string) ([]*gensql.MonthlyCostForTeamRow, error)) *MockQuerier_MonthlyCostForTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpenCriticalFindings provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) OpenCriticalFindings(ctx context.Context, arg gensql.OpenCriticalFindingsParams) ([]*gensql.VulnerabilityCriticalFinding, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*gensql.VulnerabilityCriticalFinding
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.OpenCriticalFindingsParams) ([]*gensql.VulnerabilityCriticalFinding, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.OpenCriticalFindingsParams) []*gensql.VulnerabilityCriticalFinding); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gensql.VulnerabilityCriticalFinding)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.OpenCriticalFindingsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_OpenCriticalFindings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenCriticalFindings'
+type MockQuerier_OpenCriticalFindings_Call struct {
+	*mock.Call
+}
+
+// OpenCriticalFindings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.OpenCriticalFindingsParams
+func (_e *MockQuerier_Expecter) OpenCriticalFindings(ctx interface{}, arg interface{}) *MockQuerier_OpenCriticalFindings_Call {
+	return &MockQuerier_OpenCriticalFindings_Call{Call: _e.mock.On("OpenCriticalFindings", ctx, arg)}
+}
+
+func (_c *MockQuerier_OpenCriticalFindings_Call) Run(run func(ctx context.Context, arg gensql.OpenCriticalFindingsParams)) *MockQuerier_OpenCriticalFindings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.OpenCriticalFindingsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_OpenCriticalFindings_Call) Return(_a0 []*gensql.VulnerabilityCriticalFinding, _a1 error) *MockQuerier_OpenCriticalFindings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_OpenCriticalFindings_Call) RunAndReturn(run func(context.Context, gensql.OpenCriticalFindingsParams) ([]*gensql.VulnerabilityCriticalFinding, error)) *MockQuerier_OpenCriticalFindings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResourceUtilizationExport provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) ResourceUtilizationExport(ctx context.Context, arg gensql.ResourceUtilizationExportParams) ([]*gensql.ResourceUtilizationMetric, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*gensql.ResourceUtilizationMetric
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.ResourceUtilizationExportParams) ([]*gensql.ResourceUtilizationMetric, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.ResourceUtilizationExportParams) []*gensql.ResourceUtilizationMetric); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gensql.ResourceUtilizationMetric)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.ResourceUtilizationExportParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_ResourceUtilizationExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResourceUtilizationExport'
+type MockQuerier_ResourceUtilizationExport_Call struct {
+	*mock.Call
+}
+
+// ResourceUtilizationExport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.ResourceUtilizationExportParams
+func (_e *MockQuerier_Expecter) ResourceUtilizationExport(ctx interface{}, arg interface{}) *MockQuerier_ResourceUtilizationExport_Call {
+	return &MockQuerier_ResourceUtilizationExport_Call{Call: _e.mock.On("ResourceUtilizationExport", ctx, arg)}
+}
+
+func (_c *MockQuerier_ResourceUtilizationExport_Call) Run(run func(ctx context.Context, arg gensql.ResourceUtilizationExportParams)) *MockQuerier_ResourceUtilizationExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.ResourceUtilizationExportParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ResourceUtilizationExport_Call) Return(_a0 []*gensql.ResourceUtilizationMetric, _a1 error) *MockQuerier_ResourceUtilizationExport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_ResourceUtilizationExport_Call) RunAndReturn(run func(context.Context, gensql.ResourceUtilizationExportParams) ([]*gensql.ResourceUtilizationMetric, error)) *MockQuerier_ResourceUtilizationExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResourceUtilizationForApp provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) ResourceUtilizationForApp(ctx context.Context, arg gensql.ResourceUtilizationForAppParams) ([]*gensql.ResourceUtilizationMetric, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*gensql.ResourceUtilizationMetric
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.ResourceUtilizationForAppParams) ([]*gensql.ResourceUtilizationMetric, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.ResourceUtilizationForAppParams) []*gensql.ResourceUtilizationMetric); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gensql.ResourceUtilizationMetric)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.ResourceUtilizationForAppParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_ResourceUtilizationForApp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResourceUtilizationForApp'
+type MockQuerier_ResourceUtilizationForApp_Call struct {
+	*mock.Call
+}
+
+// ResourceUtilizationForApp is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.ResourceUtilizationForAppParams
+func (_e *MockQuerier_Expecter) ResourceUtilizationForApp(ctx interface{}, arg interface{}) *MockQuerier_ResourceUtilizationForApp_Call {
+	return &MockQuerier_ResourceUtilizationForApp_Call{Call: _e.mock.On("ResourceUtilizationForApp", ctx, arg)}
+}
+
+func (_c *MockQuerier_ResourceUtilizationForApp_Call) Run(run func(ctx context.Context, arg gensql.ResourceUtilizationForAppParams)) *MockQuerier_ResourceUtilizationForApp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.ResourceUtilizationForAppParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ResourceUtilizationForApp_Call) Return(_a0 []*gensql.ResourceUtilizationMetric, _a1 error) *MockQuerier_ResourceUtilizationForApp_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_ResourceUtilizationForApp_Call) RunAndReturn(run func(context.Context, gensql.ResourceUtilizationForAppParams) ([]*gensql.ResourceUtilizationMetric, error)) *MockQuerier_ResourceUtilizationForApp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResourceUtilizationForTeam provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) ResourceUtilizationForTeam(ctx context.Context, arg gensql.ResourceUtilizationForTeamParams) ([]*gensql.ResourceUtilizationForTeamRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*gensql.ResourceUtilizationForTeamRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.ResourceUtilizationForTeamParams) ([]*gensql.ResourceUtilizationForTeamRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.ResourceUtilizationForTeamParams) []*gensql.ResourceUtilizationForTeamRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gensql.ResourceUtilizationForTeamRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.ResourceUtilizationForTeamParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_ResourceUtilizationForTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResourceUtilizationForTeam'
+type MockQuerier_ResourceUtilizationForTeam_Call struct {
+	*mock.Call
+}
+
+// ResourceUtilizationForTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.ResourceUtilizationForTeamParams
+func (_e *MockQuerier_Expecter) ResourceUtilizationForTeam(ctx interface{}, arg interface{}) *MockQuerier_ResourceUtilizationForTeam_Call {
+	return &MockQuerier_ResourceUtilizationForTeam_Call{Call: _e.mock.On("ResourceUtilizationForTeam", ctx, arg)}
+}
+
+func (_c *MockQuerier_ResourceUtilizationForTeam_Call) Run(run func(ctx context.Context, arg gensql.ResourceUtilizationForTeamParams)) *MockQuerier_ResourceUtilizationForTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.ResourceUtilizationForTeamParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ResourceUtilizationForTeam_Call) Return(_a0 []*gensql.ResourceUtilizationForTeamRow, _a1 error) *MockQuerier_ResourceUtilizationForTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_ResourceUtilizationForTeam_Call) RunAndReturn(run func(context.Context, gensql.ResourceUtilizationForTeamParams) ([]*gensql.ResourceUtilizationForTeamRow, error)) *MockQuerier_ResourceUtilizationForTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResourceUtilizationOverageForTeam provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) ResourceUtilizationOverageForTeam(ctx context.Context, arg gensql.ResourceUtilizationOverageForTeamParams) ([]*gensql.ResourceUtilizationOverageForTeamRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*gensql.ResourceUtilizationOverageForTeamRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.ResourceUtilizationOverageForTeamParams) ([]*gensql.ResourceUtilizationOverageForTeamRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.ResourceUtilizationOverageForTeamParams) []*gensql.ResourceUtilizationOverageForTeamRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gensql.ResourceUtilizationOverageForTeamRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.ResourceUtilizationOverageForTeamParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_ResourceUtilizationOverageForTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResourceUtilizationOverageForTeam'
+type MockQuerier_ResourceUtilizationOverageForTeam_Call struct {
+	*mock.Call
+}
+
+// ResourceUtilizationOverageForTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.ResourceUtilizationOverageForTeamParams
+func (_e *MockQuerier_Expecter) ResourceUtilizationOverageForTeam(ctx interface{}, arg interface{}) *MockQuerier_ResourceUtilizationOverageForTeam_Call {
+	return &MockQuerier_ResourceUtilizationOverageForTeam_Call{Call: _e.mock.On("ResourceUtilizationOverageForTeam", ctx, arg)}
+}
+
+func (_c *MockQuerier_ResourceUtilizationOverageForTeam_Call) Run(run func(ctx context.Context, arg gensql.ResourceUtilizationOverageForTeamParams)) *MockQuerier_ResourceUtilizationOverageForTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.ResourceUtilizationOverageForTeamParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ResourceUtilizationOverageForTeam_Call) Return(_a0 []*gensql.ResourceUtilizationOverageForTeamRow, _a1 error) *MockQuerier_ResourceUtilizationOverageForTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_ResourceUtilizationOverageForTeam_Call) RunAndReturn(run func(context.Context, gensql.ResourceUtilizationOverageForTeamParams) ([]*gensql.ResourceUtilizationOverageForTeamRow, error)) *MockQuerier_ResourceUtilizationOverageForTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResourceUtilizationRangeForApp provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) ResourceUtilizationRangeForApp(ctx context.Context, arg gensql.ResourceUtilizationRangeForAppParams) (*gensql.ResourceUtilizationRangeForAppRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *gensql.ResourceUtilizationRangeForAppRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.ResourceUtilizationRangeForAppParams) (*gensql.ResourceUtilizationRangeForAppRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.ResourceUtilizationRangeForAppParams) *gensql.ResourceUtilizationRangeForAppRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gensql.ResourceUtilizationRangeForAppRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.ResourceUtilizationRangeForAppParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_ResourceUtilizationRangeForApp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResourceUtilizationRangeForApp'
+type MockQuerier_ResourceUtilizationRangeForApp_Call struct {
+	*mock.Call
+}
+
+// ResourceUtilizationRangeForApp is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.ResourceUtilizationRangeForAppParams
+func (_e *MockQuerier_Expecter) ResourceUtilizationRangeForApp(ctx interface{}, arg interface{}) *MockQuerier_ResourceUtilizationRangeForApp_Call {
+	return &MockQuerier_ResourceUtilizationRangeForApp_Call{Call: _e.mock.On("ResourceUtilizationRangeForApp", ctx, arg)}
+}
+
+func (_c *MockQuerier_ResourceUtilizationRangeForApp_Call) Run(run func(ctx context.Context, arg gensql.ResourceUtilizationRangeForAppParams)) *MockQuerier_ResourceUtilizationRangeForApp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.ResourceUtilizationRangeForAppParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ResourceUtilizationRangeForApp_Call) Return(_a0 *gensql.ResourceUtilizationRangeForAppRow, _a1 error) *MockQuerier_ResourceUtilizationRangeForApp_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_ResourceUtilizationRangeForApp_Call) RunAndReturn(run func(context.Context, gensql.ResourceUtilizationRangeForAppParams) (*gensql.ResourceUtilizationRangeForAppRow, error)) *MockQuerier_ResourceUtilizationRangeForApp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResourceUtilizationRangeForTeam provides a mock function with given fields: ctx, team
+func (_m *MockQuerier) ResourceUtilizationRangeForTeam(ctx context.Context, team string) (*gensql.ResourceUtilizationRangeForTeamRow, error) {
+	ret := _m.Called(ctx, team)
+
+	var r0 *gensql.ResourceUtilizationRangeForTeamRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*gensql.ResourceUtilizationRangeForTeamRow, error)); ok {
+		return rf(ctx, team)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *gensql.ResourceUtilizationRangeForTeamRow); ok {
+		r0 = rf(ctx, team)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gensql.ResourceUtilizationRangeForTeamRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, team)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_ResourceUtilizationRangeForTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResourceUtilizationRangeForTeam'
+type MockQuerier_ResourceUtilizationRangeForTeam_Call struct {
+	*mock.Call
+}
+
+// ResourceUtilizationRangeForTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - team string
+func (_e *MockQuerier_Expecter) ResourceUtilizationRangeForTeam(ctx interface{}, team interface{}) *MockQuerier_ResourceUtilizationRangeForTeam_Call {
+	return &MockQuerier_ResourceUtilizationRangeForTeam_Call{Call: _e.mock.On("ResourceUtilizationRangeForTeam", ctx, team)}
+}
+
+func (_c *MockQuerier_ResourceUtilizationRangeForTeam_Call) Run(run func(ctx context.Context, team string)) *MockQuerier_ResourceUtilizationRangeForTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ResourceUtilizationRangeForTeam_Call) Return(_a0 *gensql.ResourceUtilizationRangeForTeamRow, _a1 error) *MockQuerier_ResourceUtilizationRangeForTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_ResourceUtilizationRangeForTeam_Call) RunAndReturn(run func(context.Context, string) (*gensql.ResourceUtilizationRangeForTeamRow, error)) *MockQuerier_ResourceUtilizationRangeForTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResourceUtilizationUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) ResourceUtilizationUpsert(ctx context.Context, arg []gensql.ResourceUtilizationUpsertParams) *gensql.ResourceUtilizationUpsertBatchResults {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *gensql.ResourceUtilizationUpsertBatchResults
+	if rf, ok := ret.Get(0).(func(context.Context, []gensql.ResourceUtilizationUpsertParams) *gensql.ResourceUtilizationUpsertBatchResults); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gensql.ResourceUtilizationUpsertBatchResults)
+		}
+	}
+
+	return r0
+}
+
+// MockQuerier_ResourceUtilizationUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResourceUtilizationUpsert'
+type MockQuerier_ResourceUtilizationUpsert_Call struct {
+	*mock.Call
+}
+
+// ResourceUtilizationUpsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []gensql.ResourceUtilizationUpsertParams
+func (_e *MockQuerier_Expecter) ResourceUtilizationUpsert(ctx interface{}, arg interface{}) *MockQuerier_ResourceUtilizationUpsert_Call {
+	return &MockQuerier_ResourceUtilizationUpsert_Call{Call: _e.mock.On("ResourceUtilizationUpsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_ResourceUtilizationUpsert_Call) Run(run func(ctx context.Context, arg []gensql.ResourceUtilizationUpsertParams)) *MockQuerier_ResourceUtilizationUpsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]gensql.ResourceUtilizationUpsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_ResourceUtilizationUpsert_Call) Return(_a0 *gensql.ResourceUtilizationUpsertBatchResults) *MockQuerier_ResourceUtilizationUpsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_ResourceUtilizationUpsert_Call) RunAndReturn(run func(context.Context, []gensql.ResourceUtilizationUpsertParams) *gensql.ResourceUtilizationUpsertBatchResults) *MockQuerier_ResourceUtilizationUpsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SpecificResourceUtilizationForApp provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) SpecificResourceUtilizationForApp(ctx context.Context, arg gensql.SpecificResourceUtilizationForAppParams) (*gensql.SpecificResourceUtilizationForAppRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *gensql.SpecificResourceUtilizationForAppRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.SpecificResourceUtilizationForAppParams) (*gensql.SpecificResourceUtilizationForAppRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.SpecificResourceUtilizationForAppParams) *gensql.SpecificResourceUtilizationForAppRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gensql.SpecificResourceUtilizationForAppRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.SpecificResourceUtilizationForAppParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_SpecificResourceUtilizationForApp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SpecificResourceUtilizationForApp'
+type MockQuerier_SpecificResourceUtilizationForApp_Call struct {
+	*mock.Call
+}
+
+// SpecificResourceUtilizationForApp is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.SpecificResourceUtilizationForAppParams
+func (_e *MockQuerier_Expecter) SpecificResourceUtilizationForApp(ctx interface{}, arg interface{}) *MockQuerier_SpecificResourceUtilizationForApp_Call {
+	return &MockQuerier_SpecificResourceUtilizationForApp_Call{Call: _e.mock.On("SpecificResourceUtilizationForApp", ctx, arg)}
+}
+
+func (_c *MockQuerier_SpecificResourceUtilizationForApp_Call) Run(run func(ctx context.Context, arg gensql.SpecificResourceUtilizationForAppParams)) *MockQuerier_SpecificResourceUtilizationForApp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.SpecificResourceUtilizationForAppParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_SpecificResourceUtilizationForApp_Call) Return(_a0 *gensql.SpecificResourceUtilizationForAppRow, _a1 error) *MockQuerier_SpecificResourceUtilizationForApp_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_SpecificResourceUtilizationForApp_Call) RunAndReturn(run func(context.Context, gensql.SpecificResourceUtilizationForAppParams) (*gensql.SpecificResourceUtilizationForAppRow, error)) *MockQuerier_SpecificResourceUtilizationForApp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SpecificResourceUtilizationForTeam provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) SpecificResourceUtilizationForTeam(ctx context.Context, arg gensql.SpecificResourceUtilizationForTeamParams) (*gensql.SpecificResourceUtilizationForTeamRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *gensql.SpecificResourceUtilizationForTeamRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.SpecificResourceUtilizationForTeamParams) (*gensql.SpecificResourceUtilizationForTeamRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.SpecificResourceUtilizationForTeamParams) *gensql.SpecificResourceUtilizationForTeamRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gensql.SpecificResourceUtilizationForTeamRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.SpecificResourceUtilizationForTeamParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_SpecificResourceUtilizationForTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SpecificResourceUtilizationForTeam'
+type MockQuerier_SpecificResourceUtilizationForTeam_Call struct {
+	*mock.Call
+}
+
+// SpecificResourceUtilizationForTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.SpecificResourceUtilizationForTeamParams
+func (_e *MockQuerier_Expecter) SpecificResourceUtilizationForTeam(ctx interface{}, arg interface{}) *MockQuerier_SpecificResourceUtilizationForTeam_Call {
+	return &MockQuerier_SpecificResourceUtilizationForTeam_Call{Call: _e.mock.On("SpecificResourceUtilizationForTeam", ctx, arg)}
+}
+
+func (_c *MockQuerier_SpecificResourceUtilizationForTeam_Call) Run(run func(ctx context.Context, arg gensql.SpecificResourceUtilizationForTeamParams)) *MockQuerier_SpecificResourceUtilizationForTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.SpecificResourceUtilizationForTeamParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_SpecificResourceUtilizationForTeam_Call) Return(_a0 *gensql.SpecificResourceUtilizationForTeamRow, _a1 error) *MockQuerier_SpecificResourceUtilizationForTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_SpecificResourceUtilizationForTeam_Call) RunAndReturn(run func(context.Context, gensql.SpecificResourceUtilizationForTeamParams) (*gensql.SpecificResourceUtilizationForTeamRow, error)) *MockQuerier_SpecificResourceUtilizationForTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TenantAppCostGrowth provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) TenantAppCostGrowth(ctx context.Context, arg gensql.TenantAppCostGrowthParams) ([]*gensql.TenantAppCostGrowthRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*gensql.TenantAppCostGrowthRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.TenantAppCostGrowthParams) ([]*gensql.TenantAppCostGrowthRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.TenantAppCostGrowthParams) []*gensql.TenantAppCostGrowthRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gensql.TenantAppCostGrowthRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.TenantAppCostGrowthParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_TenantAppCostGrowth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TenantAppCostGrowth'
+type MockQuerier_TenantAppCostGrowth_Call struct {
+	*mock.Call
+}
+
+// TenantAppCostGrowth is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.TenantAppCostGrowthParams
+func (_e *MockQuerier_Expecter) TenantAppCostGrowth(ctx interface{}, arg interface{}) *MockQuerier_TenantAppCostGrowth_Call {
+	return &MockQuerier_TenantAppCostGrowth_Call{Call: _e.mock.On("TenantAppCostGrowth", ctx, arg)}
+}
+
+func (_c *MockQuerier_TenantAppCostGrowth_Call) Run(run func(ctx context.Context, arg gensql.TenantAppCostGrowthParams)) *MockQuerier_TenantAppCostGrowth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.TenantAppCostGrowthParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_TenantAppCostGrowth_Call) Return(_a0 []*gensql.TenantAppCostGrowthRow, _a1 error) *MockQuerier_TenantAppCostGrowth_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_TenantAppCostGrowth_Call) RunAndReturn(run func(context.Context, gensql.TenantAppCostGrowthParams) ([]*gensql.TenantAppCostGrowthRow, error)) *MockQuerier_TenantAppCostGrowth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TenantCostPerEnv provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) TenantCostPerEnv(ctx context.Context, arg gensql.TenantCostPerEnvParams) ([]*gensql.TenantCostPerEnvRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*gensql.TenantCostPerEnvRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.TenantCostPerEnvParams) ([]*gensql.TenantCostPerEnvRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.TenantCostPerEnvParams) []*gensql.TenantCostPerEnvRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gensql.TenantCostPerEnvRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.TenantCostPerEnvParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_TenantCostPerEnv_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TenantCostPerEnv'
+type MockQuerier_TenantCostPerEnv_Call struct {
+	*mock.Call
+}
+
+// TenantCostPerEnv is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.TenantCostPerEnvParams
+func (_e *MockQuerier_Expecter) TenantCostPerEnv(ctx interface{}, arg interface{}) *MockQuerier_TenantCostPerEnv_Call {
+	return &MockQuerier_TenantCostPerEnv_Call{Call: _e.mock.On("TenantCostPerEnv", ctx, arg)}
+}
+
+func (_c *MockQuerier_TenantCostPerEnv_Call) Run(run func(ctx context.Context, arg gensql.TenantCostPerEnvParams)) *MockQuerier_TenantCostPerEnv_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.TenantCostPerEnvParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_TenantCostPerEnv_Call) Return(_a0 []*gensql.TenantCostPerEnvRow, _a1 error) *MockQuerier_TenantCostPerEnv_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_TenantCostPerEnv_Call) RunAndReturn(run func(context.Context, gensql.TenantCostPerEnvParams) ([]*gensql.TenantCostPerEnvRow, error)) *MockQuerier_TenantCostPerEnv_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TenantTeamCostGrowth provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) TenantTeamCostGrowth(ctx context.Context, arg gensql.TenantTeamCostGrowthParams) ([]*gensql.TenantTeamCostGrowthRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*gensql.TenantTeamCostGrowthRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.TenantTeamCostGrowthParams) ([]*gensql.TenantTeamCostGrowthRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.TenantTeamCostGrowthParams) []*gensql.TenantTeamCostGrowthRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gensql.TenantTeamCostGrowthRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.TenantTeamCostGrowthParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_TenantTeamCostGrowth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TenantTeamCostGrowth'
+type MockQuerier_TenantTeamCostGrowth_Call struct {
+	*mock.Call
+}
+
+// TenantTeamCostGrowth is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.TenantTeamCostGrowthParams
+func (_e *MockQuerier_Expecter) TenantTeamCostGrowth(ctx interface{}, arg interface{}) *MockQuerier_TenantTeamCostGrowth_Call {
+	return &MockQuerier_TenantTeamCostGrowth_Call{Call: _e.mock.On("TenantTeamCostGrowth", ctx, arg)}
+}
+
+func (_c *MockQuerier_TenantTeamCostGrowth_Call) Run(run func(ctx context.Context, arg gensql.TenantTeamCostGrowthParams)) *MockQuerier_TenantTeamCostGrowth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.TenantTeamCostGrowthParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_TenantTeamCostGrowth_Call) Return(_a0 []*gensql.TenantTeamCostGrowthRow, _a1 error) *MockQuerier_TenantTeamCostGrowth_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_TenantTeamCostGrowth_Call) RunAndReturn(run func(context.Context, gensql.TenantTeamCostGrowthParams) ([]*gensql.TenantTeamCostGrowthRow, error)) *MockQuerier_TenantTeamCostGrowth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TenantTopApps provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) TenantTopApps(ctx context.Context, arg gensql.TenantTopAppsParams) ([]*gensql.TenantTopAppsRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*gensql.TenantTopAppsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.TenantTopAppsParams) ([]*gensql.TenantTopAppsRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.TenantTopAppsParams) []*gensql.TenantTopAppsRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gensql.TenantTopAppsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.TenantTopAppsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_TenantTopApps_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TenantTopApps'
+type MockQuerier_TenantTopApps_Call struct {
+	*mock.Call
+}
+
+// TenantTopApps is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.TenantTopAppsParams
+func (_e *MockQuerier_Expecter) TenantTopApps(ctx interface{}, arg interface{}) *MockQuerier_TenantTopApps_Call {
+	return &MockQuerier_TenantTopApps_Call{Call: _e.mock.On("TenantTopApps", ctx, arg)}
+}
+
+func (_c *MockQuerier_TenantTopApps_Call) Run(run func(ctx context.Context, arg gensql.TenantTopAppsParams)) *MockQuerier_TenantTopApps_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.TenantTopAppsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_TenantTopApps_Call) Return(_a0 []*gensql.TenantTopAppsRow, _a1 error) *MockQuerier_TenantTopApps_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_TenantTopApps_Call) RunAndReturn(run func(context.Context, gensql.TenantTopAppsParams) ([]*gensql.TenantTopAppsRow, error)) *MockQuerier_TenantTopApps_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TenantTopTeams provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) TenantTopTeams(ctx context.Context, arg gensql.TenantTopTeamsParams) ([]*gensql.TenantTopTeamsRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*gensql.TenantTopTeamsRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.TenantTopTeamsParams) ([]*gensql.TenantTopTeamsRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.TenantTopTeamsParams) []*gensql.TenantTopTeamsRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gensql.TenantTopTeamsRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.TenantTopTeamsParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_TenantTopTeams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TenantTopTeams'
+type MockQuerier_TenantTopTeams_Call struct {
+	*mock.Call
+}
+
+// TenantTopTeams is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.TenantTopTeamsParams
+func (_e *MockQuerier_Expecter) TenantTopTeams(ctx interface{}, arg interface{}) *MockQuerier_TenantTopTeams_Call {
+	return &MockQuerier_TenantTopTeams_Call{Call: _e.mock.On("TenantTopTeams", ctx, arg)}
+}
+
+func (_c *MockQuerier_TenantTopTeams_Call) Run(run func(ctx context.Context, arg gensql.TenantTopTeamsParams)) *MockQuerier_TenantTopTeams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.TenantTopTeamsParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_TenantTopTeams_Call) Return(_a0 []*gensql.TenantTopTeamsRow, _a1 error) *MockQuerier_TenantTopTeams_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_TenantTopTeams_Call) RunAndReturn(run func(context.Context, gensql.TenantTopTeamsParams) ([]*gensql.TenantTopTeamsRow, error)) *MockQuerier_TenantTopTeams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Transaction provides a mock function with given fields: ctx, fn
+func (_m *MockQuerier) Transaction(ctx context.Context, fn func(context.Context, gensql.Querier) error) error {
+	ret := _m.Called(ctx, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(context.Context, gensql.Querier) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_Transaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Transaction'
+type MockQuerier_Transaction_Call struct {
+	*mock.Call
+}
+
+// Transaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(context.Context , gensql.Querier) error
+func (_e *MockQuerier_Expecter) Transaction(ctx interface{}, fn interface{}) *MockQuerier_Transaction_Call {
+	return &MockQuerier_Transaction_Call{Call: _e.mock.On("Transaction", ctx, fn)}
+}
+
+func (_c *MockQuerier_Transaction_Call) Run(run func(ctx context.Context, fn func(context.Context, gensql.Querier) error)) *MockQuerier_Transaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(context.Context, gensql.Querier) error))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_Transaction_Call) Return(_a0 error) *MockQuerier_Transaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_Transaction_Call) RunAndReturn(run func(context.Context, func(context.Context, gensql.Querier) error) error) *MockQuerier_Transaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VulnerabilityAnalysisAuditCreate provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) VulnerabilityAnalysisAuditCreate(ctx context.Context, arg gensql.VulnerabilityAnalysisAuditCreateParams) (int32, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 int32
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.VulnerabilityAnalysisAuditCreateParams) (int32, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.VulnerabilityAnalysisAuditCreateParams) int32); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Get(0).(int32)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.VulnerabilityAnalysisAuditCreateParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_VulnerabilityAnalysisAuditCreate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VulnerabilityAnalysisAuditCreate'
+type MockQuerier_VulnerabilityAnalysisAuditCreate_Call struct {
+	*mock.Call
+}
+
+// VulnerabilityAnalysisAuditCreate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.VulnerabilityAnalysisAuditCreateParams
+func (_e *MockQuerier_Expecter) VulnerabilityAnalysisAuditCreate(ctx interface{}, arg interface{}) *MockQuerier_VulnerabilityAnalysisAuditCreate_Call {
+	return &MockQuerier_VulnerabilityAnalysisAuditCreate_Call{Call: _e.mock.On("VulnerabilityAnalysisAuditCreate", ctx, arg)}
+}
+
+func (_c *MockQuerier_VulnerabilityAnalysisAuditCreate_Call) Run(run func(ctx context.Context, arg gensql.VulnerabilityAnalysisAuditCreateParams)) *MockQuerier_VulnerabilityAnalysisAuditCreate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.VulnerabilityAnalysisAuditCreateParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilityAnalysisAuditCreate_Call) Return(_a0 int32, _a1 error) *MockQuerier_VulnerabilityAnalysisAuditCreate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilityAnalysisAuditCreate_Call) RunAndReturn(run func(context.Context, gensql.VulnerabilityAnalysisAuditCreateParams) (int32, error)) *MockQuerier_VulnerabilityAnalysisAuditCreate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VulnerabilityAnalysisAuditDelete provides a mock function with given fields: ctx, id
+func (_m *MockQuerier) VulnerabilityAnalysisAuditDelete(ctx context.Context, id int32) error {
+	ret := _m.Called(ctx, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_VulnerabilityAnalysisAuditDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VulnerabilityAnalysisAuditDelete'
+type MockQuerier_VulnerabilityAnalysisAuditDelete_Call struct {
+	*mock.Call
+}
+
+// VulnerabilityAnalysisAuditDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int32
+func (_e *MockQuerier_Expecter) VulnerabilityAnalysisAuditDelete(ctx interface{}, id interface{}) *MockQuerier_VulnerabilityAnalysisAuditDelete_Call {
+	return &MockQuerier_VulnerabilityAnalysisAuditDelete_Call{Call: _e.mock.On("VulnerabilityAnalysisAuditDelete", ctx, id)}
+}
+
+func (_c *MockQuerier_VulnerabilityAnalysisAuditDelete_Call) Run(run func(ctx context.Context, id int32)) *MockQuerier_VulnerabilityAnalysisAuditDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilityAnalysisAuditDelete_Call) Return(_a0 error) *MockQuerier_VulnerabilityAnalysisAuditDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilityAnalysisAuditDelete_Call) RunAndReturn(run func(context.Context, int32) error) *MockQuerier_VulnerabilityAnalysisAuditDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VulnerabilityIndexComponentsDelete provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) VulnerabilityIndexComponentsDelete(ctx context.Context, arg gensql.VulnerabilityIndexComponentsDeleteParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.VulnerabilityIndexComponentsDeleteParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_VulnerabilityIndexComponentsDelete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VulnerabilityIndexComponentsDelete'
+type MockQuerier_VulnerabilityIndexComponentsDelete_Call struct {
+	*mock.Call
+}
+
+// VulnerabilityIndexComponentsDelete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.VulnerabilityIndexComponentsDeleteParams
+func (_e *MockQuerier_Expecter) VulnerabilityIndexComponentsDelete(ctx interface{}, arg interface{}) *MockQuerier_VulnerabilityIndexComponentsDelete_Call {
+	return &MockQuerier_VulnerabilityIndexComponentsDelete_Call{Call: _e.mock.On("VulnerabilityIndexComponentsDelete", ctx, arg)}
+}
+
+func (_c *MockQuerier_VulnerabilityIndexComponentsDelete_Call) Run(run func(ctx context.Context, arg gensql.VulnerabilityIndexComponentsDeleteParams)) *MockQuerier_VulnerabilityIndexComponentsDelete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.VulnerabilityIndexComponentsDeleteParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilityIndexComponentsDelete_Call) Return(_a0 error) *MockQuerier_VulnerabilityIndexComponentsDelete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilityIndexComponentsDelete_Call) RunAndReturn(run func(context.Context, gensql.VulnerabilityIndexComponentsDeleteParams) error) *MockQuerier_VulnerabilityIndexComponentsDelete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VulnerabilityIndexComponentsInsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) VulnerabilityIndexComponentsInsert(ctx context.Context, arg []gensql.VulnerabilityIndexComponentsInsertParams) *gensql.VulnerabilityIndexComponentsInsertBatchResults {
+	ret := _m.Called(ctx, arg)
+
+	var r0 *gensql.VulnerabilityIndexComponentsInsertBatchResults
+	if rf, ok := ret.Get(0).(func(context.Context, []gensql.VulnerabilityIndexComponentsInsertParams) *gensql.VulnerabilityIndexComponentsInsertBatchResults); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gensql.VulnerabilityIndexComponentsInsertBatchResults)
+		}
+	}
+
+	return r0
+}
+
+// MockQuerier_VulnerabilityIndexComponentsInsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VulnerabilityIndexComponentsInsert'
+type MockQuerier_VulnerabilityIndexComponentsInsert_Call struct {
+	*mock.Call
+}
+
+// VulnerabilityIndexComponentsInsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg []gensql.VulnerabilityIndexComponentsInsertParams
+func (_e *MockQuerier_Expecter) VulnerabilityIndexComponentsInsert(ctx interface{}, arg interface{}) *MockQuerier_VulnerabilityIndexComponentsInsert_Call {
+	return &MockQuerier_VulnerabilityIndexComponentsInsert_Call{Call: _e.mock.On("VulnerabilityIndexComponentsInsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_VulnerabilityIndexComponentsInsert_Call) Run(run func(ctx context.Context, arg []gensql.VulnerabilityIndexComponentsInsertParams)) *MockQuerier_VulnerabilityIndexComponentsInsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]gensql.VulnerabilityIndexComponentsInsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilityIndexComponentsInsert_Call) Return(_a0 *gensql.VulnerabilityIndexComponentsInsertBatchResults) *MockQuerier_VulnerabilityIndexComponentsInsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilityIndexComponentsInsert_Call) RunAndReturn(run func(context.Context, []gensql.VulnerabilityIndexComponentsInsertParams) *gensql.VulnerabilityIndexComponentsInsertBatchResults) *MockQuerier_VulnerabilityIndexComponentsInsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VulnerabilityIndexDeleteStale provides a mock function with given fields: ctx, before
+func (_m *MockQuerier) VulnerabilityIndexDeleteStale(ctx context.Context, before pgtype.Timestamptz) error {
+	ret := _m.Called(ctx, before)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, pgtype.Timestamptz) error); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_VulnerabilityIndexDeleteStale_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VulnerabilityIndexDeleteStale'
+type MockQuerier_VulnerabilityIndexDeleteStale_Call struct {
+	*mock.Call
+}
+
+// VulnerabilityIndexDeleteStale is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before pgtype.Timestamptz
+func (_e *MockQuerier_Expecter) VulnerabilityIndexDeleteStale(ctx interface{}, before interface{}) *MockQuerier_VulnerabilityIndexDeleteStale_Call {
+	return &MockQuerier_VulnerabilityIndexDeleteStale_Call{Call: _e.mock.On("VulnerabilityIndexDeleteStale", ctx, before)}
+}
+
+func (_c *MockQuerier_VulnerabilityIndexDeleteStale_Call) Run(run func(ctx context.Context, before pgtype.Timestamptz)) *MockQuerier_VulnerabilityIndexDeleteStale_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pgtype.Timestamptz))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilityIndexDeleteStale_Call) Return(_a0 error) *MockQuerier_VulnerabilityIndexDeleteStale_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilityIndexDeleteStale_Call) RunAndReturn(run func(context.Context, pgtype.Timestamptz) error) *MockQuerier_VulnerabilityIndexDeleteStale_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VulnerabilityIndexUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) VulnerabilityIndexUpsert(ctx context.Context, arg gensql.VulnerabilityIndexUpsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.VulnerabilityIndexUpsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_VulnerabilityIndexUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VulnerabilityIndexUpsert'
+type MockQuerier_VulnerabilityIndexUpsert_Call struct {
+	*mock.Call
+}
+
+// VulnerabilityIndexUpsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.VulnerabilityIndexUpsertParams
+func (_e *MockQuerier_Expecter) VulnerabilityIndexUpsert(ctx interface{}, arg interface{}) *MockQuerier_VulnerabilityIndexUpsert_Call {
+	return &MockQuerier_VulnerabilityIndexUpsert_Call{Call: _e.mock.On("VulnerabilityIndexUpsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_VulnerabilityIndexUpsert_Call) Run(run func(ctx context.Context, arg gensql.VulnerabilityIndexUpsertParams)) *MockQuerier_VulnerabilityIndexUpsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.VulnerabilityIndexUpsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilityIndexUpsert_Call) Return(_a0 error) *MockQuerier_VulnerabilityIndexUpsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilityIndexUpsert_Call) RunAndReturn(run func(context.Context, gensql.VulnerabilityIndexUpsertParams) error) *MockQuerier_VulnerabilityIndexUpsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VulnerabilitySnapshotUpsert provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) VulnerabilitySnapshotUpsert(ctx context.Context, arg gensql.VulnerabilitySnapshotUpsertParams) error {
+	ret := _m.Called(ctx, arg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.VulnerabilitySnapshotUpsertParams) error); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQuerier_VulnerabilitySnapshotUpsert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VulnerabilitySnapshotUpsert'
+type MockQuerier_VulnerabilitySnapshotUpsert_Call struct {
+	*mock.Call
+}
+
+// VulnerabilitySnapshotUpsert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.VulnerabilitySnapshotUpsertParams
+func (_e *MockQuerier_Expecter) VulnerabilitySnapshotUpsert(ctx interface{}, arg interface{}) *MockQuerier_VulnerabilitySnapshotUpsert_Call {
+	return &MockQuerier_VulnerabilitySnapshotUpsert_Call{Call: _e.mock.On("VulnerabilitySnapshotUpsert", ctx, arg)}
+}
+
+func (_c *MockQuerier_VulnerabilitySnapshotUpsert_Call) Run(run func(ctx context.Context, arg gensql.VulnerabilitySnapshotUpsertParams)) *MockQuerier_VulnerabilitySnapshotUpsert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.VulnerabilitySnapshotUpsertParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilitySnapshotUpsert_Call) Return(_a0 error) *MockQuerier_VulnerabilitySnapshotUpsert_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilitySnapshotUpsert_Call) RunAndReturn(run func(context.Context, gensql.VulnerabilitySnapshotUpsertParams) error) *MockQuerier_VulnerabilitySnapshotUpsert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VulnerabilitySnapshotsForApp provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) VulnerabilitySnapshotsForApp(ctx context.Context, arg gensql.VulnerabilitySnapshotsForAppParams) ([]*gensql.VulnerabilitySnapshot, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*gensql.VulnerabilitySnapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.VulnerabilitySnapshotsForAppParams) ([]*gensql.VulnerabilitySnapshot, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.VulnerabilitySnapshotsForAppParams) []*gensql.VulnerabilitySnapshot); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gensql.VulnerabilitySnapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.VulnerabilitySnapshotsForAppParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_VulnerabilitySnapshotsForApp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VulnerabilitySnapshotsForApp'
+type MockQuerier_VulnerabilitySnapshotsForApp_Call struct {
+	*mock.Call
+}
+
+// VulnerabilitySnapshotsForApp is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.VulnerabilitySnapshotsForAppParams
+func (_e *MockQuerier_Expecter) VulnerabilitySnapshotsForApp(ctx interface{}, arg interface{}) *MockQuerier_VulnerabilitySnapshotsForApp_Call {
+	return &MockQuerier_VulnerabilitySnapshotsForApp_Call{Call: _e.mock.On("VulnerabilitySnapshotsForApp", ctx, arg)}
+}
+
+func (_c *MockQuerier_VulnerabilitySnapshotsForApp_Call) Run(run func(ctx context.Context, arg gensql.VulnerabilitySnapshotsForAppParams)) *MockQuerier_VulnerabilitySnapshotsForApp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.VulnerabilitySnapshotsForAppParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilitySnapshotsForApp_Call) Return(_a0 []*gensql.VulnerabilitySnapshot, _a1 error) *MockQuerier_VulnerabilitySnapshotsForApp_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilitySnapshotsForApp_Call) RunAndReturn(run func(context.Context, gensql.VulnerabilitySnapshotsForAppParams) ([]*gensql.VulnerabilitySnapshot, error)) *MockQuerier_VulnerabilitySnapshotsForApp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VulnerabilitySnapshotsForTeam provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) VulnerabilitySnapshotsForTeam(ctx context.Context, arg gensql.VulnerabilitySnapshotsForTeamParams) ([]*gensql.VulnerabilitySnapshot, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*gensql.VulnerabilitySnapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.VulnerabilitySnapshotsForTeamParams) ([]*gensql.VulnerabilitySnapshot, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.VulnerabilitySnapshotsForTeamParams) []*gensql.VulnerabilitySnapshot); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gensql.VulnerabilitySnapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.VulnerabilitySnapshotsForTeamParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_VulnerabilitySnapshotsForTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VulnerabilitySnapshotsForTeam'
+type MockQuerier_VulnerabilitySnapshotsForTeam_Call struct {
+	*mock.Call
+}
+
+// VulnerabilitySnapshotsForTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.VulnerabilitySnapshotsForTeamParams
+func (_e *MockQuerier_Expecter) VulnerabilitySnapshotsForTeam(ctx interface{}, arg interface{}) *MockQuerier_VulnerabilitySnapshotsForTeam_Call {
+	return &MockQuerier_VulnerabilitySnapshotsForTeam_Call{Call: _e.mock.On("VulnerabilitySnapshotsForTeam", ctx, arg)}
+}
+
+func (_c *MockQuerier_VulnerabilitySnapshotsForTeam_Call) Run(run func(ctx context.Context, arg gensql.VulnerabilitySnapshotsForTeamParams)) *MockQuerier_VulnerabilitySnapshotsForTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.VulnerabilitySnapshotsForTeamParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilitySnapshotsForTeam_Call) Return(_a0 []*gensql.VulnerabilitySnapshot, _a1 error) *MockQuerier_VulnerabilitySnapshotsForTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilitySnapshotsForTeam_Call) RunAndReturn(run func(context.Context, gensql.VulnerabilitySnapshotsForTeamParams) ([]*gensql.VulnerabilitySnapshot, error)) *MockQuerier_VulnerabilitySnapshotsForTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VulnerabilitySummaries provides a mock function with given fields: ctx, arg
+func (_m *MockQuerier) VulnerabilitySummaries(ctx context.Context, arg gensql.VulnerabilitySummariesParams) ([]*gensql.VulnerabilitySummariesRow, error) {
+	ret := _m.Called(ctx, arg)
+
+	var r0 []*gensql.VulnerabilitySummariesRow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.VulnerabilitySummariesParams) ([]*gensql.VulnerabilitySummariesRow, error)); ok {
+		return rf(ctx, arg)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gensql.VulnerabilitySummariesParams) []*gensql.VulnerabilitySummariesRow); ok {
+		r0 = rf(ctx, arg)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gensql.VulnerabilitySummariesRow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gensql.VulnerabilitySummariesParams) error); ok {
+		r1 = rf(ctx, arg)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQuerier_VulnerabilitySummaries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VulnerabilitySummaries'
+type MockQuerier_VulnerabilitySummaries_Call struct {
+	*mock.Call
+}
+
+// VulnerabilitySummaries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - arg gensql.VulnerabilitySummariesParams
+func (_e *MockQuerier_Expecter) VulnerabilitySummaries(ctx interface{}, arg interface{}) *MockQuerier_VulnerabilitySummaries_Call {
+	return &MockQuerier_VulnerabilitySummaries_Call{Call: _e.mock.On("VulnerabilitySummaries", ctx, arg)}
+}
+
+func (_c *MockQuerier_VulnerabilitySummaries_Call) Run(run func(ctx context.Context, arg gensql.VulnerabilitySummariesParams)) *MockQuerier_VulnerabilitySummaries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(gensql.VulnerabilitySummariesParams))
+	})
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilitySummaries_Call) Return(_a0 []*gensql.VulnerabilitySummariesRow, _a1 error) *MockQuerier_VulnerabilitySummaries_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQuerier_VulnerabilitySummaries_Call) RunAndReturn(run func(context.Context, gensql.VulnerabilitySummariesParams) ([]*gensql.VulnerabilitySummariesRow, error)) *MockQuerier_VulnerabilitySummaries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockQuerier creates a new instance of MockQuerier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockQuerier(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *MockQuerier {
+	mock := &MockQuerier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
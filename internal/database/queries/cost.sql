@@ -107,4 +107,164 @@ WHERE
 GROUP BY
     team, app, date
 ORDER BY
-    date, app ASC;
\ No newline at end of file
+    date, app ASC;
+
+-- CostForTeams will fetch the total cost for each of the given teams in a date range, across all apps, envs and cost
+-- types.
+-- name: CostForTeams :many
+SELECT
+    team,
+    SUM(daily_cost)::real AS daily_cost
+FROM
+    cost
+WHERE
+    date >= sqlc.arg('from_date')::date
+    AND date <= sqlc.arg('to_date')::date
+    AND team = ANY(sqlc.arg('teams')::text[])
+GROUP BY
+    team
+ORDER BY
+    team ASC;
+
+-- CostBreakdownForTeam will fetch the total cost of a team in a date range for each environment, app and cost type.
+-- name: CostBreakdownForTeam :many
+SELECT
+    COALESCE(env, '')::text AS env,
+    app,
+    cost_type,
+    SUM(daily_cost)::real AS cost
+FROM
+    cost
+WHERE
+    date >= sqlc.arg('from_date')::date
+    AND date <= sqlc.arg('to_date')::date
+    AND team = $1
+GROUP BY
+    env, app, cost_type
+ORDER BY
+    env, app, cost_type ASC;
+
+-- TenantCostPerEnv will fetch the total cost of all teams in a date range for each environment, highest cost first.
+-- name: TenantCostPerEnv :many
+SELECT
+    COALESCE(env, '')::text AS env,
+    SUM(daily_cost)::real AS cost
+FROM
+    cost
+WHERE
+    date >= sqlc.arg('from_date')::date
+    AND date <= sqlc.arg('to_date')::date
+GROUP BY
+    env
+ORDER BY
+    cost DESC, env ASC;
+
+-- TenantTopTeams will fetch the teams with the highest total cost in a date range.
+-- name: TenantTopTeams :many
+SELECT
+    team::text AS team,
+    SUM(daily_cost)::real AS cost
+FROM
+    cost
+WHERE
+    date >= sqlc.arg('from_date')::date
+    AND date <= sqlc.arg('to_date')::date
+    AND team IS NOT NULL
+GROUP BY
+    team
+ORDER BY
+    cost DESC, team ASC
+LIMIT
+    sqlc.arg('limit');
+
+-- TenantTopApps will fetch the apps with the highest total cost in a date range.
+-- name: TenantTopApps :many
+SELECT
+    team::text AS team,
+    COALESCE(env, '')::text AS env,
+    app,
+    SUM(daily_cost)::real AS cost
+FROM
+    cost
+WHERE
+    date >= sqlc.arg('from_date')::date
+    AND date <= sqlc.arg('to_date')::date
+    AND team IS NOT NULL
+GROUP BY
+    team, env, app
+ORDER BY
+    cost DESC, team, env, app ASC
+LIMIT
+    sqlc.arg('limit');
+
+-- TenantTeamCostGrowth will fetch the teams with the highest increase in cost from a previous period to a current
+-- period.
+-- name: TenantTeamCostGrowth :many
+SELECT
+    team::text AS team,
+    SUM(CASE WHEN date >= sqlc.arg('current_from')::date THEN daily_cost ELSE 0 END)::real AS current_cost,
+    SUM(CASE WHEN date <= sqlc.arg('previous_to')::date THEN daily_cost ELSE 0 END)::real AS previous_cost,
+    SUM(CASE WHEN date >= sqlc.arg('current_from')::date THEN daily_cost ELSE -daily_cost END)::real AS growth
+FROM
+    cost
+WHERE
+    (
+        date >= sqlc.arg('previous_from')::date AND date <= sqlc.arg('previous_to')::date
+        OR date >= sqlc.arg('current_from')::date AND date <= sqlc.arg('current_to')::date
+    )
+    AND team IS NOT NULL
+GROUP BY
+    team
+ORDER BY
+    growth DESC, team ASC
+LIMIT
+    sqlc.arg('limit');
+
+-- TenantAppCostGrowth will fetch the apps with the highest increase in cost from a previous period to a current period.
+-- name: TenantAppCostGrowth :many
+SELECT
+    team::text AS team,
+    COALESCE(env, '')::text AS env,
+    app,
+    SUM(CASE WHEN date >= sqlc.arg('current_from')::date THEN daily_cost ELSE 0 END)::real AS current_cost,
+    SUM(CASE WHEN date <= sqlc.arg('previous_to')::date THEN daily_cost ELSE 0 END)::real AS previous_cost,
+    SUM(CASE WHEN date >= sqlc.arg('current_from')::date THEN daily_cost ELSE -daily_cost END)::real AS growth
+FROM
+    cost
+WHERE
+    (
+        date >= sqlc.arg('previous_from')::date AND date <= sqlc.arg('previous_to')::date
+        OR date >= sqlc.arg('current_from')::date AND date <= sqlc.arg('current_to')::date
+    )
+    AND team IS NOT NULL
+GROUP BY
+    team, env, app
+ORDER BY
+    growth DESC, team, env, app ASC
+LIMIT
+    sqlc.arg('limit');
+
+-- CostExport will fetch a page of the daily cost of a team in a date range, optionally for a single environment and
+-- app, ordered by date and id. The page starts after the given date and id, so that large ranges can be read in pages.
+-- name: CostExport :many
+SELECT
+    id,
+    date,
+    team::text AS team,
+    COALESCE(env, '')::text AS env,
+    app,
+    cost_type,
+    daily_cost
+FROM
+    cost
+WHERE
+    team = @team
+    AND (sqlc.narg('env')::text IS NULL OR env = sqlc.narg('env')::text)
+    AND (sqlc.narg('app')::text IS NULL OR app = sqlc.narg('app')::text)
+    AND date >= sqlc.arg('from_date')::date
+    AND date <= sqlc.arg('to_date')::date
+    AND (date, id) > (sqlc.arg('after_date')::date, sqlc.arg('after_id')::integer)
+ORDER BY
+    date, id ASC
+LIMIT
+    sqlc.arg('page_size');
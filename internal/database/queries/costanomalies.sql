@@ -0,0 +1,68 @@
+-- CostSeries will fetch the daily cost of each team, app, environment and cost type in a date range, ordered by series
+-- and date. Cost without a team is not included.
+-- name: CostSeries :many
+SELECT
+    team::text AS team,
+    COALESCE(env, '')::text AS env,
+    app,
+    cost_type,
+    date,
+    daily_cost
+FROM
+    cost
+WHERE
+    team IS NOT NULL
+    AND date >= sqlc.arg('from_date')::date
+    AND date <= sqlc.arg('to_date')::date
+ORDER BY
+    team, env, app, cost_type, date ASC;
+
+-- CostAnomalyUpsert will store an anomaly of a cost series. If the series had an anomaly the day before, the anomaly
+-- is extended to the date, and keeps the date it was first seen.
+-- name: CostAnomalyUpsert :exec
+INSERT INTO cost_anomalies (team, env, app, cost_type, first_seen, last_seen, cost, baseline)
+VALUES (
+    @team,
+    @env,
+    @app,
+    @cost_type,
+    COALESCE(
+        (
+            SELECT
+                a.first_seen
+            FROM
+                cost_anomalies a
+            WHERE
+                a.team = @team
+                AND a.env = @env
+                AND a.app = @app
+                AND a.cost_type = @cost_type
+                AND a.last_seen >= sqlc.arg('date')::date - 1
+            ORDER BY
+                a.first_seen DESC
+            LIMIT 1
+        ),
+        sqlc.arg('date')::date
+    ),
+    sqlc.arg('date')::date,
+    @cost,
+    @baseline
+)
+ON CONFLICT (team, env, app, cost_type, first_seen) DO
+    UPDATE SET
+        last_seen = EXCLUDED.last_seen,
+        cost = EXCLUDED.cost,
+        baseline = EXCLUDED.baseline;
+
+-- CostAnomaliesForTeam will fetch the cost anomalies of a team that were seen in a date range, most recent first.
+-- name: CostAnomaliesForTeam :many
+SELECT
+    *
+FROM
+    cost_anomalies
+WHERE
+    team = $1
+    AND last_seen >= sqlc.arg('from_date')::date
+    AND first_seen <= sqlc.arg('to_date')::date
+ORDER BY
+    last_seen DESC, (cost - baseline) DESC;
@@ -0,0 +1,44 @@
+-- CostBudgetUpsert will set the monthly cost budget of a team, and return the stored row. Notifications are reset, so
+-- that thresholds of the new budget will be notified about.
+-- name: CostBudgetUpsert :one
+INSERT INTO cost_budgets (team, amount, updated_by)
+VALUES ($1, $2, $3)
+ON CONFLICT (team) DO
+    UPDATE SET
+        amount = EXCLUDED.amount,
+        updated_by = EXCLUDED.updated_by,
+        updated = NOW(),
+        notified_month = NULL,
+        notified_threshold = NULL
+RETURNING *;
+
+-- CostBudgetDelete will remove the monthly cost budget of a team.
+-- name: CostBudgetDelete :exec
+DELETE FROM cost_budgets
+WHERE team = $1;
+
+-- CostBudget will fetch the monthly cost budget of a team.
+-- name: CostBudget :one
+SELECT
+    *
+FROM
+    cost_budgets
+WHERE
+    team = $1;
+
+-- CostBudgets will fetch the monthly cost budgets of all teams.
+-- name: CostBudgets :many
+SELECT
+    *
+FROM
+    cost_budgets
+ORDER BY
+    team ASC;
+
+-- CostBudgetNotified will record the highest threshold of the budget the team has been notified about in a month.
+-- name: CostBudgetNotified :exec
+UPDATE cost_budgets
+SET
+    notified_month = $2,
+    notified_threshold = $3
+WHERE team = $1;
@@ -0,0 +1,93 @@
+-- DeliveryMetricsUpsert will insert or update the daily delivery metrics for an app. If there is a conflict on the
+-- delivery_metric constraint, all metrics for the day will be replaced.
+-- name: DeliveryMetricsUpsert :batchexec
+INSERT INTO delivery_metrics (date, env, team, app, deployments, failed_deployments, rollbacks, restores, restore_time_seconds, lead_times, lead_time_seconds)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+ON CONFLICT ON CONSTRAINT delivery_metric DO
+    UPDATE SET
+        deployments = EXCLUDED.deployments,
+        failed_deployments = EXCLUDED.failed_deployments,
+        rollbacks = EXCLUDED.rollbacks,
+        restores = EXCLUDED.restores,
+        restore_time_seconds = EXCLUDED.restore_time_seconds,
+        lead_times = EXCLUDED.lead_times,
+        lead_time_seconds = EXCLUDED.lead_time_seconds;
+
+-- DeliveryMetricsForTeam will fetch the daily delivery metrics for all apps of a team in a date range.
+-- name: DeliveryMetricsForTeam :many
+SELECT
+    *
+FROM
+    delivery_metrics
+WHERE
+    team = $1
+    AND date >= sqlc.arg('from_date')::date
+    AND date <= sqlc.arg('to_date')::date
+ORDER BY
+    date, env, app ASC;
+
+-- AppFailureStart will record that an app has started failing, unless the app is already failing.
+-- name: AppFailureStart :exec
+INSERT INTO app_failures (team, env, app, started)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (team, env, app) WHERE ended IS NULL DO NOTHING;
+
+-- AppFailureEnd will record that an app is no longer failing.
+-- name: AppFailureEnd :exec
+UPDATE
+    app_failures
+SET
+    ended = $4
+WHERE
+    team = $1
+    AND env = $2
+    AND app = $3
+    AND ended IS NULL;
+
+-- AppFailures will fetch the failures of all apps that have not ended, or ended after the given time.
+-- name: AppFailures :many
+SELECT
+    *
+FROM
+    app_failures
+WHERE
+    ended IS NULL
+    OR ended >= sqlc.arg('since')::timestamptz
+ORDER BY
+    started ASC;
+
+-- AppCommits will fetch the commits an app has run, the last one seen first.
+-- name: AppCommits :many
+SELECT
+    commit_sha
+FROM
+    app_commits
+WHERE
+    team = $1
+    AND env = $2
+    AND app = $3
+ORDER BY
+    last_seen DESC;
+
+-- AppCommitUpsert will record that an app is running a commit.
+-- name: AppCommitUpsert :exec
+INSERT INTO app_commits (team, env, app, commit_sha, last_seen)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (team, env, app, commit_sha) DO
+    UPDATE SET last_seen = EXCLUDED.last_seen;
+
+-- AppRollbackInsert will record that an app has been rolled back to a commit.
+-- name: AppRollbackInsert :exec
+INSERT INTO app_rollbacks (team, env, app, commit_sha, detected)
+VALUES ($1, $2, $3, $4, $5);
+
+-- AppRollbacks will fetch the rollbacks of all apps detected after the given time.
+-- name: AppRollbacks :many
+SELECT
+    *
+FROM
+    app_rollbacks
+WHERE
+    detected >= sqlc.arg('since')::timestamptz
+ORDER BY
+    detected ASC;
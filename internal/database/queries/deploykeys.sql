@@ -0,0 +1,39 @@
+-- DeployKeyUpsert will insert or update the deploy key expiry of a team, and return the stored row. The notified
+-- channels are reset when the expiry changes.
+-- name: DeployKeyUpsert :one
+INSERT INTO deploy_keys (team, created, expires)
+VALUES ($1, $2, $3)
+ON CONFLICT (team) DO
+    UPDATE SET
+        created = EXCLUDED.created,
+        expires = EXCLUDED.expires,
+        notified_channels = CASE
+            WHEN deploy_keys.expires = EXCLUDED.expires THEN deploy_keys.notified_channels
+            ELSE '{}'
+        END,
+        checked_at = NOW()
+RETURNING *;
+
+-- DeployKeyNotified will record that the team has been notified about their deploy key with the given expiry.
+-- name: DeployKeyNotified :exec
+UPDATE deploy_keys
+SET notified_expires = $2
+WHERE team = $1;
+
+-- DeployKeyChannelNotified will record that a Slack channel of the team has been notified about their current deploy
+-- key.
+-- name: DeployKeyChannelNotified :exec
+UPDATE deploy_keys
+SET notified_channels = array_append(notified_channels, sqlc.arg('channel')::text)
+WHERE team = sqlc.arg('team');
+
+-- ExpiringDeployKeys will fetch deploy keys that expire before the given time, soonest first.
+-- name: ExpiringDeployKeys :many
+SELECT
+    *
+FROM
+    deploy_keys
+WHERE
+    expires < sqlc.arg('before')::timestamptz
+ORDER BY
+    expires, team ASC;
@@ -0,0 +1,111 @@
+-- DeploymentUpsert will insert or update a deployment. A known commit timestamp is kept when the deployment is
+-- updated without one, as hookd does not return commit timestamps.
+-- name: DeploymentUpsert :exec
+INSERT INTO deployments (id, team, env, repository, created, commit_timestamp)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (id) DO
+    UPDATE SET
+        team = EXCLUDED.team,
+        env = EXCLUDED.env,
+        repository = EXCLUDED.repository,
+        created = EXCLUDED.created,
+        commit_timestamp = COALESCE(EXCLUDED.commit_timestamp, deployments.commit_timestamp);
+
+-- DeploymentStatusUpsert will insert or update statuses of deployments.
+-- name: DeploymentStatusUpsert :batchexec
+INSERT INTO deployment_statuses (id, deployment_id, status, message, created)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (id) DO
+    UPDATE SET
+        status = EXCLUDED.status,
+        message = EXCLUDED.message,
+        created = EXCLUDED.created;
+
+-- DeploymentResourceUpsert will insert or update resources of deployments.
+-- name: DeploymentResourceUpsert :batchexec
+INSERT INTO deployment_resources (id, deployment_id, "group", kind, name, version, namespace)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (id) DO
+    UPDATE SET
+        "group" = EXCLUDED."group",
+        kind = EXCLUDED.kind,
+        name = EXCLUDED.name,
+        version = EXCLUDED.version,
+        namespace = EXCLUDED.namespace;
+
+-- Deployments will fetch deployments, newest first. All filters are optional, an empty list of teams includes all teams. The state of a deployment is given by its
+-- most recent status, and a deployment without statuses is in progress. created_from is inclusive and created_before is
+-- exclusive.
+-- name: Deployments :many
+SELECT
+    *
+FROM
+    deployments
+WHERE
+    (sqlc.narg('team')::text IS NULL OR team = sqlc.narg('team'))
+    AND (sqlc.narg('env')::text IS NULL OR env = sqlc.narg('env'))
+    AND (cardinality(sqlc.arg('teams')::text[]) = 0 OR team = ANY(sqlc.arg('teams')::text[]))
+    AND NOT (team = ANY(sqlc.arg('ignore_teams')::text[]))
+    AND (sqlc.narg('repository')::text IS NULL OR repository = sqlc.narg('repository'))
+    AND (
+        (sqlc.narg('resource_kind')::text IS NULL AND sqlc.narg('resource_name')::text IS NULL)
+        OR EXISTS (
+            SELECT
+                1
+            FROM
+                deployment_resources
+            WHERE
+                deployment_resources.deployment_id = deployments.id
+                AND (sqlc.narg('resource_kind')::text IS NULL OR deployment_resources.kind = sqlc.narg('resource_kind'))
+                AND (sqlc.narg('resource_name')::text IS NULL OR deployment_resources.name = sqlc.narg('resource_name'))
+        )
+    )
+    AND (
+        sqlc.narg('state')::text IS NULL
+        OR sqlc.narg('state')::text = (
+            CASE COALESCE((
+                SELECT
+                    deployment_statuses.status
+                FROM
+                    deployment_statuses
+                WHERE
+                    deployment_statuses.deployment_id = deployments.id
+                ORDER BY
+                    deployment_statuses.created DESC
+                LIMIT 1
+            ), '')
+                WHEN 'success' THEN 'success'
+                WHEN 'failure' THEN 'failure'
+                WHEN 'error' THEN 'failure'
+                ELSE 'in_progress'
+            END
+        )
+    )
+    AND (sqlc.narg('created_from')::timestamptz IS NULL OR created >= sqlc.narg('created_from'))
+    AND (sqlc.narg('created_before')::timestamptz IS NULL OR created < sqlc.narg('created_before'))
+ORDER BY
+    created DESC
+LIMIT
+    sqlc.arg('limit');
+
+-- DeploymentStatusesForDeployments will fetch the statuses of the given deployments.
+-- name: DeploymentStatusesForDeployments :many
+SELECT
+    *
+FROM
+    deployment_statuses
+WHERE
+    deployment_id = ANY(sqlc.arg('deployment_ids')::text[])
+ORDER BY
+    created DESC;
+
+-- DeploymentResourcesForDeployments will fetch the resources of the given deployments.
+-- name: DeploymentResourcesForDeployments :many
+SELECT
+    *
+FROM
+    deployment_resources
+WHERE
+    deployment_id = ANY(sqlc.arg('deployment_ids')::text[])
+ORDER BY
+    deployment_id, kind, name;
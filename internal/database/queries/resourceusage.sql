@@ -134,3 +134,23 @@ WHERE
     AND timestamp >= sqlc.arg('timestamp')::timestamptz - INTERVAL '1 week'
     AND timestamp < sqlc.arg('timestamp')::timestamptz
     AND request > usage;
+
+-- ResourceUtilizationExport will fetch a page of the resource utilization records of a team in a time range,
+-- optionally for a single environment and app, ordered by timestamp and id. The page starts after the given timestamp
+-- and id, so that large ranges can be read in pages.
+-- name: ResourceUtilizationExport :many
+SELECT
+    *
+FROM
+    resource_utilization_metrics
+WHERE
+    team = @team
+    AND (sqlc.narg('env')::text IS NULL OR env = sqlc.narg('env')::text)
+    AND (sqlc.narg('app')::text IS NULL OR app = sqlc.narg('app')::text)
+    AND timestamp >= sqlc.arg('start')::timestamptz
+    AND timestamp < sqlc.arg('end')::timestamptz
+    AND (timestamp, id) > (sqlc.arg('after_timestamp')::timestamptz, sqlc.arg('after_id')::integer)
+ORDER BY
+    timestamp, id ASC
+LIMIT
+    sqlc.arg('page_size');
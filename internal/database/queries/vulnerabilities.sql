@@ -0,0 +1,87 @@
+-- VulnerabilityAnalysisAuditCreate will record an analysis of a vulnerability finding in the audit trail, and return
+-- the ID of the entry.
+-- name: VulnerabilityAnalysisAuditCreate :one
+INSERT INTO vulnerability_analysis_audit (actor, team, env, app, finding_id, state, justification, comment, suppressed)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING id;
+
+-- VulnerabilityAnalysisAuditDelete will remove an entry from the audit trail, for analyses that could not be recorded.
+-- name: VulnerabilityAnalysisAuditDelete :exec
+DELETE FROM vulnerability_analysis_audit
+WHERE id = $1;
+
+-- VulnerabilityIndexUpsert will insert or update the vulnerability summary of a workload in the vulnerability index.
+-- name: VulnerabilityIndexUpsert :exec
+INSERT INTO vulnerability_index (team, env, kind, app, image, has_bom, critical, high, medium, low, unassigned, risk_score)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+ON CONFLICT (team, env, kind, app) DO
+    UPDATE SET
+        image = EXCLUDED.image,
+        has_bom = EXCLUDED.has_bom,
+        critical = EXCLUDED.critical,
+        high = EXCLUDED.high,
+        medium = EXCLUDED.medium,
+        low = EXCLUDED.low,
+        unassigned = EXCLUDED.unassigned,
+        risk_score = EXCLUDED.risk_score,
+        updated_at = NOW();
+
+-- VulnerabilityIndexComponentsDelete will remove all components of a workload from the vulnerability index.
+-- name: VulnerabilityIndexComponentsDelete :exec
+DELETE FROM vulnerability_index_components
+WHERE team = $1 AND env = $2 AND kind = $3 AND app = $4;
+
+-- VulnerabilityIndexComponentsInsert will add components of a workload to the vulnerability index.
+-- name: VulnerabilityIndexComponentsInsert :batchexec
+INSERT INTO vulnerability_index_components (team, env, kind, app, "group", name, version, purl)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- VulnerabilityIndexDeleteStale will remove workloads that have not been updated since the given time from the
+-- vulnerability index.
+-- name: VulnerabilityIndexDeleteStale :exec
+DELETE FROM vulnerability_index
+WHERE updated_at < sqlc.arg('before')::timestamptz;
+
+-- VulnerabilitySummaries will fetch vulnerability summaries from the vulnerability index, aggregated per team and env.
+-- name: VulnerabilitySummaries :many
+SELECT
+    team,
+    env,
+    COUNT(*)::integer AS apps,
+    (COUNT(*) FILTER (WHERE NOT has_bom))::integer AS apps_without_bom,
+    SUM(critical)::integer AS critical,
+    SUM(high)::integer AS high,
+    SUM(medium)::integer AS medium,
+    SUM(low)::integer AS low,
+    SUM(unassigned)::integer AS unassigned,
+    SUM(risk_score)::integer AS risk_score,
+    MAX(updated_at)::timestamptz AS updated_at
+FROM
+    vulnerability_index
+WHERE
+    (sqlc.narg('team')::text IS NULL OR team = sqlc.narg('team')::text)
+    AND (sqlc.narg('env')::text IS NULL OR env = sqlc.narg('env')::text)
+GROUP BY
+    team, env
+ORDER BY
+    team, env ASC;
+
+-- ComponentUsage will fetch components from the vulnerability index by name or package URL. The name matches with or
+-- without the group, and the package URL matches regardless of version.
+-- name: ComponentUsage :many
+SELECT
+    *
+FROM
+    vulnerability_index_components
+WHERE
+    (
+        sqlc.narg('name')::text IS NULL
+        OR LOWER(name) = LOWER(sqlc.narg('name')::text)
+        OR LOWER("group" || '/' || name) = LOWER(sqlc.narg('name')::text)
+    )
+    AND (
+        sqlc.narg('purl')::text IS NULL
+        OR split_part(purl, '@', 1) = split_part(sqlc.narg('purl')::text, '@', 1)
+    )
+ORDER BY
+    team, env, app, kind, "group", name, version ASC;
@@ -0,0 +1,89 @@
+-- VulnerabilitySnapshotUpsert will insert or update the daily vulnerability snapshot of an app.
+-- name: VulnerabilitySnapshotUpsert :exec
+INSERT INTO vulnerability_snapshots (date, team, env, app, critical, high, medium, low, unassigned, risk_score)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+ON CONFLICT (date, team, env, app) DO
+    UPDATE SET
+        critical = EXCLUDED.critical,
+        high = EXCLUDED.high,
+        medium = EXCLUDED.medium,
+        low = EXCLUDED.low,
+        unassigned = EXCLUDED.unassigned,
+        risk_score = EXCLUDED.risk_score;
+
+-- VulnerabilitySnapshotsForApp will fetch the daily vulnerability snapshots of an app in a date range.
+-- name: VulnerabilitySnapshotsForApp :many
+SELECT
+    *
+FROM
+    vulnerability_snapshots
+WHERE
+    team = $1
+    AND env = $2
+    AND app = $3
+    AND date >= sqlc.arg('from_date')::date
+    AND date <= sqlc.arg('to_date')::date
+ORDER BY
+    date ASC;
+
+-- VulnerabilitySnapshotsForTeam will fetch the daily vulnerability snapshots of all apps of a team in a date range.
+-- name: VulnerabilitySnapshotsForTeam :many
+SELECT
+    *
+FROM
+    vulnerability_snapshots
+WHERE
+    team = $1
+    AND date >= sqlc.arg('from_date')::date
+    AND date <= sqlc.arg('to_date')::date
+ORDER BY
+    date, env, app ASC;
+
+-- OpenCriticalFindings will fetch the critical findings of an app that have not been resolved.
+-- name: OpenCriticalFindings :many
+SELECT
+    *
+FROM
+    vulnerability_critical_findings
+WHERE
+    team = $1
+    AND env = $2
+    AND app = $3
+    AND resolved IS NULL;
+
+-- CriticalFindingCreate will record that a critical finding has appeared in an app.
+-- name: CriticalFindingCreate :exec
+INSERT INTO vulnerability_critical_findings (team, env, app, finding, first_seen)
+VALUES ($1, $2, $3, $4, $5);
+
+-- CriticalFindingResolve will record that a critical finding has disappeared from an app.
+-- name: CriticalFindingResolve :exec
+UPDATE vulnerability_critical_findings
+SET resolved = $2
+WHERE id = $1;
+
+-- CriticalFindingsResolveForRemovedApps will resolve the open critical findings of all apps that are not in the given
+-- lists of teams, envs and apps, where the apps are identified by the elements at the same index in each list.
+-- name: CriticalFindingsResolveForRemovedApps :exec
+UPDATE vulnerability_critical_findings
+SET resolved = @resolved
+WHERE
+    resolved IS NULL
+    AND (team, env, app) NOT IN (
+        SELECT unnest(@teams::text[]), unnest(@envs::text[]), unnest(@apps::text[])
+    );
+
+-- CriticalFindingsRemediation will calculate the mean time to remediate critical findings of a team that were resolved
+-- in a date range. The findings can optionally be limited to a single app.
+-- name: CriticalFindingsRemediation :one
+SELECT
+    COUNT(*)::integer AS resolved,
+    COALESCE(AVG(EXTRACT(EPOCH FROM resolved - first_seen)), 0)::float8 AS mean_seconds
+FROM
+    vulnerability_critical_findings
+WHERE
+    team = $1
+    AND (sqlc.narg('env')::text IS NULL OR env = sqlc.narg('env')::text)
+    AND (sqlc.narg('app')::text IS NULL OR app = sqlc.narg('app')::text)
+    AND resolved >= sqlc.arg('from_date')::date
+    AND resolved < sqlc.arg('to_date')::date + 1;
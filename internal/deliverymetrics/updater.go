@@ -0,0 +1,370 @@
+package deliverymetrics
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nais/console-backend/internal/database/gensql"
+	"github.com/nais/console-backend/internal/graph/model"
+	"github.com/nais/console-backend/internal/hookd"
+	"github.com/nais/console-backend/internal/teams"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// deploymentsLimit is the max number of deployments to fetch from hookd on each run
+	deploymentsLimit = 10000
+
+	statusSuccess = "success"
+	statusFailure = "failure"
+	statusError   = "error"
+)
+
+// Deployment is a single completed deployment of an app or naisjob
+type Deployment struct {
+	Team    string
+	App     string
+	Env     string
+	Created time.Time
+
+	// Finished is the time of the final status of the deployment
+	Finished time.Time
+	Failed   bool
+
+	// CommitTime is the time of the commit that was deployed, used to calculate lead time. Nil if the deployment does
+	// not include it.
+	CommitTime *time.Time
+}
+
+// Failure is a period where an app was failing, as observed in Kubernetes. Ended is nil if the app is still failing.
+type Failure struct {
+	Team    string
+	App     string
+	Env     string
+	Started time.Time
+	Ended   *time.Time
+}
+
+// Rollback is an app going back to running a commit it has run before, as observed in Kubernetes
+type Rollback struct {
+	Team     string
+	App      string
+	Env      string
+	Detected time.Time
+}
+
+// AppLister lists the apps of a team, implemented by the k8s client
+type AppLister interface {
+	Apps(ctx context.Context, team string) ([]*model.App, error)
+}
+
+type Updater struct {
+	hookdClient hookd.Client
+	appLister   AppLister
+	teamsClient teams.Client
+	querier     gensql.Querier
+	log         logrus.FieldLogger
+}
+
+// NewUpdater creates a new delivery metrics updater
+func NewUpdater(hookdClient hookd.Client, appLister AppLister, teamsClient teams.Client, querier gensql.Querier, log logrus.FieldLogger) *Updater {
+	return &Updater{
+		hookdClient: hookdClient,
+		appLister:   appLister,
+		teamsClient: teamsClient,
+		querier:     querier,
+		log:         log,
+	}
+}
+
+// UpdateDeliveryMetrics will record the state and commit of all apps, fetch deployments from hookd and store daily
+// aggregates in the database. The oldest day returned by hookd is skipped, as it might only be partially covered by the
+// deployments fetched, and would otherwise overwrite complete aggregates from earlier runs.
+func (u *Updater) UpdateDeliveryMetrics(ctx context.Context) (rowsUpserted int, err error) {
+	if err := u.observeApps(ctx, time.Now()); err != nil {
+		u.log.WithError(err).Errorf("unable to observe the state of all apps")
+	}
+
+	deploys, err := u.hookdClient.Deployments(ctx, hookd.WithLimit(deploymentsLimit))
+	if err != nil {
+		return 0, fmt.Errorf("unable to fetch deployments from hookd: %w", err)
+	}
+
+	deployments := FromHookd(deploys)
+	if len(deployments) == 0 {
+		return 0, nil
+	}
+
+	oldest := truncateToDay(deployments[0].Created)
+	since := pgtype.Timestamptz{Time: oldest, Valid: true}
+
+	failureRows, err := u.querier.AppFailures(ctx, since)
+	if err != nil {
+		return 0, fmt.Errorf("unable to fetch app failures: %w", err)
+	}
+	failures := make([]Failure, 0, len(failureRows))
+	for _, row := range failureRows {
+		f := Failure{Team: row.Team, App: row.App, Env: row.Env, Started: row.Started.Time}
+		if row.Ended.Valid {
+			f.Ended = &row.Ended.Time
+		}
+		failures = append(failures, f)
+	}
+
+	rollbackRows, err := u.querier.AppRollbacks(ctx, since)
+	if err != nil {
+		return 0, fmt.Errorf("unable to fetch app rollbacks: %w", err)
+	}
+	rollbacks := make([]Rollback, 0, len(rollbackRows))
+	for _, row := range rollbackRows {
+		rollbacks = append(rollbacks, Rollback{Team: row.Team, App: row.App, Env: row.Env, Detected: row.Detected.Time})
+	}
+
+	batch := make([]gensql.DeliveryMetricsUpsertParams, 0)
+	for _, params := range Aggregate(deployments, failures, rollbacks) {
+		if params.Date.Time.After(oldest) {
+			batch = append(batch, params)
+		}
+	}
+
+	batchErrors := 0
+	u.querier.DeliveryMetricsUpsert(ctx, batch).Exec(func(i int, err error) {
+		if err != nil {
+			u.log.WithError(err).Errorf("unable to upsert delivery metrics")
+			batchErrors++
+		}
+	})
+
+	return len(batch) - batchErrors, nil
+}
+
+// observeApps records which apps are failing, and which commit each app is running, at the given time. Failures last
+// from the first time an app is observed failing until it is observed not failing. An app observed running a commit it
+// has run before, while another commit was the last one observed, is recorded as a rollback. Apps that can not be
+// observed are logged and skipped.
+func (u *Updater) observeApps(ctx context.Context, now time.Time) error {
+	teams, err := u.teamsClient.GetCachedTeams(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to get teams: %w", err)
+	}
+
+	failed := 0
+	for _, team := range teams {
+		apps, err := u.appLister.Apps(ctx, team.Name)
+		if err != nil {
+			u.log.WithError(err).WithField("team", team.Name).Errorf("unable to list apps")
+			failed++
+			continue
+		}
+
+		for _, app := range apps {
+			if err := u.observeApp(ctx, team.Name, app, now); err != nil {
+				u.log.WithError(err).WithFields(logrus.Fields{"team": team.Name, "env": app.Env.Name, "app": app.Name}).Errorf("unable to observe app")
+				failed++
+			}
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("unable to observe %d teams or apps", failed)
+	}
+	return nil
+}
+
+// observeApp records the state and commit of a single app
+func (u *Updater) observeApp(ctx context.Context, team string, app *model.App, now time.Time) error {
+	ts := pgtype.Timestamptz{Time: now, Valid: true}
+
+	var err error
+	if app.AppState.State == model.StateFailing {
+		err = u.querier.AppFailureStart(ctx, gensql.AppFailureStartParams{Team: team, Env: app.Env.Name, App: app.Name, Started: ts})
+	} else {
+		err = u.querier.AppFailureEnd(ctx, gensql.AppFailureEndParams{Team: team, Env: app.Env.Name, App: app.Name, Ended: ts})
+	}
+	if err != nil {
+		return fmt.Errorf("storing app state: %w", err)
+	}
+
+	sha := app.DeployInfo.CommitSha
+	if sha == "" {
+		return nil
+	}
+
+	commits, err := u.querier.AppCommits(ctx, gensql.AppCommitsParams{Team: team, Env: app.Env.Name, App: app.Name})
+	if err != nil {
+		return fmt.Errorf("getting app commits: %w", err)
+	}
+
+	if len(commits) > 0 && commits[0] != sha && slices.Contains(commits, sha) {
+		err := u.querier.AppRollbackInsert(ctx, gensql.AppRollbackInsertParams{
+			Team:      team,
+			Env:       app.Env.Name,
+			App:       app.Name,
+			CommitSha: sha,
+			Detected:  ts,
+		})
+		if err != nil {
+			return fmt.Errorf("storing app rollback: %w", err)
+		}
+	}
+
+	err = u.querier.AppCommitUpsert(ctx, gensql.AppCommitUpsertParams{
+		Team:      team,
+		Env:       app.Env.Name,
+		App:       app.Name,
+		CommitSha: sha,
+		LastSeen:  ts,
+	})
+	if err != nil {
+		return fmt.Errorf("storing app commit: %w", err)
+	}
+
+	return nil
+}
+
+// FromHookd converts hookd deploys to deployments, one for each app or naisjob in the deploy. Deploys that have not
+// finished yet are skipped. The returned deployments are sorted by creation time, oldest first.
+func FromHookd(deploys []hookd.Deploy) []Deployment {
+	deployments := make([]Deployment, 0)
+	for _, deploy := range deploys {
+		status, ok := finalStatus(deploy.Statuses)
+		if !ok {
+			continue
+		}
+
+		for _, resource := range deploy.Resources {
+			if resource.Kind != "Application" && resource.Kind != "Naisjob" {
+				continue
+			}
+
+			deployments = append(deployments, Deployment{
+				Team:       deploy.DeploymentInfo.Team,
+				App:        resource.Name,
+				Env:        deploy.DeploymentInfo.Cluster,
+				Created:    deploy.DeploymentInfo.Created,
+				Finished:   status.Created,
+				Failed:     status.Status != statusSuccess,
+				CommitTime: deploy.DeploymentInfo.CommitTimestamp,
+			})
+		}
+	}
+
+	sort.SliceStable(deployments, func(i, j int) bool {
+		return deployments[i].Created.Before(deployments[j].Created)
+	})
+
+	return deployments
+}
+
+// Aggregate calculates daily delivery metrics per app from a list of deployments sorted by creation time, oldest
+// first. Rollbacks are counted on the day they were detected. Time to restore is the time from an app started failing
+// until the first successful deployment of the app while it was failing, and is counted on the day of the deployment.
+// Failures that ended without a successful deployment are not counted. Lead time is the time from commit until the
+// successful deployment of the commit, for deployments with a commit time.
+func Aggregate(deployments []Deployment, failures []Failure, rollbacks []Rollback) []gensql.DeliveryMetricsUpsertParams {
+	type key struct {
+		date           time.Time
+		team, app, env string
+	}
+	type workload struct {
+		team, app, env string
+	}
+
+	metrics := make(map[key]*gensql.DeliveryMetricsUpsertParams)
+	metricsFor := func(t time.Time, team, app, env string) *gensql.DeliveryMetricsUpsertParams {
+		k := key{date: truncateToDay(t), team: team, app: app, env: env}
+		m, exists := metrics[k]
+		if !exists {
+			m = &gensql.DeliveryMetricsUpsertParams{
+				Date: pgtype.Date{Time: k.date, Valid: true},
+				Env:  env,
+				Team: team,
+				App:  app,
+			}
+			metrics[k] = m
+		}
+		return m
+	}
+
+	failuresFor := make(map[workload][]Failure)
+	for _, f := range failures {
+		w := workload{team: f.Team, app: f.App, env: f.Env}
+		failuresFor[w] = append(failuresFor[w], f)
+	}
+
+	for _, d := range deployments {
+		m := metricsFor(d.Finished, d.Team, d.App, d.Env)
+		m.Deployments++
+		if d.Failed {
+			m.FailedDeployments++
+			continue
+		}
+
+		if d.CommitTime != nil {
+			m.LeadTimes++
+			m.LeadTimeSeconds += int64(d.Finished.Sub(*d.CommitTime).Seconds())
+		}
+
+		w := workload{team: d.Team, app: d.App, env: d.Env}
+		remaining := make([]Failure, 0, len(failuresFor[w]))
+		for _, f := range failuresFor[w] {
+			if d.Finished.Before(f.Started) || (f.Ended != nil && d.Finished.After(*f.Ended)) {
+				remaining = append(remaining, f)
+				continue
+			}
+			m.Restores++
+			m.RestoreTimeSeconds += int64(d.Finished.Sub(f.Started).Seconds())
+		}
+		failuresFor[w] = remaining
+	}
+
+	for _, r := range rollbacks {
+		metricsFor(r.Detected, r.Team, r.App, r.Env).Rollbacks++
+	}
+
+	ret := make([]gensql.DeliveryMetricsUpsertParams, 0, len(metrics))
+	for _, m := range metrics {
+		ret = append(ret, *m)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		if !ret[i].Date.Time.Equal(ret[j].Date.Time) {
+			return ret[i].Date.Time.Before(ret[j].Date.Time)
+		}
+		if ret[i].Team != ret[j].Team {
+			return ret[i].Team < ret[j].Team
+		}
+		if ret[i].Env != ret[j].Env {
+			return ret[i].Env < ret[j].Env
+		}
+		return ret[i].App < ret[j].App
+	})
+
+	return ret
+}
+
+// finalStatus returns the most recent status of a deploy, if the deploy has finished
+func finalStatus(statuses []hookd.Status) (hookd.Status, bool) {
+	var final hookd.Status
+	found := false
+	for _, status := range statuses {
+		switch status.Status {
+		case statusSuccess, statusFailure, statusError:
+			if !found || status.Created.After(final.Created) {
+				final = status
+				found = true
+			}
+		}
+	}
+	return final, found
+}
+
+// truncateToDay returns the start of the day of t, in UTC
+func truncateToDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,219 @@
+package deliverymetrics_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nais/console-backend/internal/database/gensql"
+	"github.com/nais/console-backend/internal/deliverymetrics"
+	"github.com/nais/console-backend/internal/graph/model"
+	"github.com/nais/console-backend/internal/hookd"
+	"github.com/nais/console-backend/internal/teams"
+	logrustest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type fakeAppLister map[string][]*model.App
+
+func (f fakeAppLister) Apps(_ context.Context, team string) ([]*model.App, error) {
+	return f[team], nil
+}
+
+func Test_updater_UpdateDeliveryMetrics(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("error when fetching deployments from hookd", func(t *testing.T) {
+		teamsClient := teams.NewMockClient(t)
+		teamsClient.EXPECT().GetCachedTeams(ctx).Return([]*model.Team{}, nil)
+		hookdClient := hookd.NewMockClient(t)
+		hookdClient.EXPECT().Deployments(ctx, mock.AnythingOfType("hookd.RequestOption")).Return(nil, assert.AnError)
+		log, _ := logrustest.NewNullLogger()
+		rowsUpserted, err := deliverymetrics.NewUpdater(hookdClient, fakeAppLister{}, teamsClient, nil, log).UpdateDeliveryMetrics(ctx)
+		assert.Equal(t, 0, rowsUpserted)
+		assert.ErrorContains(t, err, "unable to fetch deployments from hookd")
+	})
+
+	t.Run("no deployments", func(t *testing.T) {
+		teamsClient := teams.NewMockClient(t)
+		teamsClient.EXPECT().GetCachedTeams(ctx).Return([]*model.Team{}, nil)
+		hookdClient := hookd.NewMockClient(t)
+		hookdClient.EXPECT().Deployments(ctx, mock.AnythingOfType("hookd.RequestOption")).Return([]hookd.Deploy{}, nil)
+		log, _ := logrustest.NewNullLogger()
+		rowsUpserted, err := deliverymetrics.NewUpdater(hookdClient, fakeAppLister{}, teamsClient, nil, log).UpdateDeliveryMetrics(ctx)
+		assert.Equal(t, 0, rowsUpserted)
+		assert.NoError(t, err)
+	})
+
+	t.Run("app state and commits observed", func(t *testing.T) {
+		teamsClient := teams.NewMockClient(t)
+		teamsClient.EXPECT().GetCachedTeams(ctx).Return([]*model.Team{{Name: "team-a"}}, nil)
+
+		apps := fakeAppLister{
+			"team-a": {
+				{Name: "failing", Env: model.Env{Name: "dev"}, AppState: model.AppState{State: model.StateFailing}},
+				{Name: "rolled-back", Env: model.Env{Name: "dev"}, AppState: model.AppState{State: model.StateNais}, DeployInfo: model.DeployInfo{CommitSha: "sha-1"}},
+			},
+		}
+
+		querier := gensql.NewMockQuerier(t)
+		querier.EXPECT().
+			AppFailureStart(ctx, mock.MatchedBy(func(arg gensql.AppFailureStartParams) bool {
+				return arg.Team == "team-a" && arg.Env == "dev" && arg.App == "failing"
+			})).
+			Return(nil)
+		querier.EXPECT().
+			AppFailureEnd(ctx, mock.MatchedBy(func(arg gensql.AppFailureEndParams) bool {
+				return arg.App == "rolled-back"
+			})).
+			Return(nil)
+		querier.EXPECT().
+			AppCommits(ctx, gensql.AppCommitsParams{Team: "team-a", Env: "dev", App: "rolled-back"}).
+			Return([]string{"sha-2", "sha-1"}, nil)
+		querier.EXPECT().
+			AppRollbackInsert(ctx, mock.MatchedBy(func(arg gensql.AppRollbackInsertParams) bool {
+				return arg.App == "rolled-back" && arg.CommitSha == "sha-1"
+			})).
+			Return(nil)
+		querier.EXPECT().
+			AppCommitUpsert(ctx, mock.MatchedBy(func(arg gensql.AppCommitUpsertParams) bool {
+				return arg.App == "rolled-back" && arg.CommitSha == "sha-1"
+			})).
+			Return(nil)
+
+		hookdClient := hookd.NewMockClient(t)
+		hookdClient.EXPECT().Deployments(ctx, mock.AnythingOfType("hookd.RequestOption")).Return([]hookd.Deploy{}, nil)
+		log, _ := logrustest.NewNullLogger()
+		rowsUpserted, err := deliverymetrics.NewUpdater(hookdClient, apps, teamsClient, querier, log).UpdateDeliveryMetrics(ctx)
+		assert.Equal(t, 0, rowsUpserted)
+		assert.NoError(t, err)
+	})
+
+	t.Run("error when fetching app failures", func(t *testing.T) {
+		created := time.Now().Add(-time.Hour)
+		teamsClient := teams.NewMockClient(t)
+		teamsClient.EXPECT().GetCachedTeams(ctx).Return([]*model.Team{}, nil)
+
+		querier := gensql.NewMockQuerier(t)
+		querier.EXPECT().
+			AppFailures(ctx, pgtype.Timestamptz{Time: created.UTC().Truncate(24 * time.Hour), Valid: true}).
+			Return(nil, assert.AnError)
+
+		hookdClient := hookd.NewMockClient(t)
+		hookdClient.EXPECT().Deployments(ctx, mock.AnythingOfType("hookd.RequestOption")).Return([]hookd.Deploy{
+			{
+				DeploymentInfo: hookd.DeploymentInfo{Team: "team-a", Cluster: "dev", Created: created},
+				Statuses:       []hookd.Status{{Status: "success", Created: created.Add(time.Minute)}},
+				Resources:      []hookd.Resource{{Kind: "Application", Name: "app"}},
+			},
+		}, nil)
+		log, _ := logrustest.NewNullLogger()
+		rowsUpserted, err := deliverymetrics.NewUpdater(hookdClient, fakeAppLister{}, teamsClient, querier, log).UpdateDeliveryMetrics(ctx)
+		assert.Equal(t, 0, rowsUpserted)
+		assert.ErrorContains(t, err, "unable to fetch app failures")
+	})
+}
+
+func TestFromHookd(t *testing.T) {
+	created := time.Date(2023, time.November, 1, 10, 0, 0, 0, time.UTC)
+	committed := created.Add(-time.Hour)
+	deploys := []hookd.Deploy{
+		{
+			DeploymentInfo: hookd.DeploymentInfo{Team: "team", Cluster: "dev", Created: created.Add(time.Hour)},
+			Statuses: []hookd.Status{
+				{Status: "in_progress", Created: created.Add(time.Hour)},
+			},
+			Resources: []hookd.Resource{{Kind: "Application", Name: "unfinished"}},
+		},
+		{
+			DeploymentInfo: hookd.DeploymentInfo{Team: "team", Cluster: "dev", Created: created, CommitTimestamp: &committed},
+			Statuses: []hookd.Status{
+				{Status: "in_progress", Created: created.Add(time.Minute)},
+				{Status: "failure", Created: created.Add(3 * time.Minute)},
+				{Status: "success", Created: created.Add(2 * time.Minute)},
+			},
+			Resources: []hookd.Resource{
+				{Kind: "Application", Name: "app"},
+				{Kind: "Naisjob", Name: "job"},
+				{Kind: "Topic", Name: "topic"},
+			},
+		},
+	}
+
+	deployments := deliverymetrics.FromHookd(deploys)
+	assert.Len(t, deployments, 2)
+	assert.Equal(t, "app", deployments[0].App)
+	assert.Equal(t, "job", deployments[1].App)
+	for _, d := range deployments {
+		assert.True(t, d.Failed)
+		assert.Equal(t, created.Add(3*time.Minute), d.Finished)
+		assert.Equal(t, &committed, d.CommitTime)
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	day1 := time.Date(2023, time.November, 1, 10, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 1)
+	restored := day2.Add(-time.Hour)
+
+	deployment := func(app string, finished time.Time, failed bool) deliverymetrics.Deployment {
+		return deliverymetrics.Deployment{Team: "team", App: app, Env: "dev", Created: finished, Finished: finished, Failed: failed}
+	}
+
+	deployments := []deliverymetrics.Deployment{
+		deployment("app-1", day1, false),
+		deployment("app-1", day1.Add(time.Hour), true),
+		deployment("app-2", day1.Add(time.Hour), false),
+		deployment("app-1", day1.Add(2*time.Hour), true),
+		deployment("app-2", day1.Add(3*time.Hour), false),
+		deployment("app-1", day2, false),
+	}
+
+	// lead time is only counted for successful deployments
+	committed := day1.Add(90 * time.Minute)
+	deployments[3].CommitTime = &committed
+	deployments[4].CommitTime = &committed
+
+	failures := []deliverymetrics.Failure{
+		// restored by the last deployment of app-1
+		{Team: "team", App: "app-1", Env: "dev", Started: day1.Add(30 * time.Minute)},
+		// ended before the next deployment of app-2
+		{Team: "team", App: "app-2", Env: "dev", Started: day1.Add(time.Minute), Ended: &day1},
+		// restored by the second deployment of app-2
+		{Team: "team", App: "app-2", Env: "dev", Started: day1.Add(2 * time.Hour), Ended: &restored},
+	}
+
+	rollbacks := []deliverymetrics.Rollback{
+		{Team: "team", App: "app-1", Env: "dev", Detected: day2.Add(time.Hour)},
+	}
+
+	metrics := deliverymetrics.Aggregate(deployments, failures, rollbacks)
+	assert.Len(t, metrics, 3)
+
+	assert.Equal(t, gensql.DeliveryMetricsUpsertParams{
+		Date:              metrics[0].Date,
+		Env:               "dev",
+		Team:              "team",
+		App:               "app-1",
+		Deployments:       3,
+		FailedDeployments: 2,
+	}, metrics[0])
+	assert.Equal(t, time.Date(2023, time.November, 1, 0, 0, 0, 0, time.UTC), metrics[0].Date.Time)
+
+	assert.Equal(t, "app-2", metrics[1].App)
+	assert.Equal(t, int32(2), metrics[1].Deployments)
+	assert.Equal(t, int32(0), metrics[1].FailedDeployments)
+	assert.Equal(t, int32(1), metrics[1].Restores)
+	assert.Equal(t, int64(60*60), metrics[1].RestoreTimeSeconds)
+	assert.Equal(t, int32(1), metrics[1].LeadTimes)
+	assert.Equal(t, int64(90*60), metrics[1].LeadTimeSeconds)
+
+	assert.Equal(t, "app-1", metrics[2].App)
+	assert.Equal(t, time.Date(2023, time.November, 2, 0, 0, 0, 0, time.UTC), metrics[2].Date.Time)
+	assert.Equal(t, int32(1), metrics[2].Deployments)
+	assert.Equal(t, int32(1), metrics[2].Rollbacks)
+	assert.Equal(t, int32(1), metrics[2].Restores)
+	assert.Equal(t, int64(23*60*60+30*60), metrics[2].RestoreTimeSeconds)
+}
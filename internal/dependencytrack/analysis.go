@@ -0,0 +1,75 @@
+package dependencytrack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/nais/console-backend/internal/upstream"
+)
+
+// Analysis is an analysis decision for a finding, as recorded in DependencyTrack
+type Analysis struct {
+	// State is the analysis state, for instance FALSE_POSITIVE or NOT_AFFECTED
+	State string
+
+	// Justification is the justification of the state, for instance CODE_NOT_REACHABLE. Empty means not set.
+	Justification string
+
+	// Comment is added to the audit trail of the finding in DependencyTrack. Empty means no comment.
+	Comment string
+
+	// Suppressed will suppress or unsuppress the finding. Nil leaves the suppression unchanged.
+	Suppressed *bool
+}
+
+type analysisRequest struct {
+	Project       string `json:"project"`
+	Component     string `json:"component"`
+	Vulnerability string `json:"vulnerability"`
+	State         string `json:"analysisState"`
+	Justification string `json:"analysisJustification,omitempty"`
+	Comment       string `json:"comment,omitempty"`
+	Suppressed    *bool  `json:"isSuppressed,omitempty"`
+}
+
+// ParseFindingID splits the ID of a finding into the UUIDs of the project, component and vulnerability
+func ParseFindingID(id string) (projectUuid, componentUuid, vulnerabilityUuid string, err error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("invalid finding ID %q", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// RecordAnalysis records an analysis of a finding of an app instance in DependencyTrack. The cached vulnerabilities of
+// the app instance are invalidated, so that the analysis is reflected in findings and summaries.
+func (c *Client) RecordAnalysis(ctx context.Context, app *AppInstance, findingID string, analysis Analysis) error {
+	projectUuid, componentUuid, vulnerabilityUuid, err := ParseFindingID(findingID)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(analysisRequest{
+		Project:       projectUuid,
+		Component:     componentUuid,
+		Vulnerability: vulnerabilityUuid,
+		State:         analysis.State,
+		Justification: analysis.Justification,
+		Comment:       analysis.Comment,
+		Suppressed:    analysis.Suppressed,
+	})
+	if err != nil {
+		return fmt.Errorf("encoding analysis: %w", err)
+	}
+
+	// recording the same analysis twice has the same effect, so the request can safely be retried
+	if err := c.callAPI(upstream.WithIdempotent(ctx), http.MethodPut, "/api/v1/analysis", body, nil); err != nil {
+		return fmt.Errorf("recording analysis in DependencyTrack: %w", err)
+	}
+
+	c.cache.Delete(app.ID())
+	return nil
+}
@@ -0,0 +1,60 @@
+package dependencytrack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// callAPI calls the DependencyTrack REST API directly, for endpoints that are not available in the DependencyTrack
+// library. Requests are authenticated with the headers of the library client, which handles logging in and renewing
+// credentials, and sent through the same upstream as the library calls. The body, if any, is sent as JSON, and a
+// successful response is decoded into result, if not nil.
+func (c *Client) callAPI(ctx context.Context, method, path string, body []byte, result any) error {
+	headers, err := c.client.Headers(ctx)
+	if err != nil {
+		return fmt.Errorf("getting DependencyTrack credentials: %w", err)
+	}
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.endpoint+path, reader)
+	if err != nil {
+		return fmt.Errorf("creating request for DependencyTrack: %w", err)
+	}
+	for key, values := range headers {
+		req.Header[key] = values
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.upstream.Do(req)
+	if err != nil {
+		return fmt.Errorf("calling DependencyTrack: %w", err)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			c.log.WithError(err).Error("closing response body")
+		}
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("DependencyTrack API returned %s", resp.Status)
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("decoding response from DependencyTrack: %w", err)
+	}
+	return nil
+}
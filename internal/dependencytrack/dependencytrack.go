@@ -2,7 +2,9 @@ package dependencytrack
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
 	"sync"
@@ -11,31 +13,93 @@ import (
 	"github.com/nais/console-backend/internal/config"
 	"github.com/nais/console-backend/internal/graph/model"
 	"github.com/nais/console-backend/internal/graph/scalar"
+	"github.com/nais/console-backend/internal/upstream"
 	dependencytrack "github.com/nais/dependencytrack/pkg/client"
 	"github.com/patrickmn/go-cache"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/metric"
+	"golang.org/x/sync/singleflight"
 )
 
+const (
+	// maxConcurrentFetches is the max number of app instances to fetch vulnerabilities for concurrently
+	maxConcurrentFetches = 10
+
+	// defaultStaleAfter is how long to wait for DependencyTrack before serving stale vulnerabilities
+	defaultStaleAfter = 5 * time.Second
+
+	// staleCacheTTL is how long stale vulnerabilities are kept
+	staleCacheTTL = 24 * time.Hour
+)
+
+// AppInstance is a running workload, an app or a naisjob. App is the name of the workload.
 type AppInstance struct {
 	Env, Team, App, Image string
+
+	// Kind is the kind of workload. Empty means an app.
+	Kind model.WorkloadType
 }
 
 func (a *AppInstance) ID() string {
+	if a.Kind == model.WorkloadTypeNaisjob {
+		// an app and a naisjob may share name and image, but not their vulnerabilities node
+		return fmt.Sprintf("%s:%s:naisjob:%s:%s", a.Env, a.Team, a.App, a.Image)
+	}
 	return fmt.Sprintf("%s:%s:%s:%s", a.Env, a.Team, a.App, a.Image)
 }
 
+// WorkloadType returns the kind of workload, defaulting to an app
+func (a *AppInstance) WorkloadType() model.WorkloadType {
+	if a.Kind == "" {
+		return model.WorkloadTypeApp
+	}
+	return a.Kind
+}
+
 func (a *AppInstance) ProjectName() string {
 	return fmt.Sprintf("%s:%s:%s", a.Env, a.Team, a.App)
 }
 
 type Client struct {
 	client      dependencytrack.Client
+	upstream    *upstream.Client
+	endpoint    string
 	frontendUrl string
 	log         logrus.FieldLogger
 	cache       *cache.Cache
+
+	// violations holds the policy violations of each app instance
+	violations *cache.Cache
+
+	// stale holds the last successfully fetched vulnerabilities of each app instance, served when DependencyTrack is
+	// slow or failing
+	stale *cache.Cache
+
+	// staleAfter is how long to wait for DependencyTrack before serving stale vulnerabilities, if there are any
+	staleAfter time.Duration
+
+	// refreshes holds a slot for each fetch still running after stale vulnerabilities have been served, so that there
+	// are at most maxConcurrentFetches of them
+	refreshes chan struct{}
+
+	// refreshGroup deduplicates the fetches of each app instance that may outlive the request
+	refreshGroup singleflight.Group
+
+	riskModel riskModel
+}
+
+type Option func(*Client)
+
+// WithMeter will register a gauge reporting the circuit breaker state of DependencyTrack with the given meter
+func WithMeter(meter metric.Meter) Option {
+	return func(c *Client) {
+		if err := c.upstream.RegisterMetrics(meter); err != nil {
+			c.log.WithError(err).Error("register dependencytrack upstream metrics")
+		}
+	}
 }
 
-func New(cfg config.DependencyTrack, log *logrus.Entry) *Client {
+func New(cfg config.DependencyTrack, log *logrus.Entry, opts ...Option) *Client {
 	c := dependencytrack.New(
 		cfg.Endpoint,
 		cfg.Username,
@@ -46,12 +110,29 @@ func New(cfg config.DependencyTrack, log *logrus.Entry) *Client {
 
 	ch := cache.New(5*time.Minute, 10*time.Minute)
 
-	return &Client{
-		client:      c,
+	client := &Client{
+		client: c,
+		upstream: upstream.New(upstream.Config{
+			Name:       "dependencytrack",
+			Timeout:    cfg.Timeout,
+			MaxRetries: cfg.MaxRetries,
+		}),
+		endpoint:    strings.TrimSuffix(cfg.Endpoint, "/"),
 		frontendUrl: cfg.Frontend,
 		log:         log,
 		cache:       ch,
+		violations:  cache.New(5*time.Minute, 10*time.Minute),
+		stale:       cache.New(staleCacheTTL, time.Hour),
+		staleAfter:  defaultStaleAfter,
+		refreshes:   make(chan struct{}, maxConcurrentFetches),
+		riskModel:   newRiskModel(cfg),
 	}
+
+	for _, opt := range opts {
+		opt(client)
+	}
+
+	return client
 }
 
 func (c *Client) Init(ctx context.Context) error {
@@ -67,46 +148,188 @@ func (c *Client) WithClient(client dependencytrack.Client) *Client {
 	return c
 }
 
+// appVulnerabilities is the vulnerability data of an app instance, as stored in the cache
+type appVulnerabilities struct {
+	node        *model.VulnerabilitiesNode
+	findings    []*model.VulnerabilityFinding
+	projectUuid string
+}
+
 func (c *Client) VulnerabilitySummary(ctx context.Context, app *AppInstance) (*model.VulnerabilitiesNode, error) {
 	return c.findingsForApp(ctx, app)
 }
 
-func (c *Client) GetVulnerabilities(ctx context.Context, apps []*AppInstance) ([]*model.VulnerabilitiesNode, error) {
-	var wg sync.WaitGroup
+// VulnerabilityFindings returns all findings for an app instance, in the order returned by DependencyTrack
+func (c *Client) VulnerabilityFindings(ctx context.Context, app *AppInstance) ([]*model.VulnerabilityFinding, error) {
+	v, err := c.vulnerabilitiesForApp(ctx, app)
+	if err != nil {
+		return nil, err
+	}
+	return v.findings, nil
+}
+
+// Components returns all components of an app instance. Apps without a project or a BOM in DependencyTrack have no
+// components.
+func (c *Client) Components(ctx context.Context, app *AppInstance) ([]Component, error) {
+	v, err := c.vulnerabilitiesForApp(ctx, app)
+	if err != nil {
+		return nil, err
+	}
+	if v.projectUuid == "" {
+		return nil, nil
+	}
+
+	var components []Component
+	path := "/api/v1/component/project/" + url.PathEscape(v.projectUuid)
+	if err := c.callAPI(ctx, http.MethodGet, path, nil, &components); err != nil {
+		return nil, fmt.Errorf("getting components from DependencyTrack: %w", err)
+	}
+	return components, nil
+}
+
+// ErrSlow is the error of app instances where stale vulnerabilities are served, because DependencyTrack did not respond
+// in time
+var ErrSlow = errors.New("DependencyTrack did not respond in time")
+
+// AppVulnerabilities is the result of fetching the vulnerabilities of a single app instance
+type AppVulnerabilities struct {
+	App *AppInstance
+
+	// Node is nil if the vulnerabilities could not be fetched, and there were no stale vulnerabilities to serve
+	Node *model.VulnerabilitiesNode
+
+	// Stale is true if Node is served from the stale cache, because DependencyTrack was slow or failed
+	Stale bool
+
+	// Err is the reason the vulnerabilities could not be fetched, if any
+	Err error
+}
+
+// FetchVulnerabilities fetches the vulnerabilities of the app instances using a bounded number of workers. The results
+// are in the same order as the app instances, and each result carries its own error.
+func (c *Client) FetchVulnerabilities(ctx context.Context, apps []*AppInstance) []*AppVulnerabilities {
 	now := time.Now()
+	results := make([]*AppVulnerabilities, len(apps))
 
-	nodes := make([]*model.VulnerabilitiesNode, 0)
-	for _, a := range apps {
+	// each call only writes the result of its own index, so no locking is needed
+	forEachConcurrently(len(apps), func(i int) {
+		results[i] = c.fetchVulnerabilities(ctx, apps[i])
+	})
+
+	c.log.Debugf("DependencyTrack fetch: %v\n", time.Since(now))
+	return results
+}
+
+// forEachConcurrently calls fn for each index from 0 to n, using at most maxConcurrentFetches workers, and returns when
+// all calls have returned
+func forEachConcurrently(n int, fn func(i int)) {
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < min(maxConcurrentFetches, n); w++ {
 		wg.Add(1)
-		go func(app *AppInstance) {
+		go func() {
 			defer wg.Done()
-			v, err := c.findingsForApp(ctx, app)
-			if err != nil {
-				c.log.Errorf("retrieveFindings for app %q: %v", app.ID(), err)
-				return
-			}
-			if v == nil {
-				c.log.Debugf("no findings found in DependencyTrack for app %q", app.ID())
-				return
+			for i := range indexes {
+				fn(i)
 			}
-			nodes = append(nodes, v)
-		}(a)
+		}()
 	}
+
+	for i := 0; i < n; i++ {
+		indexes <- i
+	}
+	close(indexes)
 	wg.Wait()
+}
 
-	c.log.Debugf("DependencyTrack fetch: %v\n", time.Since(now))
+// GetVulnerabilities returns the vulnerabilities of the app instances, in the same order as the app instances. App
+// instances where the vulnerabilities could not be fetched are left out, and an error is only returned if there are no
+// vulnerabilities to return at all. Use FetchVulnerabilities to get the errors of each app instance.
+func (c *Client) GetVulnerabilities(ctx context.Context, apps []*AppInstance) ([]*model.VulnerabilitiesNode, error) {
+	nodes := make([]*model.VulnerabilitiesNode, 0)
+	var err error
+	for _, result := range c.FetchVulnerabilities(ctx, apps) {
+		if result.Node == nil {
+			err = result.Err
+			continue
+		}
+		nodes = append(nodes, result.Node)
+	}
+
+	if len(nodes) == 0 && err != nil {
+		return nil, err
+	}
 	return nodes, nil
 }
 
+// fetchVulnerabilities fetches the vulnerabilities of an app instance. If DependencyTrack fails, or does not respond
+// within staleAfter, stale vulnerabilities are served if there are any.
+func (c *Client) fetchVulnerabilities(ctx context.Context, app *AppInstance) *AppVulnerabilities {
+	ret := &AppVulnerabilities{App: app}
+
+	_, cached := c.cache.Get(app.ID())
+	stale, hasStale := c.stale.Get(app.ID())
+	if cached || !hasStale {
+		v, err := c.vulnerabilitiesForApp(ctx, app)
+		if err != nil {
+			c.log.WithError(err).Errorf("fetching vulnerabilities for app %q", app.ID())
+			ret.Err = err
+			return ret
+		}
+		ret.Node = v.node
+		return ret
+	}
+
+	// the fetch is detached from the request, so that it can complete and refresh the cache after stale
+	// vulnerabilities have been served. It is bounded by the upstream timeout, holds a refresh slot while running, and
+	// is shared by all requests for the app instance.
+	done := c.refreshGroup.DoChan(app.ID(), func() (any, error) {
+		c.refreshes <- struct{}{}
+		defer func() { <-c.refreshes }()
+		return c.vulnerabilitiesForApp(context.WithoutCancel(ctx), app)
+	})
+
+	timer := time.NewTimer(c.staleAfter)
+	defer timer.Stop()
+
+	select {
+	case r := <-done:
+		if r.Err == nil {
+			ret.Node = r.Val.(*appVulnerabilities).node
+			return ret
+		}
+		ret.Err = r.Err
+	case <-timer.C:
+		ret.Err = ErrSlow
+	case <-ctx.Done():
+		ret.Err = ctx.Err()
+	}
+
+	c.log.WithError(ret.Err).Warnf("serving stale vulnerabilities for app %q", app.ID())
+	ret.Node = stale.(*appVulnerabilities).node
+	ret.Stale = true
+	return ret
+}
+
 func (c *Client) findingsForApp(ctx context.Context, app *AppInstance) (*model.VulnerabilitiesNode, error) {
+	v, err := c.vulnerabilitiesForApp(ctx, app)
+	if err != nil {
+		return nil, err
+	}
+	return v.node, nil
+}
+
+func (c *Client) vulnerabilitiesForApp(ctx context.Context, app *AppInstance) (*appVulnerabilities, error) {
 	if v, ok := c.cache.Get(app.ID()); ok {
-		return v.(*model.VulnerabilitiesNode), nil
+		return v.(*appVulnerabilities), nil
 	}
 
 	v := &model.VulnerabilitiesNode{
-		ID:      scalar.VulnerabilitiesIdent(app.ID()),
-		AppName: app.App,
-		Env:     app.Env,
+		ID:           scalar.VulnerabilitiesIdent(app.ID()),
+		AppName:      app.App,
+		Env:          app.Env,
+		WorkloadType: app.WorkloadType(),
 	}
 
 	p, err := c.retrieveProject(ctx, app)
@@ -114,7 +337,7 @@ func (c *Client) findingsForApp(ctx context.Context, app *AppInstance) (*model.V
 		return nil, fmt.Errorf("getting project by app %s: %w", app.ID(), err)
 	}
 	if p == nil {
-		return v, nil
+		return &appVulnerabilities{node: v}, nil
 	}
 
 	u := strings.TrimSuffix(c.frontendUrl, "/")
@@ -125,32 +348,97 @@ func (c *Client) findingsForApp(ctx context.Context, app *AppInstance) (*model.V
 
 	if !v.HasBom {
 		c.log.Debugf("no bom found in DependencyTrack for project %s", p.Name)
-		v.Summary = c.createSummary([]*dependencytrack.Finding{}, v.HasBom)
-		c.cache.Set(app.ID(), v, cache.DefaultExpiration)
-		return v, nil
+		v.Summary = c.createSummary([]*dependencytrack.Finding{}, nil, v.HasBom)
+		ret := &appVulnerabilities{node: v}
+		c.cache.Set(app.ID(), ret, cache.DefaultExpiration)
+		c.stale.Set(app.ID(), ret, cache.DefaultExpiration)
+		return ret, nil
 	}
 
-	f, err := c.retrieveFindings(ctx, p.Uuid)
+	f, metadata, err := c.retrieveFindings(ctx, p.Uuid)
 	if err != nil {
 		return nil, err
 	}
 
-	v.Summary = c.createSummary(f, v.HasBom)
+	v.Summary = c.createSummary(f, metadata, v.HasBom)
 
-	c.cache.Set(app.ID(), v, cache.DefaultExpiration)
-	return v, nil
+	ret := &appVulnerabilities{
+		node:        v,
+		findings:    toModelFindings(p.Uuid, f),
+		projectUuid: p.Uuid,
+	}
+	c.cache.Set(app.ID(), ret, cache.DefaultExpiration)
+	c.stale.Set(app.ID(), ret, cache.DefaultExpiration)
+	return ret, nil
 }
 
-func (c *Client) retrieveFindings(ctx context.Context, uuid string) ([]*dependencytrack.Finding, error) {
-	findings, err := c.client.GetFindings(ctx, uuid)
-	if err != nil {
-		return nil, fmt.Errorf("retrieveFindings from DependencyTrack: %w", err)
+// toModelFindings converts findings from DependencyTrack to the GraphQL model
+func toModelFindings(projectUuid string, findings []*dependencytrack.Finding) []*model.VulnerabilityFinding {
+	ret := make([]*model.VulnerabilityFinding, 0, len(findings))
+	for _, f := range findings {
+		name := f.Component.Name
+		if f.Component.Group != "" {
+			name = f.Component.Group + "/" + f.Component.Name
+		}
+
+		severity := model.VulnerabilitySeverity(f.Vulnerability.Severity)
+		if !severity.IsValid() {
+			severity = model.VulnerabilitySeverityUnassigned
+		}
+
+		finding := &model.VulnerabilityFinding{
+			ID:              scalar.VulnerabilityFindingIdent(FindingID(projectUuid, f.Component.Uuid, f.Vulnerability.Uuid)),
+			VulnerabilityID: f.Vulnerability.VulnId,
+			Source:          f.Vulnerability.Source,
+			Severity:        severity,
+			Component: model.VulnerableComponent{
+				Name:    name,
+				Version: f.Component.Version,
+				Purl:    f.Component.Purl,
+			},
+			AnalysisState: f.Analysis.State,
+			Suppressed:    f.Analysis.IsSuppressed,
+		}
+
+		if finding.AnalysisState == "" {
+			finding.AnalysisState = "NOT_SET"
+		}
+
+		if score := f.Vulnerability.CvssV3BaseScore; score > 0 {
+			finding.CvssScore = &score
+		}
+
+		if fixed := f.Vulnerability.PatchedVersions; fixed != "" {
+			finding.FixedVersion = &fixed
+		}
+
+		ret = append(ret, finding)
+	}
+	return ret
+}
+
+// Component is a component of a project in DependencyTrack
+type Component struct {
+	Group   string `json:"group"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Purl    string `json:"purl"`
+}
+
+// FullName returns the name of the component, prefixed with the group if any
+func (c Component) FullName() string {
+	if c.Group == "" {
+		return c.Name
 	}
+	return c.Group + "/" + c.Name
+}
 
-	return findings, nil
+// FindingID returns the ID of a finding, which identifies the project, component and vulnerability in DependencyTrack
+func FindingID(projectUuid, componentUuid, vulnerabilityUuid string) string {
+	return projectUuid + ":" + componentUuid + ":" + vulnerabilityUuid
 }
 
-func (c *Client) createSummary(findings []*dependencytrack.Finding, hasBom bool) *model.VulnerabilitySummary {
+func (c *Client) createSummary(findings []*dependencytrack.Finding, metadata map[string]findingMetadata, hasBom bool) *model.VulnerabilitySummary {
 	var low, medium, high, critical, unassigned int
 	if !hasBom {
 		return &model.VulnerabilitySummary{
@@ -164,7 +452,15 @@ func (c *Client) createSummary(findings []*dependencytrack.Finding, hasBom bool)
 		}
 	}
 
+	// findings are counted by the same rules as they are scored, so that the counts explain the risk score
+	now := time.Now()
+	total := 0
 	for _, finding := range findings {
+		if !c.riskModel.included(finding, metadata[finding.Component.Uuid+":"+finding.Vulnerability.Uuid], now) {
+			continue
+		}
+
+		total += 1
 		switch finding.Vulnerability.Severity {
 		case "LOW":
 			low += 1
@@ -178,12 +474,10 @@ func (c *Client) createSummary(findings []*dependencytrack.Finding, hasBom bool)
 			unassigned += 1
 		}
 	}
-	// algorithm: https://github.com/DependencyTrack/dependency-track/blob/41e2ba8afb15477ff2b7b53bd9c19130ba1053c0/src/main/java/org/dependencytrack/metrics/Metrics.java#L31-L33
-	riskScore := (critical * 10) + (high * 5) + (medium * 3) + (low * 1) + (unassigned * 5)
 
 	return &model.VulnerabilitySummary{
-		Total:      len(findings),
-		RiskScore:  riskScore,
+		Total:      total,
+		RiskScore:  c.riskModel.score(findings, metadata, now),
 		Critical:   critical,
 		High:       high,
 		Medium:     medium,
@@ -194,7 +488,11 @@ func (c *Client) createSummary(findings []*dependencytrack.Finding, hasBom bool)
 
 func (c *Client) retrieveProject(ctx context.Context, app *AppInstance) (*dependencytrack.Project, error) {
 	tag := url.QueryEscape(app.Image)
-	projects, err := c.client.GetProjectsByTag(ctx, tag)
+	var projects []*dependencytrack.Project
+	err := c.upstream.Call(ctx, func(ctx context.Context) (err error) {
+		projects, err = c.client.GetProjectsByTag(ctx, tag)
+		return err
+	})
 	if err != nil {
 		return nil, fmt.Errorf("getting projects from DependencyTrack: %w", err)
 	}
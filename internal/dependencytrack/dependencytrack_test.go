@@ -2,18 +2,29 @@ package dependencytrack
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/nais/console-backend/internal/config"
 	"github.com/nais/console-backend/internal/graph/model"
+	"github.com/nais/console-backend/internal/test"
 	dependencytrack "github.com/nais/dependencytrack/pkg/client"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	testifymock "github.com/stretchr/testify/mock"
 )
 
 func TestClient_GetVulnerabilities(t *testing.T) {
-	cfg := config.DependencyTrack{}
+	server := findingsServer(t, findings())
+	defer server.Close()
+
+	cfg := config.DependencyTrack{Endpoint: server.URL}
 	log := logrus.New().WithField("test", "dependencytrack")
 	ctx := context.Background()
 
@@ -74,8 +85,7 @@ func TestClient_GetVulnerabilities(t *testing.T) {
 
 				mock.EXPECT().
 					GetProjectsByTag(ctx, url.QueryEscape("image:latest")).Return([]*dependencytrack.Project{p1}, nil)
-				mock.EXPECT().
-					GetFindings(ctx, p1.Uuid).Return(findings(), nil)
+				expectHeaders(mock)
 				mock.EXPECT().
 					GetProjectsByTag(ctx, url.QueryEscape("image:notfound")).Return([]*dependencytrack.Project{}, nil)
 			},
@@ -105,8 +115,7 @@ func TestClient_GetVulnerabilities(t *testing.T) {
 
 				mock.EXPECT().
 					GetProjectsByTag(ctx, url.QueryEscape("image:latest")).Return(ps, nil).Times(2)
-				mock.EXPECT().
-					GetFindings(ctx, ps[0].Uuid).Return(findings(), nil).Times(2)
+				expectHeaders(mock)
 			},
 			assert: func(t *testing.T, v []*model.VulnerabilitiesNode, err error) {
 				assert.NoError(t, err)
@@ -128,11 +137,137 @@ func TestClient_GetVulnerabilities(t *testing.T) {
 	}
 }
 
-func TestClient_VulnerabilitySummary(t *testing.T) {
+func TestClient_FetchVulnerabilities(t *testing.T) {
 	cfg := config.DependencyTrack{}
 	log := logrus.New().WithField("test", "dependencytrack")
 	ctx := context.Background()
 
+	t.Run("results are ordered like the input, with errors per app", func(t *testing.T) {
+		input := make([]*AppInstance, 0)
+		mock := NewMockInternalClient(t)
+		for i := 0; i < 25; i++ {
+			a := app("dev", "team1", fmt.Sprintf("app%d", i), fmt.Sprintf("image:%d", i))
+			input = append(input, a)
+
+			call := mock.EXPECT().GetProjectsByTag(ctx, url.QueryEscape(a.Image))
+			if i%10 == 3 {
+				call.Return(nil, fmt.Errorf("DependencyTrack is down")).Once()
+			} else {
+				call.Return([]*dependencytrack.Project{project(a.ToTags()...)}, nil).Once()
+			}
+		}
+
+		results := New(cfg, log).WithClient(mock).FetchVulnerabilities(ctx, input)
+		assert.Len(t, results, 25)
+		for i, result := range results {
+			assert.Equal(t, input[i], result.App)
+			assert.False(t, result.Stale)
+			if i%10 == 3 {
+				assert.Error(t, result.Err)
+				assert.Nil(t, result.Node)
+			} else {
+				assert.NoError(t, result.Err)
+				assert.Equal(t, input[i].App, result.Node.AppName)
+			}
+		}
+	})
+
+	t.Run("stale vulnerabilities are served when DependencyTrack fails", func(t *testing.T) {
+		input := app("dev", "team1", "app1", "image:latest")
+		mock := NewMockInternalClient(t)
+		mock.EXPECT().
+			GetProjectsByTag(ctx, url.QueryEscape("image:latest")).Return([]*dependencytrack.Project{project(input.ToTags()...)}, nil).Once()
+		mock.EXPECT().
+			GetProjectsByTag(testifymock.Anything, url.QueryEscape("image:latest")).Return(nil, fmt.Errorf("DependencyTrack is down")).Twice()
+
+		c := New(cfg, log).WithClient(mock)
+		results := c.FetchVulnerabilities(ctx, []*AppInstance{input})
+		assert.NoError(t, results[0].Err)
+
+		c.cache.Flush()
+		results = c.FetchVulnerabilities(ctx, []*AppInstance{input})
+		assert.EqualError(t, results[0].Err, "getting project by app dev:team1:app1:image:latest: getting projects from DependencyTrack: DependencyTrack is down")
+		assert.True(t, results[0].Stale)
+		assert.Equal(t, "app1", results[0].Node.AppName)
+
+		nodes, err := c.GetVulnerabilities(ctx, []*AppInstance{input})
+		assert.NoError(t, err)
+		assert.Len(t, nodes, 1)
+	})
+
+	t.Run("stale vulnerabilities are served when DependencyTrack is slow", func(t *testing.T) {
+		input := app("dev", "team1", "app1", "image:latest")
+		release := make(chan time.Time)
+		mock := NewMockInternalClient(t)
+		mock.EXPECT().
+			GetProjectsByTag(ctx, url.QueryEscape("image:latest")).Return([]*dependencytrack.Project{project(input.ToTags()...)}, nil).Once()
+		mock.EXPECT().
+			GetProjectsByTag(testifymock.Anything, url.QueryEscape("image:latest")).Return([]*dependencytrack.Project{project(input.ToTags()...)}, nil).WaitUntil(release).Once()
+
+		c := New(cfg, log).WithClient(mock)
+		c.staleAfter = 10 * time.Millisecond
+		c.FetchVulnerabilities(ctx, []*AppInstance{input})
+
+		c.cache.Flush()
+		results := c.FetchVulnerabilities(ctx, []*AppInstance{input})
+		assert.ErrorIs(t, results[0].Err, ErrSlow)
+		assert.True(t, results[0].Stale)
+		assert.Equal(t, "app1", results[0].Node.AppName)
+
+		// the slow fetch completes in the background and refreshes the cache
+		close(release)
+		assert.Eventually(t, func() bool {
+			_, cached := c.cache.Get(input.ID())
+			return cached
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("slow fetches of the same app are shared", func(t *testing.T) {
+		input := app("dev", "team1", "app1", "image:latest")
+		release := make(chan time.Time)
+		mock := NewMockInternalClient(t)
+		mock.EXPECT().
+			GetProjectsByTag(ctx, url.QueryEscape("image:latest")).Return([]*dependencytrack.Project{project(input.ToTags()...)}, nil).Once()
+		mock.EXPECT().
+			GetProjectsByTag(testifymock.Anything, url.QueryEscape("image:latest")).Return([]*dependencytrack.Project{project(input.ToTags()...)}, nil).WaitUntil(release).Once()
+
+		c := New(cfg, log).WithClient(mock)
+		c.staleAfter = 10 * time.Millisecond
+		c.FetchVulnerabilities(ctx, []*AppInstance{input})
+
+		c.cache.Flush()
+		results := c.FetchVulnerabilities(ctx, []*AppInstance{input, input, input})
+		for _, result := range results {
+			assert.ErrorIs(t, result.Err, ErrSlow)
+			assert.True(t, result.Stale)
+		}
+		assert.Len(t, c.refreshes, 1)
+
+		close(release)
+		assert.Eventually(t, func() bool {
+			return len(c.refreshes) == 0
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("error when no vulnerabilities can be returned", func(t *testing.T) {
+		input := app("dev", "team1", "app1", "image:latest")
+		mock := NewMockInternalClient(t)
+		mock.EXPECT().
+			GetProjectsByTag(ctx, url.QueryEscape("image:latest")).Return(nil, fmt.Errorf("DependencyTrack is down")).Once()
+
+		_, err := New(cfg, log).WithClient(mock).GetVulnerabilities(ctx, []*AppInstance{input})
+		assert.Error(t, err)
+	})
+}
+
+func TestClient_VulnerabilitySummary(t *testing.T) {
+	server := findingsServer(t, findings())
+	defer server.Close()
+
+	cfg := config.DependencyTrack{Endpoint: server.URL}
+	log := logrus.New().WithField("test", "dependencytrack")
+	ctx := context.Background()
+
 	tt := []struct {
 		name   string
 		input  *AppInstance
@@ -148,6 +283,7 @@ func TestClient_VulnerabilitySummary(t *testing.T) {
 			},
 			assert: func(t *testing.T, v *model.VulnerabilitiesNode, err error) {
 				assert.NoError(t, err)
+				assert.Equal(t, model.WorkloadTypeApp, v.WorkloadType)
 				assert.Equal(t, -1, v.Summary.Critical)
 				assert.Equal(t, -1, v.Summary.High)
 				assert.Equal(t, -1, v.Summary.Medium)
@@ -155,6 +291,20 @@ func TestClient_VulnerabilitySummary(t *testing.T) {
 				assert.Equal(t, -1, v.Summary.Unassigned)
 			},
 		},
+		{
+			name:  "should return summary of a naisjob",
+			input: &AppInstance{Env: "dev", Team: "team1", App: "job1", Image: "image:latest", Kind: model.WorkloadTypeNaisjob},
+			expect: func(input *AppInstance, mock *MockInternalClient) {
+				mock.EXPECT().
+					GetProjectsByTag(ctx, url.QueryEscape("image:latest")).Return([]*dependencytrack.Project{project(input.ToTags()...)}, nil)
+			},
+			assert: func(t *testing.T, v *model.VulnerabilitiesNode, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "job1", v.AppName)
+				assert.Equal(t, model.WorkloadTypeNaisjob, v.WorkloadType)
+				assert.Equal(t, "dev:team1:naisjob:job1:image:latest", v.ID.ID)
+			},
+		},
 		{
 			name:  "should return nil summary if no project is found",
 			input: app("dev", "team1", "noProject", "image:latest"),
@@ -176,9 +326,7 @@ func TestClient_VulnerabilitySummary(t *testing.T) {
 
 				mock.EXPECT().
 					GetProjectsByTag(ctx, url.QueryEscape("image:latest")).Return(p, nil)
-
-				mock.EXPECT().
-					GetFindings(ctx, p[0].Uuid).Return(findings(), nil)
+				expectHeaders(mock)
 			},
 			assert: func(t *testing.T, v *model.VulnerabilitiesNode, err error) {
 				assert.NoError(t, err)
@@ -204,6 +352,354 @@ func TestClient_VulnerabilitySummary(t *testing.T) {
 	}
 }
 
+func TestClient_VulnerabilityFindings(t *testing.T) {
+	log := logrus.New().WithField("test", "dependencytrack")
+	ctx := context.Background()
+
+	input := app("dev", "team1", "app1", "image:latest")
+	p := project(input.ToTags()...)
+	p.LastBomImportFormat = "cyclonedx"
+
+	findings := []*dependencytrack.Finding{
+		{
+			Component: dependencytrack.Component{Uuid: "component-1", Group: "org.apache.logging.log4j", Name: "log4j-core", Version: "2.14.1", Purl: "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1"},
+			Vulnerability: dependencytrack.Vulnerability{
+				Uuid:            "vulnerability-1",
+				VulnId:          "CVE-2021-44228",
+				Source:          "NVD",
+				Severity:        "CRITICAL",
+				CvssV3BaseScore: 10,
+				PatchedVersions: "2.15.0",
+			},
+			Analysis: dependencytrack.Analysis{State: "EXPLOITABLE"},
+		},
+		{
+			Component:     dependencytrack.Component{Uuid: "component-2", Name: "lodash", Version: "4.17.20"},
+			Vulnerability: dependencytrack.Vulnerability{Uuid: "vulnerability-2", VulnId: "GHSA-1234", Source: "GITHUB", Severity: "SOMETHING"},
+			Analysis:      dependencytrack.Analysis{IsSuppressed: true},
+		},
+	}
+
+	server := findingsServer(t, findings)
+	defer server.Close()
+
+	mock := headersClient(t)
+	mock.EXPECT().
+		GetProjectsByTag(ctx, url.QueryEscape("image:latest")).Return([]*dependencytrack.Project{p}, nil).Once()
+
+	c := New(config.DependencyTrack{Endpoint: server.URL}, log).WithClient(mock)
+	f, err := c.VulnerabilityFindings(ctx, input)
+	assert.NoError(t, err)
+	assert.Len(t, f, 2)
+
+	assert.Equal(t, "uuid:component-1:vulnerability-1", f[0].ID.ID)
+	assert.Equal(t, "CVE-2021-44228", f[0].VulnerabilityID)
+	assert.Equal(t, model.VulnerabilitySeverityCritical, f[0].Severity)
+	assert.Equal(t, 10.0, *f[0].CvssScore)
+	assert.Equal(t, "2.15.0", *f[0].FixedVersion)
+	assert.Equal(t, "org.apache.logging.log4j/log4j-core", f[0].Component.Name)
+	assert.Equal(t, "EXPLOITABLE", f[0].AnalysisState)
+	assert.False(t, f[0].Suppressed)
+
+	assert.Equal(t, model.VulnerabilitySeverityUnassigned, f[1].Severity)
+	assert.Nil(t, f[1].CvssScore)
+	assert.Nil(t, f[1].FixedVersion)
+	assert.Equal(t, "lodash", f[1].Component.Name)
+	assert.Equal(t, "NOT_SET", f[1].AnalysisState)
+	assert.True(t, f[1].Suppressed)
+
+	// the summary is served from the same cache entry, and does not count the suppressed finding
+	v, err := c.VulnerabilitySummary(ctx, input)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, v.Summary.Total)
+	assert.Equal(t, 0, v.Summary.Unassigned)
+}
+
+func TestClient_RecordAnalysis(t *testing.T) {
+	log := logrus.New().WithField("test", "dependencytrack")
+	ctx := context.Background()
+	input := app("dev", "team1", "app1", "image:latest")
+
+	t.Run("invalid finding ID", func(t *testing.T) {
+		c := New(config.DependencyTrack{}, log)
+		err := c.RecordAnalysis(ctx, input, "project:component", Analysis{State: "FALSE_POSITIVE"})
+		assert.EqualError(t, err, `invalid finding ID "project:component"`)
+	})
+
+	t.Run("records analysis and invalidates cache", func(t *testing.T) {
+		suppressed := true
+		server := test.NewHttpServerWithHandlers(t, []http.HandlerFunc{
+			func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, "/api/v1/analysis", r.URL.Path)
+				assert.Equal(t, "key", r.Header.Get("X-Api-Key"))
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+				var body map[string]any
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, map[string]any{
+					"project":               "project",
+					"component":             "component",
+					"vulnerability":         "vulnerability",
+					"analysisState":         "NOT_AFFECTED",
+					"analysisJustification": "CODE_NOT_REACHABLE",
+					"comment":               "not used",
+					"isSuppressed":          true,
+				}, body)
+				_, _ = w.Write([]byte("{}"))
+			},
+		})
+		defer server.Close()
+
+		c := New(config.DependencyTrack{Endpoint: server.URL}, log).WithClient(headersClient(t))
+		c.cache.Set(input.ID(), &appVulnerabilities{}, 0)
+
+		err := c.RecordAnalysis(ctx, input, "project:component:vulnerability", Analysis{
+			State:         "NOT_AFFECTED",
+			Justification: "CODE_NOT_REACHABLE",
+			Comment:       "not used",
+			Suppressed:    &suppressed,
+		})
+		assert.NoError(t, err)
+
+		_, cached := c.cache.Get(input.ID())
+		assert.False(t, cached)
+	})
+
+	t.Run("error from DependencyTrack", func(t *testing.T) {
+		server := test.NewHttpServerWithHandlers(t, []http.HandlerFunc{
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+			},
+		})
+		defer server.Close()
+
+		c := New(config.DependencyTrack{Endpoint: server.URL}, log).WithClient(headersClient(t))
+		err := c.RecordAnalysis(ctx, input, "project:component:vulnerability", Analysis{State: "FALSE_POSITIVE"})
+		assert.EqualError(t, err, "recording analysis in DependencyTrack: DependencyTrack API returned 404 Not Found")
+	})
+
+	t.Run("no credentials from the DependencyTrack library", func(t *testing.T) {
+		mock := NewMockInternalClient(t)
+		mock.EXPECT().Headers(testifymock.Anything).Return(nil, fmt.Errorf("login failed"))
+
+		c := New(config.DependencyTrack{}, log).WithClient(mock)
+		err := c.RecordAnalysis(ctx, input, "project:component:vulnerability", Analysis{State: "FALSE_POSITIVE"})
+		assert.EqualError(t, err, "recording analysis in DependencyTrack: getting DependencyTrack credentials: login failed")
+	})
+}
+
+func TestClient_Components(t *testing.T) {
+	log := logrus.New().WithField("test", "dependencytrack")
+	ctx := context.Background()
+	input := app("dev", "team1", "app1", "image:latest")
+	p := project(input.ToTags()...)
+	p.LastBomImportFormat = "cyclonedx"
+
+	server := test.NewHttpServerWithHandlers(t, []http.HandlerFunc{
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/v1/finding/project/uuid", r.URL.Path)
+			_, _ = w.Write([]byte("[]"))
+		},
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/api/v1/component/project/uuid", r.URL.Path)
+			assert.Equal(t, "key", r.Header.Get("X-Api-Key"))
+			_, _ = w.Write([]byte(`[{"group":"org.apache.logging.log4j","name":"log4j-core","version":"2.14.1","purl":"pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1"}]`))
+		},
+	})
+	defer server.Close()
+
+	mock := headersClient(t)
+	mock.EXPECT().
+		GetProjectsByTag(ctx, url.QueryEscape("image:latest")).Return([]*dependencytrack.Project{p}, nil).Once()
+
+	c := New(config.DependencyTrack{Endpoint: server.URL}, log).WithClient(mock)
+	components, err := c.Components(ctx, input)
+	assert.NoError(t, err)
+	assert.Equal(t, []Component{
+		{Group: "org.apache.logging.log4j", Name: "log4j-core", Version: "2.14.1", Purl: "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1"},
+	}, components)
+	assert.Equal(t, "org.apache.logging.log4j/log4j-core", components[0].FullName())
+}
+
+func TestClient_PolicyViolations(t *testing.T) {
+	log := logrus.New().WithField("test", "dependencytrack")
+	ctx := context.Background()
+	input := app("dev", "team1", "app1", "image:latest")
+	p := project(input.ToTags()...)
+	p.LastBomImportFormat = "cyclonedx"
+
+	server := test.NewHttpServerWithHandlers(t, []http.HandlerFunc{
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/v1/finding/project/uuid", r.URL.Path)
+			_, _ = w.Write([]byte("[]"))
+		},
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/api/v1/violation/project/uuid", r.URL.Path)
+			assert.Equal(t, "false", r.URL.Query().Get("suppressed"))
+			_, _ = w.Write([]byte(`[
+				{"uuid":"v1","type":"LICENSE","component":{"group":"org.example","name":"lib","version":"1.0.0","purl":"pkg:maven/org.example/lib@1.0.0"},"policyCondition":{"policy":{"name":"No GPL","violationState":"FAIL"}}},
+				{"uuid":"v2","type":"OPERATIONAL","component":{"name":"left-pad","version":"0.0.1","purl":"pkg:npm/left-pad@0.0.1"},"policyCondition":{"policy":{"name":"No ancient components","violationState":"WARN"}}},
+				{"uuid":"v3","type":"UNKNOWN","component":{"name":"other"},"policyCondition":{"policy":{"name":"Other"}}}
+			]`))
+		},
+	})
+	defer server.Close()
+
+	mock := headersClient(t)
+	mock.EXPECT().
+		GetProjectsByTag(ctx, url.QueryEscape("image:latest")).Return([]*dependencytrack.Project{p}, nil).Once()
+
+	c := New(config.DependencyTrack{Endpoint: server.URL}, log).WithClient(mock)
+	violations, err := c.PolicyViolations(ctx, input)
+	assert.NoError(t, err)
+	assert.Len(t, violations, 2)
+	assert.Equal(t, "No GPL", violations[0].PolicyName)
+	assert.Equal(t, model.PolicyViolationTypeLicense, violations[0].Type)
+	assert.Equal(t, model.PolicyViolationStateFail, violations[0].State)
+	assert.Equal(t, "org.example/lib", violations[0].Component.Name)
+	assert.Equal(t, model.PolicyViolationTypeOperational, violations[1].Type)
+	assert.Equal(t, model.PolicyViolationStateWarn, violations[1].State)
+
+	// the violations are cached, so DependencyTrack is not called again
+	summary, err := c.PolicyViolationSummary(ctx, []*AppInstance{input})
+	assert.NoError(t, err)
+	assert.Equal(t, &model.PolicyViolationSummary{Total: 2, License: 1, Operational: 1}, summary)
+}
+
+func TestRiskModel_score(t *testing.T) {
+	now := time.Now()
+	epss := 0.5
+	input := []*dependencytrack.Finding{
+		{
+			Component:     dependencytrack.Component{Uuid: "c1"},
+			Vulnerability: dependencytrack.Vulnerability{Uuid: "v1", Severity: "CRITICAL", CvssV3BaseScore: 9.8, PatchedVersions: "2.17.1"},
+		},
+		{
+			Component:     dependencytrack.Component{Uuid: "c2"},
+			Vulnerability: dependencytrack.Vulnerability{Uuid: "v2", Severity: "HIGH"},
+		},
+		{
+			Component:     dependencytrack.Component{Uuid: "c3"},
+			Vulnerability: dependencytrack.Vulnerability{Uuid: "v3", Severity: "LOW", CvssV3BaseScore: 3.1, PatchedVersions: "1.0.1"},
+			Analysis:      dependencytrack.Analysis{IsSuppressed: true},
+		},
+	}
+	metadata := map[string]findingMetadata{
+		"c1:v1": {epss: &epss, attributedOn: now.Add(-48 * time.Hour)},
+		"c2:v2": {attributedOn: now.Add(-time.Hour)},
+	}
+
+	t.Run("severity", func(t *testing.T) {
+		assert.Equal(t, 15, defaultRiskModel.score(input, nil, now))
+	})
+
+	t.Run("custom weights", func(t *testing.T) {
+		m := newRiskModel(config.DependencyTrack{RiskModel: "SEVERITY", RiskWeightCritical: 100, RiskWeightHigh: 20})
+		assert.Equal(t, 120, m.score(input, nil, now))
+	})
+
+	t.Run("cvss falls back to severity weights", func(t *testing.T) {
+		m := defaultRiskModel
+		m.mode = model.RiskScoreModeCvss
+		assert.Equal(t, 15, m.score(input, nil, now))
+	})
+
+	t.Run("epss", func(t *testing.T) {
+		m := defaultRiskModel
+		m.mode = model.RiskScoreModeEpss
+		assert.Equal(t, 10, m.score(input, metadata, now))
+	})
+
+	t.Run("grace period", func(t *testing.T) {
+		m := defaultRiskModel
+		m.gracePeriod = 24 * time.Hour
+		assert.Equal(t, 5, m.score(input, metadata, now))
+	})
+
+	t.Run("exclude unfixed", func(t *testing.T) {
+		m := defaultRiskModel
+		m.excludeUnfixed = true
+		assert.Equal(t, 10, m.score(input, nil, now))
+	})
+}
+
+func TestClient_RiskModel(t *testing.T) {
+	log := logrus.New().WithField("test", "dependencytrack")
+	ctx := context.Background()
+	input := app("dev", "team1", "app1", "image:latest")
+	p := project(input.ToTags()...)
+	p.LastBomImportFormat = "cyclonedx"
+
+	recent := time.Now().Add(-time.Hour).UnixMilli()
+	old := time.Now().Add(-96 * time.Hour).UnixMilli()
+	server := test.NewHttpServerWithHandlers(t, []http.HandlerFunc{
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/v1/finding/project/uuid", r.URL.Path)
+			assert.Equal(t, "true", r.URL.Query().Get("suppressed"))
+			_, _ = fmt.Fprintf(w, `[
+				{"component":{"uuid":"c1"},"vulnerability":{"uuid":"v1","severity":"CRITICAL","cvssV3BaseScore":9.8,"epssScore":0.1,"patchedVersions":"2.0.0"},"attribution":{"attributedOn":%[1]d}},
+				{"component":{"uuid":"c2"},"vulnerability":{"uuid":"v2","severity":"HIGH","patchedVersions":"2.0.0"},"attribution":{"attributedOn":%[2]d}},
+				{"component":{"uuid":"c3"},"vulnerability":{"uuid":"v3","severity":"LOW"},"attribution":{"attributedOn":%[1]d}},
+				{"component":{"uuid":"c4"},"vulnerability":{"uuid":"v4","severity":"MEDIUM","patchedVersions":"2.0.0"},"attribution":{"attributedOn":%[1]d},"analysis":{"isSuppressed":true}}
+			]`, recent, old)
+		},
+	})
+	defer server.Close()
+
+	mock := headersClient(t)
+	mock.EXPECT().
+		GetProjectsByTag(ctx, url.QueryEscape("image:latest")).Return([]*dependencytrack.Project{p}, nil).Once()
+
+	cfg := config.DependencyTrack{
+		Endpoint:           server.URL,
+		RiskModel:          "EPSS",
+		RiskWeightCritical: 10,
+		RiskGracePeriod:    72 * time.Hour,
+		RiskExcludeUnfixed: true,
+	}
+	c := New(cfg, log).WithClient(mock)
+	assert.Equal(t, &model.VulnerabilityRiskModel{
+		Mode:             model.RiskScoreModeEpss,
+		Weights:          model.SeverityWeights{Critical: 10},
+		GracePeriodHours: 72,
+		ExcludeUnfixed:   true,
+	}, c.RiskModel())
+
+	// the old, the unfixed and the suppressed findings are neither counted nor scored
+	v, err := c.VulnerabilitySummary(ctx, input)
+	assert.NoError(t, err)
+	assert.Equal(t, &model.VulnerabilitySummary{Total: 1, Critical: 1, RiskScore: 1}, v.Summary)
+
+	// all findings are listed
+	findings, err := c.VulnerabilityFindings(ctx, input)
+	assert.NoError(t, err)
+	assert.Len(t, findings, 4)
+}
+
+// headersClient returns a DependencyTrack library client that only provides credentials for the REST API
+func headersClient(t *testing.T) *MockInternalClient {
+	mock := NewMockInternalClient(t)
+	expectHeaders(mock)
+	return mock
+}
+
+// expectHeaders lets the DependencyTrack library client provide credentials for the REST API
+func expectHeaders(mock *MockInternalClient) {
+	mock.EXPECT().Headers(testifymock.Anything).Return(http.Header{"X-Api-Key": {"key"}}, nil)
+}
+
+// findingsServer returns a DependencyTrack REST API that returns the findings for any project
+func findingsServer(t *testing.T, findings []*dependencytrack.Finding) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.True(t, strings.HasPrefix(r.URL.Path, "/api/v1/finding/project/"))
+		assert.Equal(t, "true", r.URL.Query().Get("suppressed"))
+		assert.NoError(t, json.NewEncoder(w).Encode(findings))
+	}))
+}
+
 func app(env, team, app, image string) *AppInstance {
 	return &AppInstance{
 		Env:   env,
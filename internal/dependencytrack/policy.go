@@ -0,0 +1,121 @@
+package dependencytrack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/nais/console-backend/internal/graph/model"
+	"github.com/nais/console-backend/internal/graph/scalar"
+	"github.com/patrickmn/go-cache"
+)
+
+type policyViolation struct {
+	Uuid      string    `json:"uuid"`
+	Type      string    `json:"type"`
+	Component Component `json:"component"`
+
+	PolicyCondition struct {
+		Policy struct {
+			Name           string `json:"name"`
+			ViolationState string `json:"violationState"`
+		} `json:"policy"`
+	} `json:"policyCondition"`
+}
+
+// PolicyViolations returns the unsuppressed policy violations of an app instance. Apps without a project in
+// DependencyTrack have no policy violations.
+func (c *Client) PolicyViolations(ctx context.Context, app *AppInstance) ([]*model.PolicyViolation, error) {
+	if v, ok := c.violations.Get(app.ID()); ok {
+		return v.([]*model.PolicyViolation), nil
+	}
+
+	v, err := c.vulnerabilitiesForApp(ctx, app)
+	if err != nil {
+		return nil, err
+	}
+	if v.projectUuid == "" {
+		return []*model.PolicyViolation{}, nil
+	}
+
+	var violations []*policyViolation
+	path := "/api/v1/violation/project/" + url.PathEscape(v.projectUuid) + "?suppressed=false"
+	if err := c.callAPI(ctx, http.MethodGet, path, nil, &violations); err != nil {
+		return nil, fmt.Errorf("getting policy violations from DependencyTrack: %w", err)
+	}
+
+	ret := toModelPolicyViolations(violations)
+	c.violations.Set(app.ID(), ret, cache.DefaultExpiration)
+	return ret, nil
+}
+
+// PolicyViolationSummary counts the unsuppressed policy violations of the app instances by type. App instances where
+// the violations could not be fetched are left out, and an error is only returned if none of them could be fetched.
+func (c *Client) PolicyViolationSummary(ctx context.Context, apps []*AppInstance) (*model.PolicyViolationSummary, error) {
+	violations := make([][]*model.PolicyViolation, len(apps))
+	errs := make([]error, len(apps))
+
+	forEachConcurrently(len(apps), func(i int) {
+		violations[i], errs[i] = c.PolicyViolations(ctx, apps[i])
+	})
+
+	ret := &model.PolicyViolationSummary{}
+	var err error
+	fetched := 0
+	for i, app := range apps {
+		if errs[i] != nil {
+			c.log.WithError(errs[i]).Errorf("fetching policy violations for app %q", app.ID())
+			err = errs[i]
+			continue
+		}
+
+		fetched++
+		for _, v := range violations[i] {
+			ret.Total++
+			switch v.Type {
+			case model.PolicyViolationTypeLicense:
+				ret.License++
+			case model.PolicyViolationTypeSecurity:
+				ret.Security++
+			case model.PolicyViolationTypeOperational:
+				ret.Operational++
+			}
+		}
+	}
+
+	if fetched == 0 && err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// toModelPolicyViolations converts policy violations from DependencyTrack to the GraphQL model. Violations of unknown
+// types are left out.
+func toModelPolicyViolations(violations []*policyViolation) []*model.PolicyViolation {
+	ret := make([]*model.PolicyViolation, 0, len(violations))
+	for _, v := range violations {
+		violationType := model.PolicyViolationType(v.Type)
+		if !violationType.IsValid() {
+			continue
+		}
+
+		state := model.PolicyViolationState(v.PolicyCondition.Policy.ViolationState)
+		if !state.IsValid() {
+			state = model.PolicyViolationStateInfo
+		}
+
+		ret = append(ret, &model.PolicyViolation{
+			ID:         scalar.PolicyViolationIdent(v.Uuid),
+			PolicyName: v.PolicyCondition.Policy.Name,
+			Type:       violationType,
+			State:      state,
+			Component: model.VulnerableComponent{
+				Name:    v.Component.FullName(),
+				Version: v.Component.Version,
+				Purl:    v.Component.Purl,
+			},
+		})
+	}
+	return ret
+}
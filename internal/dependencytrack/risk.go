@@ -0,0 +1,191 @@
+package dependencytrack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/nais/console-backend/internal/config"
+	"github.com/nais/console-backend/internal/graph/model"
+	dependencytrack "github.com/nais/dependencytrack/pkg/client"
+)
+
+// riskModel computes the risk score of the findings of an app instance
+type riskModel struct {
+	mode           model.RiskScoreMode
+	weights        model.SeverityWeights
+	gracePeriod    time.Duration
+	excludeUnfixed bool
+}
+
+// defaultRiskModel is the risk model of DependencyTrack, see
+// https://github.com/DependencyTrack/dependency-track/blob/41e2ba8afb15477ff2b7b53bd9c19130ba1053c0/src/main/java/org/dependencytrack/metrics/Metrics.java#L31-L33
+var defaultRiskModel = riskModel{
+	mode: model.RiskScoreModeSeverity,
+	weights: model.SeverityWeights{
+		Critical:   10,
+		High:       5,
+		Medium:     3,
+		Low:        1,
+		Unassigned: 5,
+	},
+}
+
+// newRiskModel creates the risk model from the configuration. The configuration is validated when loaded, and an empty
+// risk model means the risk model of DependencyTrack.
+func newRiskModel(cfg config.DependencyTrack) riskModel {
+	if cfg.RiskModel == "" {
+		return defaultRiskModel
+	}
+
+	return riskModel{
+		mode: model.RiskScoreMode(cfg.RiskModel),
+		weights: model.SeverityWeights{
+			Critical:   cfg.RiskWeightCritical,
+			High:       cfg.RiskWeightHigh,
+			Medium:     cfg.RiskWeightMedium,
+			Low:        cfg.RiskWeightLow,
+			Unassigned: cfg.RiskWeightUnassigned,
+		},
+		gracePeriod:    cfg.RiskGracePeriod,
+		excludeUnfixed: cfg.RiskExcludeUnfixed,
+	}
+}
+
+// RiskModel returns the model used to compute risk scores
+func (c *Client) RiskModel() *model.VulnerabilityRiskModel {
+	return &model.VulnerabilityRiskModel{
+		Mode:             c.riskModel.mode,
+		Weights:          c.riskModel.weights,
+		GracePeriodHours: int(c.riskModel.gracePeriod.Hours()),
+		ExcludeUnfixed:   c.riskModel.excludeUnfixed,
+	}
+}
+
+// findingMetadata is data about a finding that is not available in the DependencyTrack library
+type findingMetadata struct {
+	// epss is the EPSS probability of exploitation, nil if unknown
+	epss *float64
+
+	// attributedOn is when DependencyTrack first saw the finding, zero if unknown
+	attributedOn time.Time
+}
+
+type apiFinding struct {
+	Component struct {
+		Uuid string `json:"uuid"`
+	} `json:"component"`
+
+	Vulnerability struct {
+		Uuid      string   `json:"uuid"`
+		EpssScore *float64 `json:"epssScore"`
+	} `json:"vulnerability"`
+
+	Attribution struct {
+		// AttributedOn is in milliseconds since the epoch
+		AttributedOn int64 `json:"attributedOn"`
+	} `json:"attribution"`
+}
+
+// retrieveFindings returns all findings of a project, including suppressed findings, along with their metadata keyed by
+// component and vulnerability UUID. The findings and their metadata are read from a single call to the DependencyTrack
+// API, as the metadata is not available in the DependencyTrack library. Suppressed findings are left out of summaries
+// and risk scores by included.
+func (c *Client) retrieveFindings(ctx context.Context, projectUuid string) ([]*dependencytrack.Finding, map[string]findingMetadata, error) {
+	var raw []json.RawMessage
+	path := "/api/v1/finding/project/" + url.PathEscape(projectUuid) + "?suppressed=true"
+	if err := c.callAPI(ctx, http.MethodGet, path, nil, &raw); err != nil {
+		return nil, nil, fmt.Errorf("getting findings from DependencyTrack: %w", err)
+	}
+
+	findings := make([]*dependencytrack.Finding, 0, len(raw))
+	metadata := make(map[string]findingMetadata, len(raw))
+	for _, r := range raw {
+		finding := &dependencytrack.Finding{}
+		if err := json.Unmarshal(r, finding); err != nil {
+			return nil, nil, fmt.Errorf("decoding finding from DependencyTrack: %w", err)
+		}
+		findings = append(findings, finding)
+
+		var f apiFinding
+		if err := json.Unmarshal(r, &f); err != nil {
+			return nil, nil, fmt.Errorf("decoding finding metadata from DependencyTrack: %w", err)
+		}
+		meta := findingMetadata{epss: f.Vulnerability.EpssScore}
+		if f.Attribution.AttributedOn > 0 {
+			meta.attributedOn = time.UnixMilli(f.Attribution.AttributedOn)
+		}
+		metadata[f.Component.Uuid+":"+f.Vulnerability.Uuid] = meta
+	}
+	return findings, metadata, nil
+}
+
+// included returns true if a finding is counted in summaries and scored. Suppressed findings, findings first seen more
+// than the grace period ago and, if configured, findings without a fix are left out. Findings without a known
+// attribution time are always included.
+func (m riskModel) included(f *dependencytrack.Finding, meta findingMetadata, now time.Time) bool {
+	if f.Analysis.IsSuppressed {
+		return false
+	}
+
+	if m.excludeUnfixed && f.Vulnerability.PatchedVersions == "" {
+		return false
+	}
+
+	if m.gracePeriod > 0 && !meta.attributedOn.IsZero() && now.Sub(meta.attributedOn) > m.gracePeriod {
+		return false
+	}
+
+	return true
+}
+
+// score returns the risk score of the findings, the sum of the scores of each included finding rounded to the nearest
+// integer
+func (m riskModel) score(findings []*dependencytrack.Finding, metadata map[string]findingMetadata, now time.Time) int {
+	total := 0.0
+	for _, f := range findings {
+		meta := metadata[f.Component.Uuid+":"+f.Vulnerability.Uuid]
+		if !m.included(f, meta, now) {
+			continue
+		}
+
+		total += m.findingScore(f, meta)
+	}
+	return int(math.Round(total))
+}
+
+func (m riskModel) findingScore(f *dependencytrack.Finding, meta findingMetadata) float64 {
+	score := float64(m.weight(f.Vulnerability.Severity))
+	if m.mode == model.RiskScoreModeSeverity {
+		return score
+	}
+
+	if cvss := f.Vulnerability.CvssV3BaseScore; cvss > 0 {
+		score = cvss
+	}
+
+	if m.mode == model.RiskScoreModeEpss && meta.epss != nil {
+		score *= *meta.epss
+	}
+	return score
+}
+
+func (m riskModel) weight(severity string) int {
+	switch severity {
+	case "CRITICAL":
+		return m.weights.Critical
+	case "HIGH":
+		return m.weights.High
+	case "MEDIUM":
+		return m.weights.Medium
+	case "LOW":
+		return m.weights.Low
+	case "UNASSIGNED":
+		return m.weights.Unassigned
+	}
+	return 0
+}
@@ -0,0 +1,126 @@
+package deploykeys
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nais/console-backend/internal/database/gensql"
+	"github.com/nais/console-backend/internal/graph/model"
+	"github.com/nais/console-backend/internal/hookd"
+	"github.com/nais/console-backend/internal/teams"
+	"github.com/sirupsen/logrus"
+)
+
+// ExpiresSoonThreshold is how long before expiry a deploy key is considered to expire soon
+const ExpiresSoonThreshold = 14 * 24 * time.Hour
+
+// ExpiresSoon returns true if a deploy key with the given expiry expires within ExpiresSoonThreshold
+func ExpiresSoon(expires time.Time) bool {
+	return time.Until(expires) < ExpiresSoonThreshold
+}
+
+type Checker struct {
+	hookdClient hookd.Client
+	teamsClient teams.Client
+	querier     gensql.Querier
+	notifier    Notifier
+	log         logrus.FieldLogger
+}
+
+// NewChecker creates a new deploy key checker. The notifier is optional, and no notifications will be sent if it is nil.
+func NewChecker(hookdClient hookd.Client, teamsClient teams.Client, querier gensql.Querier, notifier Notifier, log logrus.FieldLogger) *Checker {
+	return &Checker{
+		hookdClient: hookdClient,
+		teamsClient: teamsClient,
+		querier:     querier,
+		notifier:    notifier,
+		log:         log,
+	}
+}
+
+// CheckDeployKeys fetches the deploy key of every team and stores the expiry in the database. Teams with keys that
+// expire soon will be notified in their Slack alerts channels, once for each key. Returns the number of keys checked.
+func (c *Checker) CheckDeployKeys(ctx context.Context) (checked int, err error) {
+	teams, err := c.teamsClient.GetCachedTeams(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("unable to get teams: %w", err)
+	}
+
+	for _, team := range teams {
+		log := c.log.WithField("team", team.Name)
+		key, err := c.hookdClient.DeployKey(ctx, team.Name)
+		if err != nil {
+			log.WithError(err).Errorf("unable to fetch deploy key from hookd")
+			continue
+		}
+
+		row, err := c.querier.DeployKeyUpsert(ctx, gensql.DeployKeyUpsertParams{
+			Team:    team.Name,
+			Created: pgtype.Timestamptz{Time: key.Created, Valid: true},
+			Expires: pgtype.Timestamptz{Time: key.Expires, Valid: true},
+		})
+		if err != nil {
+			return checked, fmt.Errorf("unable to store deploy key expiry: %w", err)
+		}
+		checked++
+
+		if c.notifier == nil || !ExpiresSoon(key.Expires) || row.NotifiedExpires.Time.Equal(row.Expires.Time) {
+			continue
+		}
+
+		if err := c.notify(ctx, team, row); err != nil {
+			log.WithError(err).Errorf("unable to notify team about expiring deploy key")
+			continue
+		}
+
+		err = c.querier.DeployKeyNotified(ctx, gensql.DeployKeyNotifiedParams{
+			Team:            team.Name,
+			NotifiedExpires: row.Expires,
+		})
+		if err != nil {
+			return checked, fmt.Errorf("unable to store deploy key notification: %w", err)
+		}
+	}
+
+	return checked, nil
+}
+
+// notify posts a warning about an expiring deploy key to each of the Slack alerts channels of the team that has not
+// already been notified about the key. Every channel is attempted, and successful notifications are recorded per
+// channel, so that only the failed channels are retried on the next check.
+func (c *Checker) notify(ctx context.Context, team *model.Team, row *gensql.DeployKey) error {
+	channels := make([]string, 0)
+	for _, channel := range team.SlackAlertsChannels {
+		if channel.Name != "" && !slices.Contains(channels, channel.Name) && !slices.Contains(row.NotifiedChannels, channel.Name) {
+			channels = append(channels, channel.Name)
+		}
+	}
+
+	msg := fmt.Sprintf(
+		"The deploy key of team %s expires %s. Rotate the key in NAIS console to avoid failing deployments.",
+		team.Name,
+		row.Expires.Time.UTC().Format(time.DateOnly),
+	)
+
+	errs := make([]error, 0)
+	for _, channel := range channels {
+		if err := c.notifier.Notify(ctx, channel, msg); err != nil {
+			errs = append(errs, fmt.Errorf("channel %s: %w", channel, err))
+			continue
+		}
+
+		err := c.querier.DeployKeyChannelNotified(ctx, gensql.DeployKeyChannelNotifiedParams{
+			Team:    team.Name,
+			Channel: channel,
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to store notification of channel %s: %w", channel, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,119 @@
+package deploykeys_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nais/console-backend/internal/database/gensql"
+	"github.com/nais/console-backend/internal/deploykeys"
+	"github.com/nais/console-backend/internal/graph/model"
+	"github.com/nais/console-backend/internal/hookd"
+	"github.com/nais/console-backend/internal/teams"
+	logrustest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type notification struct {
+	channel, message string
+}
+
+type fakeNotifier struct {
+	notifications  []notification
+	failedChannels []string
+}
+
+func (n *fakeNotifier) Notify(_ context.Context, channel, message string) error {
+	if slices.Contains(n.failedChannels, channel) {
+		return assert.AnError
+	}
+	n.notifications = append(n.notifications, notification{channel: channel, message: message})
+	return nil
+}
+
+func TestExpiresSoon(t *testing.T) {
+	assert.True(t, deploykeys.ExpiresSoon(time.Now().Add(-time.Hour)))
+	assert.True(t, deploykeys.ExpiresSoon(time.Now().Add(24*time.Hour)))
+	assert.False(t, deploykeys.ExpiresSoon(time.Now().Add(30*24*time.Hour)))
+}
+
+func TestChecker_CheckDeployKeys(t *testing.T) {
+	ctx := context.Background()
+	created := time.Now().Add(-300 * 24 * time.Hour)
+	expiresSoon := time.Now().Add(7 * 24 * time.Hour)
+	expiresLater := time.Now().Add(60 * 24 * time.Hour)
+
+	keyRow := func(team string, expires time.Time, notified *time.Time) *gensql.DeployKey {
+		row := &gensql.DeployKey{
+			Team:    team,
+			Created: pgtype.Timestamptz{Time: created, Valid: true},
+			Expires: pgtype.Timestamptz{Time: expires, Valid: true},
+		}
+		if notified != nil {
+			row.NotifiedExpires = pgtype.Timestamptz{Time: *notified, Valid: true}
+		}
+		return row
+	}
+
+	teamsClient := teams.NewMockClient(t)
+	teamsClient.EXPECT().GetCachedTeams(ctx).Return([]*model.Team{
+		{Name: "team-a", SlackAlertsChannels: []model.SlackAlertsChannel{{Env: "dev", Name: "#team-a-dev"}, {Env: "prod", Name: "#team-a"}, {Env: "other", Name: "#team-a"}}},
+		{Name: "team-b", SlackAlertsChannels: []model.SlackAlertsChannel{{Env: "dev", Name: "#team-b"}}},
+		{Name: "team-c", SlackAlertsChannels: []model.SlackAlertsChannel{{Env: "dev", Name: "#team-c"}}},
+		{Name: "team-d"},
+		{Name: "team-e", SlackAlertsChannels: []model.SlackAlertsChannel{{Env: "dev", Name: "#team-e-dev"}, {Env: "prod", Name: "#team-e"}, {Env: "other", Name: "#team-e-other"}}},
+	}, nil)
+
+	hookdClient := hookd.NewMockClient(t)
+	hookdClient.EXPECT().DeployKey(ctx, "team-a").Return(&hookd.DeployKey{Team: "team-a", Created: created, Expires: expiresSoon}, nil)
+	hookdClient.EXPECT().DeployKey(ctx, "team-b").Return(&hookd.DeployKey{Team: "team-b", Created: created, Expires: expiresSoon}, nil)
+	hookdClient.EXPECT().DeployKey(ctx, "team-c").Return(&hookd.DeployKey{Team: "team-c", Created: created, Expires: expiresLater}, nil)
+	hookdClient.EXPECT().DeployKey(ctx, "team-d").Return(nil, assert.AnError)
+	hookdClient.EXPECT().DeployKey(ctx, "team-e").Return(&hookd.DeployKey{Team: "team-e", Created: created, Expires: expiresSoon}, nil)
+
+	teamERow := keyRow("team-e", expiresSoon, nil)
+	teamERow.NotifiedChannels = []string{"#team-e-dev"}
+
+	querier := gensql.NewMockQuerier(t)
+	querier.EXPECT().
+		DeployKeyUpsert(ctx, mock.MatchedBy(func(params gensql.DeployKeyUpsertParams) bool { return params.Team == "team-a" })).
+		Return(keyRow("team-a", expiresSoon, nil), nil)
+	querier.EXPECT().
+		DeployKeyUpsert(ctx, mock.MatchedBy(func(params gensql.DeployKeyUpsertParams) bool { return params.Team == "team-b" })).
+		Return(keyRow("team-b", expiresSoon, &expiresSoon), nil)
+	querier.EXPECT().
+		DeployKeyUpsert(ctx, mock.MatchedBy(func(params gensql.DeployKeyUpsertParams) bool { return params.Team == "team-c" })).
+		Return(keyRow("team-c", expiresLater, nil), nil)
+	querier.EXPECT().
+		DeployKeyUpsert(ctx, mock.MatchedBy(func(params gensql.DeployKeyUpsertParams) bool { return params.Team == "team-e" })).
+		Return(teamERow, nil)
+	querier.EXPECT().
+		DeployKeyChannelNotified(ctx, gensql.DeployKeyChannelNotifiedParams{Team: "team-a", Channel: "#team-a-dev"}).
+		Return(nil)
+	querier.EXPECT().
+		DeployKeyChannelNotified(ctx, gensql.DeployKeyChannelNotifiedParams{Team: "team-a", Channel: "#team-a"}).
+		Return(nil)
+	querier.EXPECT().
+		DeployKeyChannelNotified(ctx, gensql.DeployKeyChannelNotifiedParams{Team: "team-e", Channel: "#team-e-other"}).
+		Return(nil)
+	querier.EXPECT().
+		DeployKeyNotified(ctx, gensql.DeployKeyNotifiedParams{Team: "team-a", NotifiedExpires: pgtype.Timestamptz{Time: expiresSoon, Valid: true}}).
+		Return(nil)
+
+	notifier := &fakeNotifier{failedChannels: []string{"#team-e"}}
+	log, _ := logrustest.NewNullLogger()
+	checked, err := deploykeys.
+		NewChecker(hookdClient, teamsClient, querier, notifier, log).
+		CheckDeployKeys(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, checked)
+
+	assert.Len(t, notifier.notifications, 3)
+	assert.Equal(t, "#team-a-dev", notifier.notifications[0].channel)
+	assert.Equal(t, "#team-a", notifier.notifications[1].channel)
+	assert.Equal(t, "#team-e-other", notifier.notifications[2].channel)
+	assert.Contains(t, notifier.notifications[0].message, "team team-a expires "+expiresSoon.UTC().Format(time.DateOnly))
+}
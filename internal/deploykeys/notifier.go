@@ -0,0 +1,31 @@
+package deploykeys
+
+import (
+	"context"
+
+	"github.com/nais/console-backend/internal/webhook"
+)
+
+type Notifier interface {
+	// Notify posts a message to a Slack channel
+	Notify(ctx context.Context, channel, message string) error
+}
+
+type slackWebhookNotifier struct {
+	webhook *webhook.Client
+}
+
+// NewSlackWebhookNotifier creates a notifier that posts messages to a Slack incoming webhook, or any service accepting
+// the same payload
+func NewSlackWebhookNotifier(webhookURL string) Notifier {
+	return &slackWebhookNotifier{
+		webhook: webhook.New(webhookURL),
+	}
+}
+
+func (n *slackWebhookNotifier) Notify(ctx context.Context, channel, message string) error {
+	return n.webhook.Post(ctx, map[string]string{
+		"channel": channel,
+		"text":    message,
+	})
+}
@@ -0,0 +1,44 @@
+package deploykeys_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/nais/console-backend/internal/deploykeys"
+	httptest "github.com/nais/console-backend/internal/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlackWebhookNotifier_Notify(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("message is posted to webhook", func(t *testing.T) {
+		server := httptest.NewHttpServerWithHandlers(t, []http.HandlerFunc{
+			func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+				body := map[string]string{}
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, "#channel", body["channel"])
+				assert.Equal(t, "message", body["text"])
+			},
+		})
+
+		err := deploykeys.NewSlackWebhookNotifier(server.URL).Notify(ctx, "#channel", "message")
+		assert.NoError(t, err)
+	})
+
+	t.Run("webhook returns error", func(t *testing.T) {
+		server := httptest.NewHttpServerWithHandlers(t, []http.HandlerFunc{
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+			},
+		})
+
+		err := deploykeys.NewSlackWebhookNotifier(server.URL).Notify(ctx, "#channel", "message")
+		assert.ErrorContains(t, err, "webhook returned 404")
+	})
+}
@@ -0,0 +1,43 @@
+package deployments
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/nais/console-backend/internal/hookd"
+	"github.com/sirupsen/logrus"
+)
+
+// maxBodySize is the max size of a deployment event, in bytes
+const maxBodySize = 1 << 20
+
+// NewHandler returns an HTTP handler that receives deployment events from hookd and stores them. The request body is a
+// single deployment in the same format as returned by the hookd deployments API.
+func NewHandler(store *Store, log logrus.FieldLogger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var deploy hookd.Deploy
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize)).Decode(&deploy); err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				http.Error(w, `{"error": "Request body too large"}`, http.StatusRequestEntityTooLarge)
+				return
+			}
+			http.Error(w, `{"error": "Invalid request body"}`, http.StatusBadRequest)
+			return
+		}
+
+		if deploy.DeploymentInfo.ID == "" {
+			http.Error(w, `{"error": "Missing deployment ID"}`, http.StatusBadRequest)
+			return
+		}
+
+		if err := store.Save(r.Context(), deploy); err != nil {
+			log.WithError(err).Errorf("unable to store deployment event")
+			http.Error(w, `{"error": "Unable to store deployment"}`, http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,90 @@
+package deployments_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nais/console-backend/internal/database"
+	"github.com/nais/console-backend/internal/database/gensql"
+	"github.com/nais/console-backend/internal/deployments"
+	"github.com/nais/console-backend/internal/hookd"
+	logrustest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewHandler(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("invalid request body", func(t *testing.T) {
+		log, _ := logrustest.NewNullLogger()
+		store := deployments.NewStore(hookd.NewMockClient(t), database.NewMockQuerier(t), log)
+
+		recorder := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(ctx, http.MethodPost, "/", strings.NewReader("not json"))
+		deployments.NewHandler(store, log).ServeHTTP(recorder, req)
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "Invalid request body")
+	})
+
+	t.Run("request body too large", func(t *testing.T) {
+		log, _ := logrustest.NewNullLogger()
+		store := deployments.NewStore(hookd.NewMockClient(t), database.NewMockQuerier(t), log)
+
+		body := `{"deployment": {"id": "deploy-1", "team": "` + strings.Repeat("a", 1<<20) + `"}}`
+		recorder := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(ctx, http.MethodPost, "/", strings.NewReader(body))
+		deployments.NewHandler(store, log).ServeHTTP(recorder, req)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "Request body too large")
+	})
+
+	t.Run("missing deployment ID", func(t *testing.T) {
+		log, _ := logrustest.NewNullLogger()
+		store := deployments.NewStore(hookd.NewMockClient(t), database.NewMockQuerier(t), log)
+
+		recorder := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(ctx, http.MethodPost, "/", strings.NewReader(`{"deployment": {"team": "team"}}`))
+		deployments.NewHandler(store, log).ServeHTTP(recorder, req)
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.Contains(t, recorder.Body.String(), "Missing deployment ID")
+	})
+
+	t.Run("deployment is stored", func(t *testing.T) {
+		querier := database.NewMockQuerier(t)
+		querier.EXPECT().
+			DeploymentUpsert(mock.Anything, mock.MatchedBy(func(params gensql.DeploymentUpsertParams) bool {
+				return params.ID == "deploy-1" && params.Team == "team" && params.Env == "dev" && params.Repository == "org/repo" &&
+					params.CommitTimestamp.Valid && params.CommitTimestamp.Time.Equal(time.Date(2023, time.November, 1, 9, 0, 0, 0, time.UTC))
+			})).
+			Return(nil)
+
+		log, _ := logrustest.NewNullLogger()
+		store := deployments.NewStore(hookd.NewMockClient(t), querier, log)
+
+		body := `{"deployment": {"id": "deploy-1", "team": "team", "cluster": "dev", "githubRepository": "org/repo", "created": "2023-11-01T10:00:00Z", "commitTimestamp": "2023-11-01T09:00:00Z"}}`
+		recorder := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(ctx, http.MethodPost, "/", strings.NewReader(body))
+		deployments.NewHandler(store, log).ServeHTTP(recorder, req)
+		assert.Equal(t, http.StatusNoContent, recorder.Code)
+	})
+
+	t.Run("error when storing deployment", func(t *testing.T) {
+		querier := database.NewMockQuerier(t)
+		querier.EXPECT().
+			DeploymentUpsert(mock.Anything, mock.Anything).
+			Return(assert.AnError)
+
+		log, _ := logrustest.NewNullLogger()
+		store := deployments.NewStore(hookd.NewMockClient(t), querier, log)
+
+		recorder := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(ctx, http.MethodPost, "/", strings.NewReader(`{"deployment": {"id": "deploy-1"}}`))
+		deployments.NewHandler(store, log).ServeHTTP(recorder, req)
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	})
+}
@@ -0,0 +1,278 @@
+package deployments
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nais/console-backend/internal/database"
+	"github.com/nais/console-backend/internal/database/gensql"
+	"github.com/nais/console-backend/internal/hookd"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// backfillPageSize is the number of deployments to fetch from hookd, and store, at a time when backfilling
+	backfillPageSize = 500
+
+	// defaultLimit is the max number of deployments to read from the database when no limit is given
+	defaultLimit = 1000
+)
+
+// Store is a hookd client that reads deployments from the database, with hookd as a fallback. All other calls are
+// passed through to hookd.
+type Store struct {
+	hookd.Client
+	querier database.Querier
+	log     logrus.FieldLogger
+
+	// backfilled is set once all deployments have been backfilled from hookd, until then the database is incomplete
+	backfilled atomic.Bool
+}
+
+// NewStore creates a new deployments store
+func NewStore(hookdClient hookd.Client, querier database.Querier, log logrus.FieldLogger) *Store {
+	return &Store{
+		Client:  hookdClient,
+		querier: querier,
+		log:     log,
+	}
+}
+
+// Deployments returns deployments from the database, newest first. Until the deployments have been backfilled, or if the
+// database is unavailable, the deployments will be fetched from hookd instead.
+func (s *Store) Deployments(ctx context.Context, opts ...hookd.RequestOption) ([]hookd.Deploy, error) {
+	if !s.backfilled.Load() {
+		return s.Client.Deployments(ctx, opts...)
+	}
+
+	deploys, err := s.deploymentsFromDatabase(ctx, hookd.FilterFromOptions(opts...))
+	if err != nil {
+		s.log.WithError(err).Warnf("unable to fetch deployments from database, falling back to hookd")
+		return s.Client.Deployments(ctx, opts...)
+	}
+
+	return deploys, nil
+}
+
+// Save stores a deployment along with its statuses and resources. Existing statuses and resources are updated, and
+// new ones are added.
+func (s *Store) Save(ctx context.Context, deploy hookd.Deploy) error {
+	return save(ctx, s.querier, deploy)
+}
+
+// save stores a deployment along with its statuses and resources using the querier
+func save(ctx context.Context, querier gensql.Querier, deploy hookd.Deploy) error {
+	info := deploy.DeploymentInfo
+	params := gensql.DeploymentUpsertParams{
+		ID:         info.ID,
+		Team:       info.Team,
+		Env:        info.Cluster,
+		Repository: info.GithubRepository,
+		Created:    pgtype.Timestamptz{Time: info.Created, Valid: true},
+	}
+	if info.CommitTimestamp != nil {
+		params.CommitTimestamp = pgtype.Timestamptz{Time: *info.CommitTimestamp, Valid: true}
+	}
+
+	err := querier.DeploymentUpsert(ctx, params)
+	if err != nil {
+		return fmt.Errorf("upsert deployment %q: %w", info.ID, err)
+	}
+
+	var batchErr error
+	onError := func(_ int, err error) {
+		if err != nil && batchErr == nil {
+			batchErr = err
+		}
+	}
+
+	if len(deploy.Statuses) > 0 {
+		statuses := make([]gensql.DeploymentStatusUpsertParams, 0, len(deploy.Statuses))
+		for _, status := range deploy.Statuses {
+			statuses = append(statuses, gensql.DeploymentStatusUpsertParams{
+				ID:           status.ID,
+				DeploymentID: info.ID,
+				Status:       status.Status,
+				Message:      status.Message,
+				Created:      pgtype.Timestamptz{Time: status.Created, Valid: true},
+			})
+		}
+		querier.DeploymentStatusUpsert(ctx, statuses).Exec(onError)
+	}
+
+	if len(deploy.Resources) > 0 {
+		resources := make([]gensql.DeploymentResourceUpsertParams, 0, len(deploy.Resources))
+		for _, resource := range deploy.Resources {
+			resources = append(resources, gensql.DeploymentResourceUpsertParams{
+				ID:           resource.ID,
+				DeploymentID: info.ID,
+				Group:        resource.Group,
+				Kind:         resource.Kind,
+				Name:         resource.Name,
+				Version:      resource.Version,
+				Namespace:    resource.Namespace,
+			})
+		}
+		querier.DeploymentResourceUpsert(ctx, resources).Exec(onError)
+	}
+
+	if batchErr != nil {
+		return fmt.Errorf("upsert statuses and resources of deployment %q: %w", info.ID, batchErr)
+	}
+
+	return nil
+}
+
+// Backfill fetches all deployments from hookd, one page at a time, and stores each page in its own transaction.
+// Deployments created while backfilling shift the following pages, so some deployments are fetched twice, which is
+// harmless as they are upserted. Deployments are read from the database once the backfill has finished. Returns the
+// number of deployments stored, including the pages stored before an error.
+func (s *Store) Backfill(ctx context.Context) (int, error) {
+	saved := 0
+	previous := ""
+	for offset := 0; ; offset += backfillPageSize {
+		deploys, err := s.Client.Deployments(ctx, hookd.WithLimit(backfillPageSize), hookd.WithOffset(offset))
+		if err != nil {
+			return saved, fmt.Errorf("unable to fetch deployments from hookd at offset %d: %w", offset, err)
+		}
+
+		if len(deploys) == 0 {
+			break
+		}
+
+		// a hookd without support for offsets returns the first page over and over
+		if deploys[0].DeploymentInfo.ID == previous {
+			return saved, fmt.Errorf("hookd returned the same deployments at offset %d as at the previous offset", offset)
+		}
+		previous = deploys[0].DeploymentInfo.ID
+
+		err = s.querier.Transaction(ctx, func(ctx context.Context, querier gensql.Querier) error {
+			for _, deploy := range deploys {
+				if err := save(ctx, querier, deploy); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return saved, fmt.Errorf("unable to store deployments at offset %d: %w", offset, err)
+		}
+		saved += len(deploys)
+
+		if len(deploys) < backfillPageSize {
+			break
+		}
+	}
+
+	s.backfilled.Store(true)
+	return saved, nil
+}
+
+// deploymentsFromDatabase returns the deployments matching the filter, including statuses and resources. At most
+// defaultLimit deployments are returned when the filter has no limit.
+func (s *Store) deploymentsFromDatabase(ctx context.Context, filter hookd.Filter) ([]hookd.Deploy, error) {
+	params := gensql.DeploymentsParams{
+		Teams:       make([]string, 0),
+		IgnoreTeams: make([]string, 0),
+		Limit:       defaultLimit,
+	}
+	if filter.Team != "" {
+		params.Team = &filter.Team
+	}
+	if filter.Cluster != "" {
+		params.Env = &filter.Cluster
+	}
+	if filter.Repository != "" {
+		params.Repository = &filter.Repository
+	}
+	if filter.ResourceKind != "" {
+		params.ResourceKind = &filter.ResourceKind
+	}
+	if filter.ResourceName != "" {
+		params.ResourceName = &filter.ResourceName
+	}
+	if filter.State != "" {
+		params.State = &filter.State
+	}
+	if !filter.CreatedFrom.IsZero() {
+		params.CreatedFrom = pgtype.Timestamptz{Time: filter.CreatedFrom, Valid: true}
+	}
+	if !filter.CreatedBefore.IsZero() {
+		params.CreatedBefore = pgtype.Timestamptz{Time: filter.CreatedBefore, Valid: true}
+	}
+	if filter.Limit > 0 {
+		params.Limit = int32(filter.Limit)
+	}
+	params.Teams = append(params.Teams, filter.Teams...)
+	params.IgnoreTeams = append(params.IgnoreTeams, filter.IgnoreTeams...)
+
+	rows, err := s.querier.Deployments(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]hookd.Deploy, 0, len(rows))
+	if len(rows) == 0 {
+		return ret, nil
+	}
+
+	ids := make([]string, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.ID)
+	}
+
+	statuses, err := s.querier.DeploymentStatusesForDeployments(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	resources, err := s.querier.DeploymentResourcesForDeployments(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	statusesForDeployment := make(map[string][]hookd.Status)
+	for _, status := range statuses {
+		statusesForDeployment[status.DeploymentID] = append(statusesForDeployment[status.DeploymentID], hookd.Status{
+			ID:      status.ID,
+			Status:  status.Status,
+			Message: status.Message,
+			Created: status.Created.Time,
+		})
+	}
+
+	resourcesForDeployment := make(map[string][]hookd.Resource)
+	for _, resource := range resources {
+		resourcesForDeployment[resource.DeploymentID] = append(resourcesForDeployment[resource.DeploymentID], hookd.Resource{
+			ID:        resource.ID,
+			Group:     resource.Group,
+			Kind:      resource.Kind,
+			Name:      resource.Name,
+			Version:   resource.Version,
+			Namespace: resource.Namespace,
+		})
+	}
+
+	for _, row := range rows {
+		info := hookd.DeploymentInfo{
+			ID:               row.ID,
+			Team:             row.Team,
+			Cluster:          row.Env,
+			Created:          row.Created.Time,
+			GithubRepository: row.Repository,
+		}
+		if row.CommitTimestamp.Valid {
+			info.CommitTimestamp = &row.CommitTimestamp.Time
+		}
+
+		ret = append(ret, hookd.Deploy{
+			DeploymentInfo: info,
+			Statuses:       statusesForDeployment[row.ID],
+			Resources:      resourcesForDeployment[row.ID],
+		})
+	}
+
+	return ret, nil
+}
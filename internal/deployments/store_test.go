@@ -0,0 +1,259 @@
+package deployments_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nais/console-backend/internal/database"
+	"github.com/nais/console-backend/internal/database/gensql"
+	"github.com/nais/console-backend/internal/deployments"
+	"github.com/nais/console-backend/internal/hookd"
+	logrustest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// backfilledStore returns a store that has finished backfilling from hookd, with no deployments in hookd
+func backfilledStore(t *testing.T, hookdClient *hookd.MockClient, querier *database.MockQuerier) *deployments.Store {
+	t.Helper()
+
+	hookdClient.EXPECT().
+		Deployments(mock.Anything, mock.Anything, mock.Anything).
+		Return([]hookd.Deploy{}, nil).
+		Once()
+
+	log, _ := logrustest.NewNullLogger()
+	store := deployments.NewStore(hookdClient, querier, log)
+	_, err := store.Backfill(context.Background())
+	assert.NoError(t, err)
+	return store
+}
+
+func TestStore_Deployments(t *testing.T) {
+	ctx := context.Background()
+	created := time.Date(2023, time.November, 1, 10, 0, 0, 0, time.UTC)
+	committed := created.Add(-time.Hour)
+
+	t.Run("deployments from hookd until backfilled", func(t *testing.T) {
+		hookdClient := hookd.NewMockClient(t)
+		hookdClient.EXPECT().
+			Deployments(ctx, mock.AnythingOfType("hookd.RequestOption")).
+			Return([]hookd.Deploy{{DeploymentInfo: hookd.DeploymentInfo{ID: "deploy-1"}}}, nil)
+
+		log, _ := logrustest.NewNullLogger()
+		deploys, err := deployments.
+			NewStore(hookdClient, database.NewMockQuerier(t), log).
+			Deployments(ctx, hookd.WithTeam("team"))
+		assert.NoError(t, err)
+		assert.Len(t, deploys, 1)
+		assert.Equal(t, "deploy-1", deploys[0].DeploymentInfo.ID)
+	})
+
+	t.Run("deployments from database", func(t *testing.T) {
+		team := "team"
+		querier := database.NewMockQuerier(t)
+		querier.EXPECT().
+			Deployments(ctx, gensql.DeploymentsParams{Team: &team, Teams: []string{}, IgnoreTeams: []string{}, Limit: 10}).
+			Return([]*gensql.Deployment{
+				{ID: "deploy-2", Team: "team", Env: "dev", Repository: "org/repo", Created: pgtype.Timestamptz{Time: created.Add(time.Hour), Valid: true}},
+				{ID: "deploy-1", Team: "team", Env: "prod", Repository: "org/repo", Created: pgtype.Timestamptz{Time: created, Valid: true}, CommitTimestamp: pgtype.Timestamptz{Time: committed, Valid: true}},
+			}, nil)
+		querier.EXPECT().
+			DeploymentStatusesForDeployments(ctx, []string{"deploy-2", "deploy-1"}).
+			Return([]*gensql.DeploymentStatus{
+				{ID: "status-2", DeploymentID: "deploy-1", Status: "success", Created: pgtype.Timestamptz{Time: created.Add(time.Minute), Valid: true}},
+				{ID: "status-1", DeploymentID: "deploy-1", Status: "in_progress", Created: pgtype.Timestamptz{Time: created, Valid: true}},
+			}, nil)
+		querier.EXPECT().
+			DeploymentResourcesForDeployments(ctx, []string{"deploy-2", "deploy-1"}).
+			Return([]*gensql.DeploymentResource{
+				{ID: "resource-1", DeploymentID: "deploy-1", Kind: "Application", Name: "app"},
+				{ID: "resource-2", DeploymentID: "deploy-2", Kind: "Naisjob", Name: "job"},
+			}, nil)
+
+		deploys, err := backfilledStore(t, hookd.NewMockClient(t), querier).
+			Deployments(ctx, hookd.WithTeam("team"), hookd.WithLimit(10))
+		assert.NoError(t, err)
+		assert.Len(t, deploys, 2)
+
+		assert.Equal(t, hookd.DeploymentInfo{
+			ID:               "deploy-2",
+			Team:             "team",
+			Cluster:          "dev",
+			Created:          created.Add(time.Hour),
+			GithubRepository: "org/repo",
+		}, deploys[0].DeploymentInfo)
+		assert.Empty(t, deploys[0].Statuses)
+		assert.Equal(t, []hookd.Resource{{ID: "resource-2", Kind: "Naisjob", Name: "job"}}, deploys[0].Resources)
+
+		assert.Equal(t, "deploy-1", deploys[1].DeploymentInfo.ID)
+		assert.Equal(t, &committed, deploys[1].DeploymentInfo.CommitTimestamp)
+		assert.Len(t, deploys[1].Statuses, 2)
+		assert.Equal(t, "success", deploys[1].Statuses[0].Status)
+		assert.Equal(t, []hookd.Resource{{ID: "resource-1", Kind: "Application", Name: "app"}}, deploys[1].Resources)
+	})
+
+	t.Run("filter is passed on to the database", func(t *testing.T) {
+		team, env, repository, kind, name, state := "team", "dev", "org/repo", "Application", "app", hookd.StateFailure
+		querier := database.NewMockQuerier(t)
+		querier.EXPECT().
+			Deployments(ctx, gensql.DeploymentsParams{
+				Team:          &team,
+				Env:           &env,
+				Teams:         []string{"team-a", "team-b"},
+				IgnoreTeams:   []string{},
+				Repository:    &repository,
+				ResourceKind:  &kind,
+				ResourceName:  &name,
+				State:         &state,
+				CreatedFrom:   pgtype.Timestamptz{Time: created, Valid: true},
+				CreatedBefore: pgtype.Timestamptz{Time: created.AddDate(0, 0, 1), Valid: true},
+				Limit:         5,
+			}).
+			Return([]*gensql.Deployment{}, nil)
+
+		deploys, err := backfilledStore(t, hookd.NewMockClient(t), querier).
+			Deployments(
+				ctx,
+				hookd.WithTeam(team),
+				hookd.WithCluster(env),
+				hookd.WithTeams("team-a", "team-b"),
+				hookd.WithRepository(repository),
+				hookd.WithResourceKind(kind),
+				hookd.WithResourceName(name),
+				hookd.WithState(state),
+				hookd.WithCreatedFrom(created),
+				hookd.WithCreatedBefore(created.AddDate(0, 0, 1)),
+				hookd.WithLimit(5),
+			)
+		assert.NoError(t, err)
+		assert.Empty(t, deploys)
+	})
+
+	t.Run("fall back to hookd when the database fails", func(t *testing.T) {
+		querier := database.NewMockQuerier(t)
+		querier.EXPECT().
+			Deployments(ctx, gensql.DeploymentsParams{Teams: []string{}, IgnoreTeams: []string{"nais-verification"}, Limit: 1000}).
+			Return(nil, assert.AnError)
+
+		hookdClient := hookd.NewMockClient(t)
+		hookdClient.EXPECT().
+			Deployments(ctx, mock.AnythingOfType("hookd.RequestOption")).
+			Return([]hookd.Deploy{{DeploymentInfo: hookd.DeploymentInfo{ID: "deploy-1"}}}, nil)
+
+		deploys, err := backfilledStore(t, hookdClient, querier).
+			Deployments(ctx, hookd.WithIgnoreTeams("nais-verification"))
+		assert.NoError(t, err)
+		assert.Len(t, deploys, 1)
+		assert.Equal(t, "deploy-1", deploys[0].DeploymentInfo.ID)
+	})
+}
+
+func TestStore_Backfill(t *testing.T) {
+	ctx := context.Background()
+	created := time.Date(2023, time.November, 1, 10, 0, 0, 0, time.UTC)
+
+	// pages returns the deploys of the page given by the limit and offset of the request options
+	pages := func(deploys []hookd.Deploy) func(context.Context, ...hookd.RequestOption) ([]hookd.Deploy, error) {
+		return func(_ context.Context, opts ...hookd.RequestOption) ([]hookd.Deploy, error) {
+			filter := hookd.FilterFromOptions(opts...)
+			start := min(filter.Offset, len(deploys))
+			return deploys[start:min(start+filter.Limit, len(deploys))], nil
+		}
+	}
+
+	deploys := make([]hookd.Deploy, 0)
+	for i := 0; i < 501; i++ {
+		deploys = append(deploys, hookd.Deploy{
+			DeploymentInfo: hookd.DeploymentInfo{ID: fmt.Sprintf("deploy-%d", i), Team: "team", Cluster: "dev", Created: created},
+		})
+	}
+
+	transactions := func(t *testing.T, tx gensql.Querier) *database.MockQuerier {
+		querier := database.NewMockQuerier(t)
+		querier.EXPECT().
+			Transaction(ctx, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context, gensql.Querier) error) error {
+				return fn(ctx, tx)
+			})
+		return querier
+	}
+
+	t.Run("error when fetching deployments from hookd", func(t *testing.T) {
+		hookdClient := hookd.NewMockClient(t)
+		hookdClient.EXPECT().
+			Deployments(ctx, mock.Anything, mock.Anything).
+			Return(nil, assert.AnError)
+
+		log, _ := logrustest.NewNullLogger()
+		saved, err := deployments.NewStore(hookdClient, database.NewMockQuerier(t), log).Backfill(ctx)
+		assert.Equal(t, 0, saved)
+		assert.ErrorContains(t, err, "unable to fetch deployments from hookd at offset 0")
+	})
+
+	t.Run("each page stored in its own transaction", func(t *testing.T) {
+		hookdClient := hookd.NewMockClient(t)
+		hookdClient.EXPECT().
+			Deployments(ctx, mock.Anything, mock.Anything).
+			RunAndReturn(pages(deploys)).
+			Times(2)
+
+		tx := gensql.NewMockQuerier(t)
+		tx.EXPECT().
+			DeploymentUpsert(ctx, mock.AnythingOfType("gensql.DeploymentUpsertParams")).
+			Return(nil).
+			Times(501)
+		querier := transactions(t, tx)
+
+		log, _ := logrustest.NewNullLogger()
+		saved, err := deployments.NewStore(hookdClient, querier, log).Backfill(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 501, saved)
+		querier.AssertNumberOfCalls(t, "Transaction", 2)
+	})
+
+	t.Run("pages stored before a failure are kept", func(t *testing.T) {
+		hookdClient := hookd.NewMockClient(t)
+		hookdClient.EXPECT().
+			Deployments(ctx, mock.Anything, mock.Anything).
+			RunAndReturn(pages(deploys)).
+			Times(2)
+
+		tx := gensql.NewMockQuerier(t)
+		tx.EXPECT().
+			DeploymentUpsert(ctx, mock.AnythingOfType("gensql.DeploymentUpsertParams")).
+			Return(nil).
+			Times(500)
+		tx.EXPECT().
+			DeploymentUpsert(ctx, gensql.DeploymentUpsertParams{ID: "deploy-500", Team: "team", Env: "dev", Created: pgtype.Timestamptz{Time: created, Valid: true}}).
+			Return(assert.AnError).
+			Once()
+
+		log, _ := logrustest.NewNullLogger()
+		saved, err := deployments.NewStore(hookdClient, transactions(t, tx), log).Backfill(ctx)
+		assert.Equal(t, 500, saved)
+		assert.ErrorContains(t, err, `unable to store deployments at offset 500: upsert deployment "deploy-500"`)
+	})
+
+	t.Run("hookd without support for offsets", func(t *testing.T) {
+		hookdClient := hookd.NewMockClient(t)
+		hookdClient.EXPECT().
+			Deployments(ctx, mock.Anything, mock.Anything).
+			Return(deploys[:500], nil).
+			Times(2)
+
+		tx := gensql.NewMockQuerier(t)
+		tx.EXPECT().
+			DeploymentUpsert(ctx, mock.AnythingOfType("gensql.DeploymentUpsertParams")).
+			Return(nil).
+			Times(500)
+
+		log, _ := logrustest.NewNullLogger()
+		saved, err := deployments.NewStore(hookdClient, transactions(t, tx), log).Backfill(ctx)
+		assert.Equal(t, 500, saved)
+		assert.EqualError(t, err, "hookd returned the same deployments at offset 500 as at the previous offset")
+	})
+}
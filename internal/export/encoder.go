@@ -0,0 +1,87 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Format is the format of an export
+type Format string
+
+const (
+	FormatCSV    Format = "csv"
+	FormatNDJSON Format = "ndjson"
+)
+
+// record is a single row of an export
+type record interface {
+	// csvRecord returns the values of the record in the same order as the header of the export
+	csvRecord() []string
+}
+
+// encoder writes the records of an export in a format
+type encoder interface {
+	write(r record) error
+
+	// flush writes buffered records to the underlying writer
+	flush() error
+}
+
+type csvEncoder struct {
+	w *csv.Writer
+}
+
+func (e *csvEncoder) write(r record) error {
+	return e.w.Write(r.csvRecord())
+}
+
+func (e *csvEncoder) flush() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+type ndjsonEncoder struct {
+	enc *json.Encoder
+}
+
+func (e *ndjsonEncoder) write(r record) error {
+	return e.enc.Encode(r)
+}
+
+func (e *ndjsonEncoder) flush() error {
+	return nil
+}
+
+// newEncoder returns an encoder for the format, and sets the content headers of the response. The header is the first
+// line of CSV exports.
+func newEncoder(w http.ResponseWriter, format Format, filename string, header []string) (encoder, error) {
+	switch format {
+	case FormatNDJSON:
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename+".ndjson"))
+		return &ndjsonEncoder{enc: json.NewEncoder(w)}, nil
+	default:
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename+".csv"))
+		enc := &csvEncoder{w: csv.NewWriter(w)}
+		if err := enc.w.Write(header); err != nil {
+			return nil, err
+		}
+		return enc, nil
+	}
+}
+
+// flushResponse flushes the encoder and the response, so that each page of an export is sent to the client before the
+// next page is read
+func flushResponse(w io.Writer, enc encoder) error {
+	if err := enc.flush(); err != nil {
+		return err
+	}
+	if f, ok := w.(http.Flusher); ok {
+		f.Flush()
+	}
+	return nil
+}
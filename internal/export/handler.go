@@ -0,0 +1,280 @@
+package export
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nais/console-backend/internal/database/gensql"
+	"github.com/sirupsen/logrus"
+)
+
+const defaultPageSize = 1000
+
+// Handler streams cost and resource utilization data of a team as CSV or newline-delimited JSON. The data is read from
+// the database in pages, and each page is sent to the client before the next is read, so that memory usage is bounded
+// regardless of the size of the date range.
+type Handler struct {
+	querier  gensql.Querier
+	log      logrus.FieldLogger
+	pageSize int32
+}
+
+// Option is a function that can be used to set custom options for the export handler
+type Option func(*Handler)
+
+// WithPageSize sets the number of rows read from the database at a time
+func WithPageSize(pageSize int32) Option {
+	return func(h *Handler) {
+		h.pageSize = pageSize
+	}
+}
+
+// NewHandler creates a new export handler
+func NewHandler(querier gensql.Querier, log logrus.FieldLogger, opts ...Option) *Handler {
+	h := &Handler{
+		querier:  querier,
+		log:      log,
+		pageSize: defaultPageSize,
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+// params are the query parameters of an export
+type params struct {
+	team   string
+	env    *string
+	app    *string
+	from   time.Time
+	to     time.Time
+	format Format
+}
+
+// filename returns the name of the export file, without extension
+func (p params) filename(kind string) string {
+	return fmt.Sprintf("%s-%s-%s-%s", kind, p.team, p.from.Format(time.DateOnly), p.to.Format(time.DateOnly))
+}
+
+// parseParams parses the query parameters of an export. The team, and the from and to dates in the YYYY-MM-DD format,
+// are required. The env and app parameters are optional, and the format is either csv, the default, or ndjson.
+func parseParams(r *http.Request) (params, error) {
+	q := r.URL.Query()
+	p := params{
+		team:   q.Get("team"),
+		format: FormatCSV,
+	}
+
+	if p.team == "" {
+		return p, fmt.Errorf("missing team")
+	}
+
+	if env := q.Get("env"); env != "" {
+		p.env = &env
+	}
+
+	if app := q.Get("app"); app != "" {
+		p.app = &app
+	}
+
+	var err error
+	if p.from, err = time.Parse(time.DateOnly, q.Get("from")); err != nil {
+		return p, fmt.Errorf("invalid from date, expected YYYY-MM-DD")
+	}
+
+	if p.to, err = time.Parse(time.DateOnly, q.Get("to")); err != nil {
+		return p, fmt.Errorf("invalid to date, expected YYYY-MM-DD")
+	}
+
+	if p.from.After(p.to) {
+		return p, fmt.Errorf("from date cannot be after to date")
+	}
+
+	if format := q.Get("format"); format != "" {
+		p.format = Format(format)
+		if p.format != FormatCSV && p.format != FormatNDJSON {
+			return p, fmt.Errorf("invalid format, expected csv or ndjson")
+		}
+	}
+
+	return p, nil
+}
+
+type costRecord struct {
+	Date     string  `json:"date"`
+	Team     string  `json:"team"`
+	Env      string  `json:"env"`
+	App      string  `json:"app"`
+	CostType string  `json:"costType"`
+	Cost     float32 `json:"cost"`
+}
+
+var costHeader = []string{"date", "team", "env", "app", "cost_type", "cost"}
+
+func (c costRecord) csvRecord() []string {
+	return []string{c.Date, c.Team, c.Env, c.App, c.CostType, strconv.FormatFloat(float64(c.Cost), 'f', -1, 32)}
+}
+
+// Cost streams the daily cost of a team in a date range
+func (h *Handler) Cost(w http.ResponseWriter, r *http.Request) {
+	p, err := parseParams(r)
+	if err != nil {
+		http.Error(w, fmt.Sprintf(`{"error": %q}`, err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	enc, err := newEncoder(w, p.format, p.filename("cost"), costHeader)
+	if err != nil {
+		h.error(w, err, false)
+		return
+	}
+
+	afterDate := pgtype.Date{Time: p.from, Valid: true}
+	afterID := int32(0)
+	for page := 0; ; page++ {
+		rows, err := h.querier.CostExport(r.Context(), gensql.CostExportParams{
+			Team:      &p.team,
+			Env:       p.env,
+			App:       p.app,
+			FromDate:  pgtype.Date{Time: p.from, Valid: true},
+			ToDate:    pgtype.Date{Time: p.to, Valid: true},
+			AfterDate: afterDate,
+			AfterID:   afterID,
+			PageSize:  h.pageSize,
+		})
+		if err != nil {
+			h.error(w, err, page > 0)
+			return
+		}
+
+		for _, row := range rows {
+			err := enc.write(costRecord{
+				Date:     row.Date.Time.Format(time.DateOnly),
+				Team:     row.Team,
+				Env:      row.Env,
+				App:      row.App,
+				CostType: row.CostType,
+				Cost:     row.DailyCost,
+			})
+			if err != nil {
+				h.error(w, err, true)
+				return
+			}
+		}
+
+		if err := flushResponse(w, enc); err != nil {
+			h.error(w, err, true)
+			return
+		}
+
+		if len(rows) < int(h.pageSize) {
+			return
+		}
+		afterDate = rows[len(rows)-1].Date
+		afterID = rows[len(rows)-1].ID
+	}
+}
+
+type utilizationRecord struct {
+	Timestamp    time.Time `json:"timestamp"`
+	Team         string    `json:"team"`
+	Env          string    `json:"env"`
+	App          string    `json:"app"`
+	ResourceType string    `json:"resourceType"`
+	Usage        float64   `json:"usage"`
+	Request      float64   `json:"request"`
+}
+
+var utilizationHeader = []string{"timestamp", "team", "env", "app", "resource_type", "usage", "request"}
+
+func (u utilizationRecord) csvRecord() []string {
+	return []string{
+		u.Timestamp.Format(time.RFC3339),
+		u.Team,
+		u.Env,
+		u.App,
+		u.ResourceType,
+		strconv.FormatFloat(u.Usage, 'f', -1, 64),
+		strconv.FormatFloat(u.Request, 'f', -1, 64),
+	}
+}
+
+// Utilization streams the resource utilization of a team in a date range
+func (h *Handler) Utilization(w http.ResponseWriter, r *http.Request) {
+	p, err := parseParams(r)
+	if err != nil {
+		http.Error(w, fmt.Sprintf(`{"error": %q}`, err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	enc, err := newEncoder(w, p.format, p.filename("utilization"), utilizationHeader)
+	if err != nil {
+		h.error(w, err, false)
+		return
+	}
+
+	start := pgtype.Timestamptz{Time: p.from, Valid: true}
+	afterTimestamp := start
+	afterID := int32(0)
+	for page := 0; ; page++ {
+		rows, err := h.querier.ResourceUtilizationExport(r.Context(), gensql.ResourceUtilizationExportParams{
+			Team:           p.team,
+			Env:            p.env,
+			App:            p.app,
+			Start:          start,
+			End:            pgtype.Timestamptz{Time: p.to.AddDate(0, 0, 1), Valid: true},
+			AfterTimestamp: afterTimestamp,
+			AfterID:        afterID,
+			PageSize:       h.pageSize,
+		})
+		if err != nil {
+			h.error(w, err, page > 0)
+			return
+		}
+
+		for _, row := range rows {
+			err := enc.write(utilizationRecord{
+				Timestamp:    row.Timestamp.Time.UTC(),
+				Team:         row.Team,
+				Env:          row.Env,
+				App:          row.App,
+				ResourceType: string(row.ResourceType),
+				Usage:        row.Usage,
+				Request:      row.Request,
+			})
+			if err != nil {
+				h.error(w, err, true)
+				return
+			}
+		}
+
+		if err := flushResponse(w, enc); err != nil {
+			h.error(w, err, true)
+			return
+		}
+
+		if len(rows) < int(h.pageSize) {
+			return
+		}
+		afterTimestamp = rows[len(rows)-1].Timestamp
+		afterID = rows[len(rows)-1].ID
+	}
+}
+
+// error logs an error during an export. If parts of the export have already been sent, the status can no longer be
+// changed, and the export is left incomplete.
+func (h *Handler) error(w http.ResponseWriter, err error, sent bool) {
+	h.log.WithError(err).Errorf("unable to export data")
+	if sent {
+		return
+	}
+
+	w.Header().Del("Content-Disposition")
+	http.Error(w, `{"error": "Unable to export data"}`, http.StatusInternalServerError)
+}
@@ -0,0 +1,146 @@
+package export_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nais/console-backend/internal/database/gensql"
+	"github.com/nais/console-backend/internal/export"
+	logrustest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+)
+
+func date(year int, month time.Month, day int) pgtype.Date {
+	return pgtype.Date{Time: time.Date(year, month, day, 0, 0, 0, 0, time.UTC), Valid: true}
+}
+
+func TestHandler_Cost(t *testing.T) {
+	ctx := context.Background()
+	log, _ := logrustest.NewNullLogger()
+	team := "team"
+	env := "prod"
+
+	t.Run("invalid parameters", func(t *testing.T) {
+		tests := map[string]string{
+			"/export/cost?from=2023-10-01&to=2023-10-02":                       "missing team",
+			"/export/cost?team=team&from=2023-10-01":                           "invalid to date",
+			"/export/cost?team=team&from=10/01/2023&to=2023-10-02":             "invalid from date",
+			"/export/cost?team=team&from=2023-10-02&to=2023-10-01":             "from date cannot be after to date",
+			"/export/cost?team=team&from=2023-10-01&to=2023-10-02&format=xlsx": "invalid format",
+		}
+		for target, expected := range tests {
+			recorder := httptest.NewRecorder()
+			req, _ := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+			export.NewHandler(gensql.NewMockQuerier(t), log).Cost(recorder, req)
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			assert.Contains(t, recorder.Body.String(), expected)
+		}
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		querier := gensql.NewMockQuerier(t)
+		querier.EXPECT().CostExport(ctx, gensql.CostExportParams{
+			Team:      &team,
+			FromDate:  date(2023, time.October, 1),
+			ToDate:    date(2023, time.October, 2),
+			AfterDate: date(2023, time.October, 1),
+			PageSize:  1000,
+		}).Return(nil, fmt.Errorf("some error"))
+
+		recorder := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "/export/cost?team=team&from=2023-10-01&to=2023-10-02", nil)
+		export.NewHandler(querier, log).Cost(recorder, req)
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+		assert.Empty(t, recorder.Header().Get("Content-Disposition"))
+	})
+
+	t.Run("csv is read in pages", func(t *testing.T) {
+		querier := gensql.NewMockQuerier(t)
+		querier.EXPECT().CostExport(ctx, gensql.CostExportParams{
+			Team:      &team,
+			Env:       &env,
+			FromDate:  date(2023, time.October, 1),
+			ToDate:    date(2023, time.October, 2),
+			AfterDate: date(2023, time.October, 1),
+			PageSize:  2,
+		}).Return([]*gensql.CostExportRow{
+			{ID: 10, Date: date(2023, time.October, 1), Team: team, Env: env, App: "app", CostType: "Cloud SQL", DailyCost: 1.1},
+			{ID: 12, Date: date(2023, time.October, 1), Team: team, Env: env, App: "app", CostType: "Compute Engine", DailyCost: 2},
+		}, nil)
+		querier.EXPECT().CostExport(ctx, gensql.CostExportParams{
+			Team:      &team,
+			Env:       &env,
+			FromDate:  date(2023, time.October, 1),
+			ToDate:    date(2023, time.October, 2),
+			AfterDate: date(2023, time.October, 1),
+			AfterID:   12,
+			PageSize:  2,
+		}).Return([]*gensql.CostExportRow{
+			{ID: 11, Date: date(2023, time.October, 2), Team: team, Env: env, App: "app", CostType: "Cloud SQL", DailyCost: 1.3},
+		}, nil)
+
+		recorder := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "/export/cost?team=team&env=prod&from=2023-10-01&to=2023-10-02", nil)
+		export.NewHandler(querier, log, export.WithPageSize(2)).Cost(recorder, req)
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "text/csv; charset=utf-8", recorder.Header().Get("Content-Type"))
+		assert.Equal(t, `attachment; filename="cost-team-2023-10-01-2023-10-02.csv"`, recorder.Header().Get("Content-Disposition"))
+		assert.Equal(t, "date,team,env,app,cost_type,cost\n"+
+			"2023-10-01,team,prod,app,Cloud SQL,1.1\n"+
+			"2023-10-01,team,prod,app,Compute Engine,2\n"+
+			"2023-10-02,team,prod,app,Cloud SQL,1.3\n", recorder.Body.String())
+	})
+
+	t.Run("ndjson", func(t *testing.T) {
+		querier := gensql.NewMockQuerier(t)
+		querier.EXPECT().CostExport(ctx, gensql.CostExportParams{
+			Team:      &team,
+			FromDate:  date(2023, time.October, 1),
+			ToDate:    date(2023, time.October, 1),
+			AfterDate: date(2023, time.October, 1),
+			PageSize:  1000,
+		}).Return([]*gensql.CostExportRow{
+			{ID: 1, Date: date(2023, time.October, 1), Team: team, Env: env, App: "app", CostType: "Cloud SQL", DailyCost: 1.1},
+		}, nil)
+
+		recorder := httptest.NewRecorder()
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "/export/cost?team=team&from=2023-10-01&to=2023-10-01&format=ndjson", nil)
+		export.NewHandler(querier, log).Cost(recorder, req)
+		assert.Equal(t, "application/x-ndjson", recorder.Header().Get("Content-Type"))
+		assert.Equal(t, `{"date":"2023-10-01","team":"team","env":"prod","app":"app","costType":"Cloud SQL","cost":1.1}`+"\n", recorder.Body.String())
+	})
+}
+
+func TestHandler_Utilization(t *testing.T) {
+	ctx := context.Background()
+	log, _ := logrustest.NewNullLogger()
+	app := "app"
+	timestamp := time.Date(2023, time.October, 1, 12, 0, 0, 0, time.UTC)
+
+	querier := gensql.NewMockQuerier(t)
+	querier.EXPECT().ResourceUtilizationExport(ctx, gensql.ResourceUtilizationExportParams{
+		Team:           "team",
+		App:            &app,
+		Start:          pgtype.Timestamptz{Time: time.Date(2023, time.October, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+		End:            pgtype.Timestamptz{Time: time.Date(2023, time.October, 2, 0, 0, 0, 0, time.UTC), Valid: true},
+		AfterTimestamp: pgtype.Timestamptz{Time: time.Date(2023, time.October, 1, 0, 0, 0, 0, time.UTC), Valid: true},
+		PageSize:       1000,
+	}).Return([]*gensql.ResourceUtilizationMetric{
+		{ID: 1, Timestamp: pgtype.Timestamptz{Time: timestamp, Valid: true}, Env: "prod", Team: "team", App: app, ResourceType: gensql.ResourceTypeCpu, Usage: 0.25, Request: 1},
+		{ID: 2, Timestamp: pgtype.Timestamptz{Time: timestamp, Valid: true}, Env: "prod", Team: "team", App: app, ResourceType: gensql.ResourceTypeMemory, Usage: 536870912, Request: 1073741824},
+	}, nil)
+
+	recorder := httptest.NewRecorder()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "/export/utilization?team=team&app=app&from=2023-10-01&to=2023-10-01", nil)
+	export.NewHandler(querier, log).Utilization(recorder, req)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, `attachment; filename="utilization-team-2023-10-01-2023-10-01.csv"`, recorder.Header().Get("Content-Disposition"))
+	assert.Equal(t, "timestamp,team,env,app,resource_type,usage,request\n"+
+		"2023-10-01T12:00:00Z,team,prod,app,cpu,0.25,1\n"+
+		"2023-10-01T12:00:00Z,team,prod,app,memory,536870912,1073741824\n", recorder.Body.String())
+}
@@ -8,20 +8,25 @@ import (
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/nais/console-backend/internal/upstream"
 	"github.com/sirupsen/logrus"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 var (
-	ErrInternal         = Errorf("The server errored out while processing your request, and we didn't write a suitable error message. You might consider that a bug on our side. Please try again, and if the error persists, contact the NAIS team.")
-	ErrDatabase         = Errorf("The database encountered an error while processing your request. This is probably a transient error, please try again. If the error persists, contact the NAIS team.")
-	ErrAppNotFound      = Errorf("We were unable to find the app you were looking for.")
-	ErrAppTeamNotFound  = Errorf("NAIS Teams could not find the team which owns the application.")
-	ErrTeamNotFound     = Errorf("We were unable to find the team you were looking for.")
-	ErrNoEmailInSession = Errorf("No email address found in the session. This is most likely a bug in the backend. Please try again, and if the error persists, contact the NAIS team.")
-	ErrUserNotFound     = func(email string) Error {
+	ErrInternal            = Errorf("The server errored out while processing your request, and we didn't write a suitable error message. You might consider that a bug on our side. Please try again, and if the error persists, contact the NAIS team.")
+	ErrDatabase            = Errorf("The database encountered an error while processing your request. This is probably a transient error, please try again. If the error persists, contact the NAIS team.")
+	ErrAppNotFound         = Errorf("We were unable to find the app you were looking for.")
+	ErrAppTeamNotFound     = Errorf("NAIS Teams could not find the team which owns the application.")
+	ErrTeamNotFound        = Errorf("We were unable to find the team you were looking for.")
+	ErrNoEmailInSession    = Errorf("No email address found in the session. This is most likely a bug in the backend. Please try again, and if the error persists, contact the NAIS team.")
+	ErrTenantAdminRequired = Errorf("You need to be an administrator of the tenant to see this information.")
+	ErrUserNotFound        = func(email string) Error {
 		return Errorf("We were unable to find a user with the email address you are currently signed in with: %q", email)
 	}
+	ErrUpstreamUnavailable = func(upstream string) Error {
+		return Errorf("The %s service is currently unavailable. Please try again in a little while.", upstream)
+	}
 )
 
 // Error is an error that can be presented to end-users
@@ -48,6 +53,13 @@ func GetErrorPresenter(log logrus.FieldLogger) graphql.ErrorPresenterFunc {
 		gqlError := graphql.DefaultErrorPresenter(ctx, err)
 		unwrappedError := errors.Unwrap(err)
 
+		var unavailableError *upstream.UnavailableError
+		if errors.As(unwrappedError, &unavailableError) {
+			gqlError.Message = ErrUpstreamUnavailable(unavailableError.Upstream).Error()
+			gqlError.Extensions = map[string]any{"code": "UPSTREAM_UNAVAILABLE", "upstream": unavailableError.Upstream}
+			return gqlError
+		}
+
 		switch originalError := unwrappedError.(type) {
 		default:
 			break
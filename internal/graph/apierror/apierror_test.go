@@ -4,11 +4,13 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/nais/console-backend/internal/graph/apierror"
+	"github.com/nais/console-backend/internal/upstream"
 	"github.com/sirupsen/logrus"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
@@ -56,6 +58,15 @@ func TestError(t *testing.T) {
 		assert.ErrorContains(t, err, "Object was not found")
 	})
 
+	t.Run("upstream unavailable", func(t *testing.T) {
+		defer hook.Reset()
+
+		err := presenterFunc(ctx, graphql.DefaultErrorPresenter(ctx, fmt.Errorf("calling hookd: %w", &upstream.UnavailableError{Upstream: "hookd"})))
+		assert.ErrorContains(t, err, "The hookd service is currently unavailable")
+		assert.Equal(t, "UPSTREAM_UNAVAILABLE", err.Extensions["code"])
+		assert.Empty(t, hook.Entries)
+	})
+
 	t.Run("context canceled", func(t *testing.T) {
 		defer hook.Reset()
 
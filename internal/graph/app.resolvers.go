@@ -7,10 +7,14 @@ package graph
 import (
 	"context"
 	"fmt"
+	"slices"
 
+	"github.com/nais/console-backend/internal/database/gensql"
 	"github.com/nais/console-backend/internal/dependencytrack"
 	"github.com/nais/console-backend/internal/graph/apierror"
 	"github.com/nais/console-backend/internal/graph/model"
+	"github.com/nais/console-backend/internal/graph/model/vulnerabilities"
+	"github.com/nais/console-backend/internal/graph/scalar"
 )
 
 // Instances is the resolver for the instances field.
@@ -51,6 +55,151 @@ func (r *appResolver) Vulnerabilities(ctx context.Context, obj *model.App) (*mod
 	return r.dependencyTrackClient.VulnerabilitySummary(ctx, &dependencytrack.AppInstance{Env: obj.Env.Name, Team: obj.GQLVars.Team, App: obj.Name, Image: obj.Image})
 }
 
+// VulnerabilityFindings is the resolver for the vulnerabilityFindings field.
+func (r *appResolver) VulnerabilityFindings(ctx context.Context, obj *model.App, first *int, last *int, after *scalar.Cursor, before *scalar.Cursor, orderBy *model.OrderBy) (*model.VulnerabilityFindingConnection, error) {
+	findings, err := r.dependencyTrackClient.VulnerabilityFindings(ctx, &dependencytrack.AppInstance{Env: obj.Env.Name, Team: obj.GQLVars.Team, App: obj.Name, Image: obj.Image})
+	if err != nil {
+		return nil, fmt.Errorf("getting vulnerability findings from DependencyTrack: %w", err)
+	}
+
+	// the findings are shared with the cache, so they are sorted on a copy
+	findings = slices.Clone(findings)
+	if orderBy == nil {
+		orderBy = &model.OrderBy{Field: model.OrderByFieldSeverity, Direction: model.SortOrderDesc}
+	}
+	vulnerabilities.SortFindings(findings, orderBy.Field, orderBy.Direction)
+
+	pagination, err := model.NewPagination(first, last, after, before)
+	if err != nil {
+		return nil, err
+	}
+	edges := make([]model.VulnerabilityFindingEdge, 0)
+	start, end := pagination.ForSlice(len(findings))
+
+	for i, f := range findings[start:end] {
+		edges = append(edges, model.VulnerabilityFindingEdge{
+			Cursor: scalar.Cursor{Offset: start + i},
+			Node:   *f,
+		})
+	}
+
+	var startCursor *scalar.Cursor
+	var endCursor *scalar.Cursor
+	if len(edges) > 0 {
+		startCursor = &edges[0].Cursor
+		endCursor = &edges[len(edges)-1].Cursor
+	}
+
+	hasNext := len(findings) > pagination.First()+pagination.After().Offset+1
+	hasPrevious := pagination.After().Offset > 0
+
+	if pagination.Before() != nil && startCursor != nil {
+		hasNext = true
+		hasPrevious = startCursor.Offset > 0
+	}
+
+	return &model.VulnerabilityFindingConnection{
+		TotalCount: len(findings),
+		Edges:      edges,
+		PageInfo: model.PageInfo{
+			HasNextPage:     hasNext,
+			HasPreviousPage: hasPrevious,
+			StartCursor:     startCursor,
+			EndCursor:       endCursor,
+		},
+	}, nil
+}
+
+// PolicyViolations is the resolver for the policyViolations field.
+func (r *appResolver) PolicyViolations(ctx context.Context, obj *model.App, first *int, last *int, after *scalar.Cursor, before *scalar.Cursor) (*model.PolicyViolationConnection, error) {
+	violations, err := r.dependencyTrackClient.PolicyViolations(ctx, &dependencytrack.AppInstance{Env: obj.Env.Name, Team: obj.GQLVars.Team, App: obj.Name, Image: obj.Image})
+	if err != nil {
+		return nil, fmt.Errorf("getting policy violations from DependencyTrack: %w", err)
+	}
+
+	pagination, err := model.NewPagination(first, last, after, before)
+	if err != nil {
+		return nil, err
+	}
+	edges := make([]model.PolicyViolationEdge, 0)
+	start, end := pagination.ForSlice(len(violations))
+
+	for i, v := range violations[start:end] {
+		edges = append(edges, model.PolicyViolationEdge{
+			Cursor: scalar.Cursor{Offset: start + i},
+			Node:   *v,
+		})
+	}
+
+	var startCursor *scalar.Cursor
+	var endCursor *scalar.Cursor
+	if len(edges) > 0 {
+		startCursor = &edges[0].Cursor
+		endCursor = &edges[len(edges)-1].Cursor
+	}
+
+	hasNext := len(violations) > pagination.First()+pagination.After().Offset+1
+	hasPrevious := pagination.After().Offset > 0
+
+	if pagination.Before() != nil && startCursor != nil {
+		hasNext = true
+		hasPrevious = startCursor.Offset > 0
+	}
+
+	return &model.PolicyViolationConnection{
+		TotalCount: len(violations),
+		Edges:      edges,
+		PageInfo: model.PageInfo{
+			HasNextPage:     hasNext,
+			HasPreviousPage: hasPrevious,
+			StartCursor:     startCursor,
+			EndCursor:       endCursor,
+		},
+	}, nil
+}
+
+// VulnerabilityHistory is the resolver for the vulnerabilityHistory field.
+func (r *appResolver) VulnerabilityHistory(ctx context.Context, obj *model.App, from scalar.Date, to scalar.Date) (*model.VulnerabilityHistory, error) {
+	err := ValidateDateInterval(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	fromDate, err := from.PgDate()
+	if err != nil {
+		return nil, err
+	}
+
+	toDate, err := to.PgDate()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.querier.VulnerabilitySnapshotsForApp(ctx, gensql.VulnerabilitySnapshotsForAppParams{
+		Team:     obj.GQLVars.Team,
+		Env:      obj.Env.Name,
+		App:      obj.Name,
+		FromDate: fromDate,
+		ToDate:   toDate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("vulnerability snapshots query: %w", err)
+	}
+
+	remediation, err := r.querier.CriticalFindingsRemediation(ctx, gensql.CriticalFindingsRemediationParams{
+		Team:     obj.GQLVars.Team,
+		Env:      &obj.Env.Name,
+		App:      &obj.Name,
+		FromDate: fromDate,
+		ToDate:   toDate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("critical findings remediation query: %w", err)
+	}
+
+	return VulnerabilityHistoryFromDatabaseRows(rows, remediation), nil
+}
+
 // App is the resolver for the app field.
 func (r *queryResolver) App(ctx context.Context, name string, team string, env string) (*model.App, error) {
 	app, err := r.k8sClient.App(ctx, name, team, env)
@@ -1,10 +1,15 @@
 package graph
 
 import (
+	"context"
 	"fmt"
+	"sort"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nais/console-backend/internal/cost"
 	"github.com/nais/console-backend/internal/database/gensql"
+	"github.com/nais/console-backend/internal/graph/apierror"
 	"github.com/nais/console-backend/internal/graph/model"
 	"github.com/nais/console-backend/internal/graph/scalar"
 )
@@ -14,6 +19,16 @@ type (
 	sortedDailyCosts map[string][]model.CostEntry
 )
 
+const (
+	// costProjectionMonths is the number of months in the cost projection of a team
+	costProjectionMonths = 12
+
+	// defaultTenantCostLimit and maxTenantCostLimit are the default and maximum number of teams and apps in each
+	// ranking of the tenant cost
+	defaultTenantCostLimit = 10
+	maxTenantCostLimit     = 100
+)
+
 // DailyCostsFromDatabaseRows will convert a slice of cost rows from the database to a sortedDailyCosts map.
 func DailyCostsFromDatabaseRows(from, to scalar.Date, rows []*gensql.Cost) (sortedDailyCosts, float64) {
 	sum := 0.0
@@ -103,3 +118,378 @@ func ValidateDateInterval(from, to scalar.Date) error {
 
 	return nil
 }
+
+// costForecasts returns the cost forecasts of an app, or of a team if app is empty, for a number of months starting with
+// the month of the last recorded cost. Returns nil if there is no recorded cost.
+func (r *Resolver) costForecasts(ctx context.Context, team, app, env string, months int) ([]model.CostForecast, error) {
+	last, err := r.querier.LastCostDate(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("last cost date query: %w", err)
+	}
+	if !last.Valid {
+		return nil, nil
+	}
+
+	from := pgtype.Date{Time: cost.FetchFrom(last.Time), Valid: true}
+	var rows []*gensql.Cost
+	if app == "" {
+		rows, err = r.querier.DailyCostForTeam(ctx, gensql.DailyCostForTeamParams{
+			Team:     &team,
+			FromDate: from,
+			ToDate:   last,
+		})
+	} else {
+		rows, err = r.querier.DailyCostForApp(ctx, gensql.DailyCostForAppParams{
+			App:      app,
+			Team:     &team,
+			Env:      &env,
+			FromDate: from,
+			ToDate:   last,
+		})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cost query: %w", err)
+	}
+
+	costs, _ := DailyCostsForTeamFromDatabaseRows(scalar.NewDate(from.Time), scalar.NewDate(last.Time), rows)
+	return CostForecastsFromDailyCosts(costs, firstCostDates(rows), last.Time, months), nil
+}
+
+// firstCostDates returns the first date with recorded cost of each cost type
+func firstCostDates(rows []*gensql.Cost) map[string]time.Time {
+	ret := make(map[string]time.Time)
+	for _, row := range rows {
+		if first, exists := ret[row.CostType]; !exists || row.Date.Time.Before(first) {
+			ret[row.CostType] = row.Date.Time
+		}
+	}
+	return ret
+}
+
+// CostForecastsFromDailyCosts will forecast the cost of a number of months, starting with the month of the last date
+// with recorded cost, from sorted daily costs per cost type. Each cost type is only fitted from its first date with
+// recorded cost, as the daily costs are zero-filled before the cost type existed.
+func CostForecastsFromDailyCosts(costs sortedDailyCosts, first map[string]time.Time, last time.Time, months int) []model.CostForecast {
+	series := make(map[string][]cost.DailyCost, len(costs))
+	for costType, entries := range costs {
+		data := make([]cost.DailyCost, 0, len(entries))
+		for _, entry := range entries {
+			date, err := entry.Date.Time()
+			if err != nil || date.Before(first[costType]) {
+				continue
+			}
+			data = append(data, cost.DailyCost{Date: date, Cost: entry.Cost})
+		}
+		series[costType] = data
+	}
+
+	ret := make([]model.CostForecast, 0, months)
+	for _, f := range cost.ForecastMonths(series, last, months) {
+		costTypes := make([]model.CostTypeForecast, 0, len(f.CostTypes))
+		for costType, c := range f.CostTypes {
+			costTypes = append(costTypes, model.CostTypeForecast{
+				CostType:   costType,
+				Cost:       c.Cost,
+				LowerBound: c.LowerBound,
+				UpperBound: c.UpperBound,
+			})
+		}
+		sort.Slice(costTypes, func(i, j int) bool {
+			return costTypes[i].CostType < costTypes[j].CostType
+		})
+
+		ret = append(ret, model.CostForecast{
+			Month:      scalar.NewDate(f.Month),
+			Cost:       f.Cost,
+			LowerBound: f.LowerBound,
+			UpperBound: f.UpperBound,
+			Series:     costTypes,
+		})
+	}
+	return ret
+}
+
+// teamBudget will convert a budget from the database to a team budget, with the cost recorded in the month of the last
+// date with recorded cost. The spend is 0 for the current month if no cost has been recorded.
+func (r *Resolver) teamBudget(ctx context.Context, budget *gensql.CostBudget) (*model.TeamBudget, error) {
+	last, err := r.querier.LastCostDate(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("last cost date query: %w", err)
+	}
+
+	day := time.Now().UTC()
+	if last.Valid {
+		day = last.Time
+	}
+	month := time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	spend := 0.0
+	if last.Valid {
+		rows, err := r.querier.CostForTeams(ctx, gensql.CostForTeamsParams{
+			FromDate: pgtype.Date{Time: month, Valid: true},
+			ToDate:   last,
+			Teams:    []string{budget.Team},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("cost for teams query: %w", err)
+		}
+		for _, row := range rows {
+			spend += float64(row.DailyCost)
+		}
+	}
+
+	return TeamBudgetFromDatabaseRow(budget, month, spend), nil
+}
+
+// TeamBudgetFromDatabaseRow will convert a budget from the database to a team budget, given the cost recorded in a
+// month.
+func TeamBudgetFromDatabaseRow(budget *gensql.CostBudget, month time.Time, spend float64) *model.TeamBudget {
+	return &model.TeamBudget{
+		Amount:       float64(budget.Amount),
+		Month:        scalar.NewDate(month),
+		Spend:        spend,
+		PercentSpent: spend / float64(budget.Amount) * 100,
+		UpdatedBy:    budget.UpdatedBy,
+		Updated:      budget.Updated.Time,
+		GQLVars: model.TeamBudgetGQLVars{
+			Team: budget.Team,
+		},
+	}
+}
+
+// CostAnomaliesFromDatabaseRows will convert cost anomalies from the database to cost anomalies.
+func CostAnomaliesFromDatabaseRows(rows []*gensql.CostAnomaly) []model.CostAnomaly {
+	ret := make([]model.CostAnomaly, 0, len(rows))
+	for _, row := range rows {
+		ret = append(ret, model.CostAnomaly{
+			Env:       row.Env,
+			App:       row.App,
+			CostType:  row.CostType,
+			FirstSeen: scalar.NewDate(row.FirstSeen.Time),
+			LastSeen:  scalar.NewDate(row.LastSeen.Time),
+			Cost:      float64(row.Cost),
+			Baseline:  float64(row.Baseline),
+			Magnitude: float64(row.Cost - row.Baseline),
+		})
+	}
+	return ret
+}
+
+// validateCostBreakdownGroups will make sure there is at least one level in a cost breakdown, and that each level is
+// only used once.
+func validateCostBreakdownGroups(groupBy []model.CostBreakdownGroup) error {
+	if len(groupBy) == 0 {
+		return apierror.Errorf("The cost breakdown must be grouped by at least one level.")
+	}
+
+	seen := make(map[model.CostBreakdownGroup]struct{}, len(groupBy))
+	for _, group := range groupBy {
+		if _, exists := seen[group]; exists {
+			return apierror.Errorf("The cost breakdown can only be grouped by %s once.", group)
+		}
+		seen[group] = struct{}{}
+	}
+	return nil
+}
+
+// CostBreakdownFromDatabaseRows will convert the cost of each environment, app and cost type of a team to a cost
+// breakdown with a level for each group, from the top.
+func CostBreakdownFromDatabaseRows(rows []*gensql.CostBreakdownForTeamRow, groupBy []model.CostBreakdownGroup) *model.CostBreakdown {
+	sum := 0.0
+	for _, row := range rows {
+		sum += float64(row.Cost)
+	}
+
+	return &model.CostBreakdown{
+		Sum:    sum,
+		Groups: costBreakdownNodes(rows, groupBy, sum, sum),
+	}
+}
+
+// costBreakdownNodes will group the rows by the first group, and break each group down further by the remaining
+// groups. The nodes are sorted by the highest cost first.
+func costBreakdownNodes(rows []*gensql.CostBreakdownForTeamRow, groupBy []model.CostBreakdownGroup, total, parent float64) []model.CostBreakdownNode {
+	if len(groupBy) == 0 {
+		return []model.CostBreakdownNode{}
+	}
+
+	names := make([]string, 0)
+	grouped := make(map[string][]*gensql.CostBreakdownForTeamRow)
+	for _, row := range rows {
+		name := costBreakdownName(row, groupBy[0])
+		if _, exists := grouped[name]; !exists {
+			names = append(names, name)
+		}
+		grouped[name] = append(grouped[name], row)
+	}
+
+	nodes := make([]model.CostBreakdownNode, 0, len(names))
+	for _, name := range names {
+		sum := 0.0
+		for _, row := range grouped[name] {
+			sum += float64(row.Cost)
+		}
+
+		nodes = append(nodes, model.CostBreakdownNode{
+			Group:           groupBy[0],
+			Name:            name,
+			Sum:             sum,
+			PercentOfTotal:  percentOf(sum, total),
+			PercentOfParent: percentOf(sum, parent),
+			Children:        costBreakdownNodes(grouped[name], groupBy[1:], total, sum),
+		})
+	}
+
+	sort.SliceStable(nodes, func(i, j int) bool {
+		if nodes[i].Sum == nodes[j].Sum {
+			return nodes[i].Name < nodes[j].Name
+		}
+		return nodes[i].Sum > nodes[j].Sum
+	})
+	return nodes
+}
+
+func costBreakdownName(row *gensql.CostBreakdownForTeamRow, group model.CostBreakdownGroup) string {
+	switch group {
+	case model.CostBreakdownGroupEnv:
+		return row.Env
+	case model.CostBreakdownGroupApp:
+		return row.App
+	default:
+		return row.CostType
+	}
+}
+
+// percentOf returns part as a percentage of whole, or 0 if whole is 0
+func percentOf(part, whole float64) float64 {
+	if whole == 0 {
+		return 0
+	}
+	return part / whole * 100
+}
+
+// tenantCost returns the cost of all teams in a date range, with rankings of the limit teams and apps with the highest
+// cost and month-over-month growth.
+func (r *Resolver) tenantCost(ctx context.Context, from, to scalar.Date, limit int32) (*model.TenantCost, error) {
+	fromDate, err := from.PgDate()
+	if err != nil {
+		return nil, err
+	}
+
+	toDate, err := to.PgDate()
+	if err != nil {
+		return nil, err
+	}
+
+	envs, err := r.querier.TenantCostPerEnv(ctx, gensql.TenantCostPerEnvParams{
+		FromDate: fromDate,
+		ToDate:   toDate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("tenant cost per env query: %w", err)
+	}
+
+	teams, err := r.querier.TenantTopTeams(ctx, gensql.TenantTopTeamsParams{
+		FromDate: fromDate,
+		ToDate:   toDate,
+		Limit:    limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("tenant top teams query: %w", err)
+	}
+
+	apps, err := r.querier.TenantTopApps(ctx, gensql.TenantTopAppsParams{
+		FromDate: fromDate,
+		ToDate:   toDate,
+		Limit:    limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("tenant top apps query: %w", err)
+	}
+
+	currentFrom, previousFrom, previousTo := monthOverMonthPeriods(toDate.Time)
+	teamGrowth, err := r.querier.TenantTeamCostGrowth(ctx, gensql.TenantTeamCostGrowthParams{
+		CurrentFrom:  pgtype.Date{Time: currentFrom, Valid: true},
+		CurrentTo:    toDate,
+		PreviousFrom: pgtype.Date{Time: previousFrom, Valid: true},
+		PreviousTo:   pgtype.Date{Time: previousTo, Valid: true},
+		Limit:        limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("tenant team cost growth query: %w", err)
+	}
+
+	appGrowth, err := r.querier.TenantAppCostGrowth(ctx, gensql.TenantAppCostGrowthParams{
+		CurrentFrom:  pgtype.Date{Time: currentFrom, Valid: true},
+		CurrentTo:    toDate,
+		PreviousFrom: pgtype.Date{Time: previousFrom, Valid: true},
+		PreviousTo:   pgtype.Date{Time: previousTo, Valid: true},
+		Limit:        limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("tenant app cost growth query: %w", err)
+	}
+
+	ret := &model.TenantCost{
+		Envs:                make([]model.TenantEnvCost, 0, len(envs)),
+		TopTeams:            make([]model.TeamCostRanking, 0, len(teams)),
+		TopApps:             make([]model.AppCostRanking, 0, len(apps)),
+		GrowthFrom:          scalar.NewDate(currentFrom),
+		FastestGrowingTeams: make([]model.TeamCostGrowth, 0, len(teamGrowth)),
+		FastestGrowingApps:  make([]model.AppCostGrowth, 0, len(appGrowth)),
+	}
+	for _, row := range envs {
+		ret.Sum += float64(row.Cost)
+		ret.Envs = append(ret.Envs, model.TenantEnvCost{Env: row.Env, Sum: float64(row.Cost)})
+	}
+	for _, row := range teams {
+		ret.TopTeams = append(ret.TopTeams, model.TeamCostRanking{Team: row.Team, Sum: float64(row.Cost)})
+	}
+	for _, row := range apps {
+		ret.TopApps = append(ret.TopApps, model.AppCostRanking{Team: row.Team, Env: row.Env, App: row.App, Sum: float64(row.Cost)})
+	}
+	for _, row := range teamGrowth {
+		ret.FastestGrowingTeams = append(ret.FastestGrowingTeams, model.TeamCostGrowth{
+			Team:          row.Team,
+			CurrentCost:   float64(row.CurrentCost),
+			PreviousCost:  float64(row.PreviousCost),
+			Growth:        float64(row.Growth),
+			GrowthPercent: growthPercent(float64(row.CurrentCost), float64(row.PreviousCost)),
+		})
+	}
+	for _, row := range appGrowth {
+		ret.FastestGrowingApps = append(ret.FastestGrowingApps, model.AppCostGrowth{
+			Team:          row.Team,
+			Env:           row.Env,
+			App:           row.App,
+			CurrentCost:   float64(row.CurrentCost),
+			PreviousCost:  float64(row.PreviousCost),
+			Growth:        float64(row.Growth),
+			GrowthPercent: growthPercent(float64(row.CurrentCost), float64(row.PreviousCost)),
+		})
+	}
+
+	return ret, nil
+}
+
+// monthOverMonthPeriods returns the periods of the month-over-month growth of cost up to and including a day. The
+// current period is from the first day of the month of the day, and the previous period is the same days of the month
+// before. The previous period ends on the last day of the month before if it has fewer days.
+func monthOverMonthPeriods(day time.Time) (currentFrom, previousFrom, previousTo time.Time) {
+	currentFrom = time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+	previousFrom = currentFrom.AddDate(0, -1, 0)
+	previousTo = previousFrom.AddDate(0, 0, day.Day()-1)
+	if lastDay := currentFrom.AddDate(0, 0, -1); previousTo.After(lastDay) {
+		previousTo = lastDay
+	}
+	return currentFrom, previousFrom, previousTo
+}
+
+// growthPercent returns the growth from previous to current in percent, or nil if previous is 0
+func growthPercent(current, previous float64) *float64 {
+	if previous == 0 {
+		return nil
+	}
+	ret := (current - previous) / previous * 100
+	return &ret
+}
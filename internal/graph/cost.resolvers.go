@@ -9,11 +9,66 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/nais/console-backend/internal/auth"
 	"github.com/nais/console-backend/internal/database/gensql"
+	"github.com/nais/console-backend/internal/graph/apierror"
 	"github.com/nais/console-backend/internal/graph/model"
 	"github.com/nais/console-backend/internal/graph/scalar"
 )
 
+// Forecast is the resolver for the forecast field.
+func (r *monthlyCostResolver) Forecast(ctx context.Context, obj *model.MonthlyCost) (*model.CostForecast, error) {
+	forecasts, err := r.costForecasts(ctx, obj.GQLVars.Team, obj.GQLVars.App, obj.GQLVars.Env, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(forecasts) == 0 {
+		return nil, nil
+	}
+	return &forecasts[0], nil
+}
+
+// SetTeamBudget is the resolver for the setTeamBudget field.
+func (r *mutationResolver) SetTeamBudget(ctx context.Context, team string, amount float64) (*model.TeamBudget, error) {
+	if !r.isTeamOwner(ctx, team) {
+		return nil, fmt.Errorf("access denied")
+	}
+
+	if amount <= 0 {
+		return nil, apierror.Errorf("The budget must be greater than 0.")
+	}
+
+	email, err := auth.GetEmail(ctx)
+	if err != nil {
+		return nil, apierror.ErrNoEmailInSession
+	}
+
+	budget, err := r.querier.CostBudgetUpsert(ctx, gensql.CostBudgetUpsertParams{
+		Team:      team,
+		Amount:    float32(amount),
+		UpdatedBy: email,
+	})
+	if err != nil {
+		r.log.WithError(err).Errorf("unable to set budget of team %q", team)
+		return nil, apierror.ErrDatabase
+	}
+
+	return r.teamBudget(ctx, budget)
+}
+
+// RemoveTeamBudget is the resolver for the removeTeamBudget field.
+func (r *mutationResolver) RemoveTeamBudget(ctx context.Context, team string) (bool, error) {
+	if !r.isTeamOwner(ctx, team) {
+		return false, fmt.Errorf("access denied")
+	}
+
+	if err := r.querier.CostBudgetDelete(ctx, team); err != nil {
+		r.log.WithError(err).Errorf("unable to remove budget of team %q", team)
+		return false, apierror.ErrDatabase
+	}
+	return true, nil
+}
+
 // DailyCostForApp is the resolver for the dailyCostForApp field.
 func (r *queryResolver) DailyCostForApp(ctx context.Context, team string, app string, env string, from scalar.Date, to scalar.Date) (*model.DailyCost, error) {
 	err := ValidateDateInterval(from, to)
@@ -134,6 +189,11 @@ func (r *queryResolver) MonthlyCost(ctx context.Context, filter model.MonthlyCos
 		return &model.MonthlyCost{
 			Sum:  sum,
 			Cost: cost,
+			GQLVars: model.MonthlyCostGQLVars{
+				Team: filter.Team,
+				App:  filter.App,
+				Env:  filter.Env,
+			},
 		}, nil
 	} else if filter.App == "" && filter.Env == "" && filter.Team != "" {
 		rows, err := r.querier.MonthlyCostForTeam(ctx, &filter.Team)
@@ -154,6 +214,9 @@ func (r *queryResolver) MonthlyCost(ctx context.Context, filter model.MonthlyCos
 		return &model.MonthlyCost{
 			Sum:  sum,
 			Cost: cost,
+			GQLVars: model.MonthlyCostGQLVars{
+				Team: filter.Team,
+			},
 		}, nil
 	}
 	return nil, fmt.Errorf("not implemented")
@@ -216,3 +279,93 @@ func (r *queryResolver) EnvCost(ctx context.Context, filter model.EnvCostFilter)
 
 	return ret, nil
 }
+
+// CostProjectionForTeam is the resolver for the costProjectionForTeam field.
+func (r *queryResolver) CostProjectionForTeam(ctx context.Context, team string) ([]model.CostForecast, error) {
+	forecasts, err := r.costForecasts(ctx, team, "", "", costProjectionMonths)
+	if err != nil {
+		return nil, err
+	}
+	if forecasts == nil {
+		return []model.CostForecast{}, nil
+	}
+	return forecasts, nil
+}
+
+// TeamCostBreakdown is the resolver for the teamCostBreakdown field.
+func (r *queryResolver) TeamCostBreakdown(ctx context.Context, team string, from scalar.Date, to scalar.Date, groupBy []model.CostBreakdownGroup) (*model.CostBreakdown, error) {
+	err := ValidateDateInterval(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateCostBreakdownGroups(groupBy); err != nil {
+		return nil, err
+	}
+
+	fromDate, err := from.PgDate()
+	if err != nil {
+		return nil, err
+	}
+
+	toDate, err := to.PgDate()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.querier.CostBreakdownForTeam(ctx, gensql.CostBreakdownForTeamParams{
+		Team:     &team,
+		FromDate: fromDate,
+		ToDate:   toDate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cost breakdown query: %w", err)
+	}
+
+	return CostBreakdownFromDatabaseRows(rows, groupBy), nil
+}
+
+// TenantCost is the resolver for the tenantCost field.
+func (r *queryResolver) TenantCost(ctx context.Context, from scalar.Date, to scalar.Date, limit *int) (*model.TenantCost, error) {
+	if !r.isTenantAdmin(ctx) {
+		return nil, apierror.ErrTenantAdminRequired
+	}
+
+	err := ValidateDateInterval(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	n := defaultTenantCostLimit
+	if limit != nil {
+		n = *limit
+	}
+	if n < 1 || n > maxTenantCostLimit {
+		return nil, apierror.Errorf("The limit must be between 1 and %d.", maxTenantCostLimit)
+	}
+
+	return r.tenantCost(ctx, from, to, int32(n))
+}
+
+// Forecast is the resolver for the forecast field.
+func (r *teamBudgetResolver) Forecast(ctx context.Context, obj *model.TeamBudget) (*model.CostForecast, error) {
+	forecasts, err := r.costForecasts(ctx, obj.GQLVars.Team, "", "", 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(forecasts) == 0 {
+		return nil, nil
+	}
+	return &forecasts[0], nil
+}
+
+// MonthlyCost returns MonthlyCostResolver implementation.
+func (r *Resolver) MonthlyCost() MonthlyCostResolver { return &monthlyCostResolver{r} }
+
+// TeamBudget returns TeamBudgetResolver implementation.
+func (r *Resolver) TeamBudget() TeamBudgetResolver { return &teamBudgetResolver{r} }
+
+type (
+	monthlyCostResolver struct{ *Resolver }
+	teamBudgetResolver  struct{ *Resolver }
+)
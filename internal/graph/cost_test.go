@@ -6,6 +6,7 @@ import (
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/nais/console-backend/internal/database/gensql"
+	"github.com/nais/console-backend/internal/graph/model"
 	"github.com/nais/console-backend/internal/graph/scalar"
 	"github.com/stretchr/testify/assert"
 )
@@ -108,3 +109,213 @@ func TestValidateDateInterval(t *testing.T) {
 		assert.EqualError(t, ValidateDateInterval(scalar.NewDate(from), scalar.NewDate(to)), "to date cannot be in the future")
 	})
 }
+
+func TestCostForecastsFromDailyCosts(t *testing.T) {
+	last := time.Date(2020, time.April, 30, 0, 0, 0, 0, time.UTC)
+	from := scalar.NewDate(last.AddDate(0, 0, -29))
+	to := scalar.NewDate(last)
+
+	t.Run("no cost types present", func(t *testing.T) {
+		costs, _ := DailyCostsForTeamFromDatabaseRows(from, to, []*gensql.Cost{})
+		forecasts := CostForecastsFromDailyCosts(costs, nil, last, 1)
+		assert.Len(t, forecasts, 1)
+		assert.Equal(t, 0.0, forecasts[0].Cost)
+		assert.Empty(t, forecasts[0].Series)
+	})
+
+	t.Run("series are sorted by cost type", func(t *testing.T) {
+		rows := []*gensql.Cost{
+			{CostType: "type b", Date: pgtype.Date{Time: last, Valid: true}, DailyCost: 1},
+			{CostType: "type a", Date: pgtype.Date{Time: last, Valid: true}, DailyCost: 2},
+		}
+		costs, _ := DailyCostsForTeamFromDatabaseRows(from, to, rows)
+		forecasts := CostForecastsFromDailyCosts(costs, firstCostDates(rows), last, 2)
+		assert.Len(t, forecasts, 2)
+		assert.Equal(t, scalar.Date("2020-04-01"), forecasts[0].Month)
+		assert.Equal(t, scalar.Date("2020-05-01"), forecasts[1].Month)
+		assert.Equal(t, "type a", forecasts[0].Series[0].CostType)
+		assert.Equal(t, "type b", forecasts[0].Series[1].CostType)
+		assert.InDelta(t, 3.0, forecasts[0].Cost, 0.000001)
+	})
+
+	t.Run("cost types are only fitted from their first recorded day", func(t *testing.T) {
+		rows := []*gensql.Cost{
+			{CostType: "type a", Date: pgtype.Date{Time: last.AddDate(0, 0, -2), Valid: true}, DailyCost: 10},
+			{CostType: "type a", Date: pgtype.Date{Time: last.AddDate(0, 0, -1), Valid: true}, DailyCost: 10},
+			{CostType: "type a", Date: pgtype.Date{Time: last, Valid: true}, DailyCost: 10},
+		}
+		costs, _ := DailyCostsForTeamFromDatabaseRows(from, to, rows)
+
+		// the zero-filled days before the first recorded day would otherwise pull the forecast down
+		forecasts := CostForecastsFromDailyCosts(costs, firstCostDates(rows), last, 2)
+		assert.InDelta(t, 31*10.0, forecasts[1].Cost, 0.000001)
+
+		forecasts = CostForecastsFromDailyCosts(costs, nil, last, 2)
+		assert.Less(t, forecasts[1].Cost, 31*10.0)
+	})
+}
+
+func TestTeamBudgetFromDatabaseRow(t *testing.T) {
+	month := time.Date(2023, time.October, 1, 0, 0, 0, 0, time.UTC)
+	updated := time.Date(2023, time.September, 20, 12, 0, 0, 0, time.UTC)
+
+	budget := TeamBudgetFromDatabaseRow(&gensql.CostBudget{
+		Team:      "team",
+		Amount:    200,
+		UpdatedBy: "user@example.com",
+		Updated:   pgtype.Timestamptz{Time: updated, Valid: true},
+	}, month, 50)
+
+	assert.Equal(t, 200.0, budget.Amount)
+	assert.Equal(t, scalar.NewDate(month), budget.Month)
+	assert.Equal(t, 50.0, budget.Spend)
+	assert.Equal(t, 25.0, budget.PercentSpent)
+	assert.Equal(t, "user@example.com", budget.UpdatedBy)
+	assert.Equal(t, updated, budget.Updated)
+	assert.Equal(t, "team", budget.GQLVars.Team)
+}
+
+func TestCostAnomaliesFromDatabaseRows(t *testing.T) {
+	t.Run("no anomalies", func(t *testing.T) {
+		assert.Equal(t, []model.CostAnomaly{}, CostAnomaliesFromDatabaseRows(nil))
+	})
+
+	t.Run("magnitude is the increase from the baseline", func(t *testing.T) {
+		firstSeen := time.Date(2023, time.October, 14, 0, 0, 0, 0, time.UTC)
+		lastSeen := time.Date(2023, time.October, 15, 0, 0, 0, 0, time.UTC)
+
+		anomalies := CostAnomaliesFromDatabaseRows([]*gensql.CostAnomaly{
+			{
+				Team:      "team",
+				Env:       "prod",
+				App:       "app",
+				CostType:  "Cloud Storage",
+				FirstSeen: pgtype.Date{Time: firstSeen, Valid: true},
+				LastSeen:  pgtype.Date{Time: lastSeen, Valid: true},
+				Cost:      42.5,
+				Baseline:  10,
+			},
+		})
+		assert.Equal(t, []model.CostAnomaly{
+			{
+				Env:       "prod",
+				App:       "app",
+				CostType:  "Cloud Storage",
+				FirstSeen: scalar.NewDate(firstSeen),
+				LastSeen:  scalar.NewDate(lastSeen),
+				Cost:      42.5,
+				Baseline:  10,
+				Magnitude: 32.5,
+			},
+		}, anomalies)
+	})
+}
+
+func TestCostBreakdownFromDatabaseRows(t *testing.T) {
+	rows := []*gensql.CostBreakdownForTeamRow{
+		{Env: "dev", App: "app-a", CostType: "Compute Engine", Cost: 10},
+		{Env: "prod", App: "app-a", CostType: "Cloud SQL", Cost: 40},
+		{Env: "prod", App: "app-a", CostType: "Compute Engine", Cost: 30},
+		{Env: "prod", App: "app-b", CostType: "Compute Engine", Cost: 20},
+	}
+
+	t.Run("no cost", func(t *testing.T) {
+		breakdown := CostBreakdownFromDatabaseRows(nil, []model.CostBreakdownGroup{model.CostBreakdownGroupCostType})
+		assert.Equal(t, 0.0, breakdown.Sum)
+		assert.Empty(t, breakdown.Groups)
+	})
+
+	t.Run("single level", func(t *testing.T) {
+		breakdown := CostBreakdownFromDatabaseRows(rows, []model.CostBreakdownGroup{model.CostBreakdownGroupCostType})
+		assert.Equal(t, 100.0, breakdown.Sum)
+		assert.Equal(t, []model.CostBreakdownNode{
+			{
+				Group:           model.CostBreakdownGroupCostType,
+				Name:            "Compute Engine",
+				Sum:             60,
+				PercentOfTotal:  60,
+				PercentOfParent: 60,
+				Children:        []model.CostBreakdownNode{},
+			},
+			{
+				Group:           model.CostBreakdownGroupCostType,
+				Name:            "Cloud SQL",
+				Sum:             40,
+				PercentOfTotal:  40,
+				PercentOfParent: 40,
+				Children:        []model.CostBreakdownNode{},
+			},
+		}, breakdown.Groups)
+	})
+
+	t.Run("multiple levels", func(t *testing.T) {
+		breakdown := CostBreakdownFromDatabaseRows(rows, []model.CostBreakdownGroup{
+			model.CostBreakdownGroupEnv,
+			model.CostBreakdownGroupApp,
+			model.CostBreakdownGroupCostType,
+		})
+		assert.Len(t, breakdown.Groups, 2)
+
+		prod := breakdown.Groups[0]
+		assert.Equal(t, "prod", prod.Name)
+		assert.Equal(t, 90.0, prod.Sum)
+		assert.Len(t, prod.Children, 2)
+
+		appA := prod.Children[0]
+		assert.Equal(t, model.CostBreakdownGroupApp, appA.Group)
+		assert.Equal(t, "app-a", appA.Name)
+		assert.Equal(t, 70.0, appA.Sum)
+		assert.Equal(t, 70.0, appA.PercentOfTotal)
+		assert.InDelta(t, 77.78, appA.PercentOfParent, 0.01)
+
+		cloudSQL := appA.Children[0]
+		assert.Equal(t, model.CostBreakdownGroupCostType, cloudSQL.Group)
+		assert.Equal(t, "Cloud SQL", cloudSQL.Name)
+		assert.Equal(t, 40.0, cloudSQL.PercentOfTotal)
+		assert.InDelta(t, 57.14, cloudSQL.PercentOfParent, 0.01)
+		assert.Empty(t, cloudSQL.Children)
+
+		dev := breakdown.Groups[1]
+		assert.Equal(t, "dev", dev.Name)
+		assert.Equal(t, 10.0, dev.PercentOfTotal)
+	})
+}
+
+func TestValidateCostBreakdownGroups(t *testing.T) {
+	assert.NoError(t, validateCostBreakdownGroups([]model.CostBreakdownGroup{model.CostBreakdownGroupEnv, model.CostBreakdownGroupApp}))
+	assert.EqualError(t, validateCostBreakdownGroups(nil), "The cost breakdown must be grouped by at least one level.")
+	assert.EqualError(t, validateCostBreakdownGroups([]model.CostBreakdownGroup{model.CostBreakdownGroupApp, model.CostBreakdownGroupApp}), "The cost breakdown can only be grouped by APP once.")
+}
+
+func TestMonthOverMonthPeriods(t *testing.T) {
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	t.Run("same days of the month before", func(t *testing.T) {
+		currentFrom, previousFrom, previousTo := monthOverMonthPeriods(date(2023, time.October, 15))
+		assert.Equal(t, date(2023, time.October, 1), currentFrom)
+		assert.Equal(t, date(2023, time.September, 1), previousFrom)
+		assert.Equal(t, date(2023, time.September, 15), previousTo)
+	})
+
+	t.Run("month before is shorter", func(t *testing.T) {
+		currentFrom, previousFrom, previousTo := monthOverMonthPeriods(date(2023, time.March, 31))
+		assert.Equal(t, date(2023, time.March, 1), currentFrom)
+		assert.Equal(t, date(2023, time.February, 1), previousFrom)
+		assert.Equal(t, date(2023, time.February, 28), previousTo)
+	})
+
+	t.Run("across years", func(t *testing.T) {
+		currentFrom, previousFrom, previousTo := monthOverMonthPeriods(date(2024, time.January, 1))
+		assert.Equal(t, date(2024, time.January, 1), currentFrom)
+		assert.Equal(t, date(2023, time.December, 1), previousFrom)
+		assert.Equal(t, date(2023, time.December, 1), previousTo)
+	})
+}
+
+func TestGrowthPercent(t *testing.T) {
+	assert.Nil(t, growthPercent(10, 0))
+	assert.Equal(t, 50.0, *growthPercent(15, 10))
+	assert.Equal(t, -100.0, *growthPercent(0, 10))
+}
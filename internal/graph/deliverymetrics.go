@@ -0,0 +1,123 @@
+package graph
+
+import (
+	"github.com/nais/console-backend/internal/database/gensql"
+	"github.com/nais/console-backend/internal/graph/model"
+	"github.com/nais/console-backend/internal/graph/scalar"
+)
+
+// deliveryMetricsSum is the sum of a set of daily delivery metrics rows
+type deliveryMetricsSum struct {
+	deployments        int
+	failedDeployments  int
+	rollbacks          int
+	restores           int
+	restoreTimeSeconds int64
+	leadTimes          int
+	leadTimeSeconds    int64
+}
+
+func (s *deliveryMetricsSum) add(row *gensql.DeliveryMetric) {
+	s.deployments += int(row.Deployments)
+	s.failedDeployments += int(row.FailedDeployments)
+	s.rollbacks += int(row.Rollbacks)
+	s.restores += int(row.Restores)
+	s.restoreTimeSeconds += row.RestoreTimeSeconds
+	s.leadTimes += int(row.LeadTimes)
+	s.leadTimeSeconds += row.LeadTimeSeconds
+}
+
+// summary converts the sum to a summary, where the deployment frequency is calculated over the given number of days.
+// Both failed deployments and rollbacks count as failed changes.
+func (s *deliveryMetricsSum) summary(days int) model.DeliveryMetricsSummary {
+	ret := model.DeliveryMetricsSummary{
+		Deployments:         s.deployments,
+		FailedDeployments:   s.failedDeployments,
+		Rollbacks:           s.rollbacks,
+		DeploymentFrequency: float64(s.deployments) / float64(days),
+	}
+
+	if s.deployments > 0 {
+		rate := min(float64(s.failedDeployments+s.rollbacks)/float64(s.deployments), 1)
+		ret.ChangeFailureRate = &rate
+	}
+
+	if s.restores > 0 {
+		mttr := float64(s.restoreTimeSeconds) / float64(s.restores)
+		ret.MeanTimeToRestore = &mttr
+	}
+
+	if s.leadTimes > 0 {
+		leadTime := float64(s.leadTimeSeconds) / float64(s.leadTimes)
+		ret.MeanLeadTime = &leadTime
+	}
+
+	return ret
+}
+
+// DeliveryMetricsFromDatabaseRows will convert a slice of daily delivery metrics rows from the database to delivery
+// metrics for the "from -> to" range. The series will contain an entry for all dates in the range.
+func DeliveryMetricsFromDatabaseRows(from, to scalar.Date, rows []*gensql.DeliveryMetric) *model.DeliveryMetrics {
+	type workload struct {
+		name, env string
+	}
+
+	total := &deliveryMetricsSum{}
+	workloads := make([]workload, 0)
+	perWorkload := make(map[workload]*deliveryMetricsSum)
+	perDay := make(map[scalar.Date]*deliveryMetricsSum)
+
+	for _, row := range rows {
+		total.add(row)
+
+		w := workload{name: row.App, env: row.Env}
+		if _, exists := perWorkload[w]; !exists {
+			perWorkload[w] = &deliveryMetricsSum{}
+			workloads = append(workloads, w)
+		}
+		perWorkload[w].add(row)
+
+		date := scalar.NewDate(row.Date.Time)
+		if _, exists := perDay[date]; !exists {
+			perDay[date] = &deliveryMetricsSum{}
+		}
+		perDay[date].add(row)
+	}
+
+	start, _ := from.Time()
+	end, _ := to.Time()
+
+	series := make([]model.DeliveryMetricsEntry, 0)
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		entry := model.DeliveryMetricsEntry{Date: scalar.NewDate(day)}
+		if sum, exists := perDay[entry.Date]; exists {
+			entry.Deployments = sum.deployments
+			entry.FailedDeployments = sum.failedDeployments
+		}
+		series = append(series, entry)
+	}
+
+	model.SortWith(workloads, func(a, b workload) bool {
+		if a.name == b.name {
+			return a.env < b.env
+		}
+		return a.name < b.name
+	})
+
+	days := max(len(series), 1)
+	ret := &model.DeliveryMetrics{
+		Summary:   total.summary(days),
+		Workloads: make([]model.WorkloadDeliveryMetrics, 0),
+		Series:    series,
+	}
+
+	for _, w := range workloads {
+		ret.Workloads = append(ret.Workloads, model.WorkloadDeliveryMetrics{
+			Name:    w.name,
+			Env:     w.env,
+			Metrics: perWorkload[w].summary(days),
+		})
+	}
+
+	return ret
+}
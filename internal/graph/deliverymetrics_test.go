@@ -0,0 +1,82 @@
+package graph
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nais/console-backend/internal/database/gensql"
+	"github.com/nais/console-backend/internal/graph/scalar"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeliveryMetricsFromDatabaseRows(t *testing.T) {
+	fromTime := time.Date(2023, time.November, 1, 0, 0, 0, 0, time.UTC)
+	from := scalar.NewDate(fromTime)
+	to := scalar.NewDate(fromTime.AddDate(0, 0, 3))
+
+	t.Run("no rows", func(t *testing.T) {
+		metrics := DeliveryMetricsFromDatabaseRows(from, to, []*gensql.DeliveryMetric{})
+		assert.Equal(t, 0, metrics.Summary.Deployments)
+		assert.Equal(t, 0.0, metrics.Summary.DeploymentFrequency)
+		assert.Nil(t, metrics.Summary.ChangeFailureRate)
+		assert.Nil(t, metrics.Summary.MeanTimeToRestore)
+		assert.Nil(t, metrics.Summary.MeanLeadTime)
+		assert.Empty(t, metrics.Workloads)
+		assert.Len(t, metrics.Series, 4)
+	})
+
+	t.Run("rows for multiple apps", func(t *testing.T) {
+		rows := []*gensql.DeliveryMetric{
+			{
+				Date:              pgtype.Date{Time: fromTime, Valid: true},
+				Env:               "dev",
+				App:               "b-app",
+				Deployments:       4,
+				FailedDeployments: 2,
+			},
+			{
+				Date:               pgtype.Date{Time: fromTime.AddDate(0, 0, 2), Valid: true},
+				Env:                "dev",
+				App:                "b-app",
+				Deployments:        2,
+				Restores:           2,
+				RestoreTimeSeconds: 600,
+			},
+			{
+				Date:            pgtype.Date{Time: fromTime.AddDate(0, 0, 2), Valid: true},
+				Env:             "prod",
+				App:             "a-app",
+				Deployments:     2,
+				Rollbacks:       2,
+				LeadTimes:       2,
+				LeadTimeSeconds: 7200,
+			},
+		}
+
+		metrics := DeliveryMetricsFromDatabaseRows(from, to, rows)
+		assert.Equal(t, 8, metrics.Summary.Deployments)
+		assert.Equal(t, 2, metrics.Summary.FailedDeployments)
+		assert.Equal(t, 2, metrics.Summary.Rollbacks)
+		assert.Equal(t, 2.0, metrics.Summary.DeploymentFrequency)
+		assert.Equal(t, 0.5, *metrics.Summary.ChangeFailureRate)
+		assert.Equal(t, 300.0, *metrics.Summary.MeanTimeToRestore)
+		assert.Equal(t, 3600.0, *metrics.Summary.MeanLeadTime)
+
+		assert.Len(t, metrics.Workloads, 2)
+		assert.Equal(t, "a-app", metrics.Workloads[0].Name)
+		assert.Equal(t, "prod", metrics.Workloads[0].Env)
+		assert.Equal(t, 1.0, *metrics.Workloads[0].Metrics.ChangeFailureRate)
+		assert.Equal(t, "b-app", metrics.Workloads[1].Name)
+		assert.Equal(t, 6, metrics.Workloads[1].Metrics.Deployments)
+		assert.Equal(t, 0, metrics.Workloads[1].Metrics.Rollbacks)
+		assert.Nil(t, metrics.Workloads[1].Metrics.MeanLeadTime)
+
+		assert.Len(t, metrics.Series, 4)
+		assert.Equal(t, 4, metrics.Series[0].Deployments)
+		assert.Equal(t, 2, metrics.Series[0].FailedDeployments)
+		assert.Equal(t, 0, metrics.Series[1].Deployments)
+		assert.Equal(t, 4, metrics.Series[2].Deployments)
+		assert.Equal(t, 0, metrics.Series[3].Deployments)
+	})
+}
@@ -0,0 +1,248 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nais/console-backend/internal/auth"
+	"github.com/nais/console-backend/internal/database/gensql"
+	"github.com/nais/console-backend/internal/dependencytrack"
+	"github.com/nais/console-backend/internal/graph/apierror"
+	"github.com/nais/console-backend/internal/graph/model"
+	"github.com/nais/console-backend/internal/graph/scalar"
+	"github.com/nais/console-backend/internal/upstream"
+	"github.com/nais/console-backend/internal/vulnerabilityindex"
+)
+
+// analyzeFinding records an analysis of a finding of an app in DependencyTrack and in the audit trail, and returns the
+// updated finding
+func (r *Resolver) analyzeFinding(ctx context.Context, team, env, appName string, findingID scalar.Ident, analysis dependencytrack.Analysis) (*model.VulnerabilityFinding, error) {
+	if !r.hasAccess(ctx, team) {
+		return nil, fmt.Errorf("access denied")
+	}
+
+	if findingID.Type != scalar.IdentTypeVulnerabilityFinding {
+		return nil, apierror.Errorf("The ID %q is not the ID of a vulnerability finding.", findingID.ID)
+	}
+
+	email, err := auth.GetEmail(ctx)
+	if err != nil {
+		return nil, apierror.ErrNoEmailInSession
+	}
+
+	app, err := r.k8sClient.App(ctx, appName, team, env)
+	if err != nil {
+		return nil, apierror.ErrAppNotFound
+	}
+	instance := &dependencytrack.AppInstance{Env: env, Team: team, App: appName, Image: app.Image}
+
+	findings, err := r.dependencyTrackClient.VulnerabilityFindings(ctx, instance)
+	if err != nil {
+		return nil, fmt.Errorf("getting vulnerability findings from DependencyTrack: %w", err)
+	}
+
+	// the finding must belong to the app, as access is granted through the team owning the app
+	var finding *model.VulnerabilityFinding
+	for _, f := range findings {
+		if f.ID.ID == findingID.ID {
+			finding = f
+			break
+		}
+	}
+	if finding == nil {
+		return nil, apierror.Errorf("We were unable to find the finding in the app %q.", appName)
+	}
+
+	// the audit trail is written first, so that no analysis is recorded in DependencyTrack without an audit entry. The
+	// entry is removed if the analysis cannot be recorded.
+	params := gensql.VulnerabilityAnalysisAuditCreateParams{
+		Actor:      email,
+		Team:       team,
+		Env:        env,
+		App:        appName,
+		FindingID:  findingID.ID,
+		State:      analysis.State,
+		Suppressed: analysis.Suppressed,
+	}
+	if analysis.Justification != "" {
+		params.Justification = &analysis.Justification
+	}
+	if analysis.Comment != "" {
+		params.Comment = &analysis.Comment
+	}
+	auditID, err := r.querier.VulnerabilityAnalysisAuditCreate(ctx, params)
+	if err != nil {
+		r.log.WithError(err).Errorf("recording analysis of finding %q in audit trail", findingID.ID)
+		return nil, apierror.ErrDatabase
+	}
+
+	if err := r.dependencyTrackClient.RecordAnalysis(ctx, instance, findingID.ID, analysis); err != nil {
+		if err := r.querier.VulnerabilityAnalysisAuditDelete(context.WithoutCancel(ctx), auditID); err != nil {
+			r.log.WithError(err).Errorf("removing analysis of finding %q from audit trail", findingID.ID)
+		}
+		return nil, fmt.Errorf("recording analysis in DependencyTrack: %w", err)
+	}
+
+	// the findings are shared with the cache, so the updated finding is a copy
+	ret := *finding
+	ret.AnalysisState = analysis.State
+	if analysis.Suppressed != nil {
+		ret.Suppressed = *analysis.Suppressed
+	}
+	return &ret, nil
+}
+
+func findingAnalysis(state model.FindingAnalysisState, justification *model.FindingAnalysisJustification, comment *string, suppressed *bool) dependencytrack.Analysis {
+	analysis := dependencytrack.Analysis{
+		State:      state.String(),
+		Suppressed: suppressed,
+	}
+	if justification != nil {
+		analysis.Justification = justification.String()
+	}
+	if comment != nil {
+		analysis.Comment = *comment
+	}
+	return analysis
+}
+
+// teamVulnerabilities converts vulnerability summaries from the index to the GraphQL model. If severity is set, only
+// summaries with at least one vulnerability of the severity are included.
+func teamVulnerabilities(rows []*gensql.VulnerabilitySummariesRow, severity *model.VulnerabilitySeverity) []model.TeamVulnerabilities {
+	ret := make([]model.TeamVulnerabilities, 0, len(rows))
+	for _, row := range rows {
+		summary := model.VulnerabilitySummary{
+			Total:      int(row.Critical + row.High + row.Medium + row.Low + row.Unassigned),
+			RiskScore:  int(row.RiskScore),
+			Critical:   int(row.Critical),
+			High:       int(row.High),
+			Medium:     int(row.Medium),
+			Low:        int(row.Low),
+			Unassigned: int(row.Unassigned),
+		}
+
+		if severity != nil && severityCount(summary, *severity) == 0 {
+			continue
+		}
+
+		ret = append(ret, model.TeamVulnerabilities{
+			Team:           row.Team,
+			Env:            row.Env,
+			Apps:           int(row.Apps),
+			AppsWithoutBom: int(row.AppsWithoutBom),
+			Summary:        summary,
+			Updated:        row.UpdatedAt.Time,
+		})
+	}
+	return ret
+}
+
+func severityCount(summary model.VulnerabilitySummary, severity model.VulnerabilitySeverity) int {
+	switch severity {
+	case model.VulnerabilitySeverityCritical:
+		return summary.Critical
+	case model.VulnerabilitySeverityHigh:
+		return summary.High
+	case model.VulnerabilitySeverityMedium:
+		return summary.Medium
+	case model.VulnerabilitySeverityLow:
+		return summary.Low
+	case model.VulnerabilitySeverityUnassigned:
+		return summary.Unassigned
+	}
+	return 0
+}
+
+// componentUsage converts components from the index to the GraphQL model. If versionRange is set, only components with
+// a version in the range are included.
+func componentUsage(rows []*gensql.VulnerabilityIndexComponent, versionRange vulnerabilityindex.VersionRange) []model.ComponentUsage {
+	ret := make([]model.ComponentUsage, 0, len(rows))
+	for _, row := range rows {
+		if versionRange != nil && !versionRange.Contains(row.Version) {
+			continue
+		}
+
+		component := dependencytrack.Component{Group: row.Group, Name: row.Name}
+		ret = append(ret, model.ComponentUsage{
+			Team: row.Team,
+			Env:  row.Env,
+			App:  row.App,
+			Component: model.VulnerableComponent{
+				Name:    component.FullName(),
+				Version: row.Version,
+				Purl:    row.Purl,
+			},
+		})
+	}
+	return ret
+}
+
+// workloadInstances returns the running apps and naisjobs of a team
+func (r *Resolver) workloadInstances(ctx context.Context, team string) ([]*dependencytrack.AppInstance, error) {
+	apps, err := r.k8sClient.Apps(ctx, team)
+	if err != nil {
+		return nil, fmt.Errorf("getting apps from Kubernetes: %w", err)
+	}
+
+	jobs, err := r.k8sClient.NaisJobs(ctx, team)
+	if err != nil {
+		return nil, fmt.Errorf("getting naisjobs from Kubernetes: %w", err)
+	}
+
+	instances := make([]*dependencytrack.AppInstance, 0, len(apps)+len(jobs))
+	for _, app := range apps {
+		instances = append(instances, &dependencytrack.AppInstance{
+			Env:   app.Env.Name,
+			App:   app.Name,
+			Image: app.Image,
+			Team:  team,
+			Kind:  model.WorkloadTypeApp,
+		})
+	}
+	for _, job := range jobs {
+		instances = append(instances, &dependencytrack.AppInstance{
+			Env:   job.Env.Name,
+			App:   job.Name,
+			Image: job.Image,
+			Team:  team,
+			Kind:  model.WorkloadTypeNaisjob,
+		})
+	}
+	return instances, nil
+}
+
+// vulnerabilityNodes splits the results of fetching vulnerabilities into the nodes to return, including stale nodes, and
+// errors for the apps that failed
+func vulnerabilityNodes(results []*dependencytrack.AppVulnerabilities) ([]*model.VulnerabilitiesNode, []model.VulnerabilitiesError) {
+	nodes := make([]*model.VulnerabilitiesNode, 0, len(results))
+	errs := make([]model.VulnerabilitiesError, 0)
+	for _, result := range results {
+		if result.Node != nil {
+			nodes = append(nodes, result.Node)
+		}
+
+		if result.Err != nil {
+			errs = append(errs, model.VulnerabilitiesError{
+				AppName:      result.App.App,
+				WorkloadType: result.App.WorkloadType(),
+				Env:          result.App.Env,
+				Message:      vulnerabilitiesErrorMessage(result.Err),
+				Stale:        result.Stale,
+			})
+		}
+	}
+	return nodes, errs
+}
+
+// vulnerabilitiesErrorMessage returns a user-facing description of an error fetching vulnerabilities from DependencyTrack
+func vulnerabilitiesErrorMessage(err error) string {
+	var unavailableErr *upstream.UnavailableError
+	switch {
+	case errors.As(err, &unavailableErr):
+		return "DependencyTrack is unavailable."
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, dependencytrack.ErrSlow):
+		return "DependencyTrack did not respond in time."
+	}
+	return "Unable to fetch vulnerabilities from DependencyTrack."
+}
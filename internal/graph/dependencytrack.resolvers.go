@@ -0,0 +1,162 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+
+	"github.com/nais/console-backend/internal/database/gensql"
+	"github.com/nais/console-backend/internal/graph/apierror"
+	"github.com/nais/console-backend/internal/graph/model"
+	"github.com/nais/console-backend/internal/graph/model/vulnerabilities"
+	"github.com/nais/console-backend/internal/graph/scalar"
+	"github.com/nais/console-backend/internal/vulnerabilityindex"
+)
+
+// AnalyzeFinding is the resolver for the analyzeFinding field.
+func (r *mutationResolver) AnalyzeFinding(ctx context.Context, team string, env string, app string, findingID scalar.Ident, state model.FindingAnalysisState, justification *model.FindingAnalysisJustification, comment *string, suppressed *bool) (*model.VulnerabilityFinding, error) {
+	return r.analyzeFinding(ctx, team, env, app, findingID, findingAnalysis(state, justification, comment, suppressed))
+}
+
+// SuppressFinding is the resolver for the suppressFinding field.
+func (r *mutationResolver) SuppressFinding(ctx context.Context, team string, env string, app string, findingID scalar.Ident, state model.FindingAnalysisState, justification *model.FindingAnalysisJustification, comment *string) (*model.VulnerabilityFinding, error) {
+	suppressed := true
+	return r.analyzeFinding(ctx, team, env, app, findingID, findingAnalysis(state, justification, comment, &suppressed))
+}
+
+// Vulnerabilities is the resolver for the vulnerabilities field.
+func (r *queryResolver) Vulnerabilities(ctx context.Context, first *int, last *int, after *scalar.Cursor, before *scalar.Cursor, filter *model.VulnerabilitiesFilter, orderBy *model.OrderBy) (*model.TeamVulnerabilitiesConnection, error) {
+	params := gensql.VulnerabilitySummariesParams{}
+	var severity *model.VulnerabilitySeverity
+	if filter != nil {
+		params.Team = filter.Team
+		params.Env = filter.Env
+		severity = filter.Severity
+	}
+
+	rows, err := r.querier.VulnerabilitySummaries(ctx, params)
+	if err != nil {
+		r.log.WithError(err).Errorf("unable to get vulnerability summaries")
+		return nil, apierror.ErrDatabase
+	}
+
+	summaries := teamVulnerabilities(rows, severity)
+	if orderBy == nil {
+		orderBy = &model.OrderBy{Field: model.OrderByFieldRiskScore, Direction: model.SortOrderDesc}
+	}
+	vulnerabilities.SortTeamVulnerabilities(summaries, orderBy.Field, orderBy.Direction)
+
+	pagination, err := model.NewPagination(first, last, after, before)
+	if err != nil {
+		return nil, err
+	}
+	edges := make([]model.TeamVulnerabilitiesEdge, 0)
+	start, end := pagination.ForSlice(len(summaries))
+
+	for i, s := range summaries[start:end] {
+		edges = append(edges, model.TeamVulnerabilitiesEdge{
+			Cursor: scalar.Cursor{Offset: start + i},
+			Node:   s,
+		})
+	}
+
+	var startCursor *scalar.Cursor
+	var endCursor *scalar.Cursor
+	if len(edges) > 0 {
+		startCursor = &edges[0].Cursor
+		endCursor = &edges[len(edges)-1].Cursor
+	}
+
+	hasNext := len(summaries) > pagination.First()+pagination.After().Offset+1
+	hasPrevious := pagination.After().Offset > 0
+
+	if pagination.Before() != nil && startCursor != nil {
+		hasNext = true
+		hasPrevious = startCursor.Offset > 0
+	}
+
+	return &model.TeamVulnerabilitiesConnection{
+		TotalCount: len(summaries),
+		Edges:      edges,
+		PageInfo: model.PageInfo{
+			HasNextPage:     hasNext,
+			HasPreviousPage: hasPrevious,
+			StartCursor:     startCursor,
+			EndCursor:       endCursor,
+		},
+	}, nil
+}
+
+// ComponentUsage is the resolver for the componentUsage field.
+func (r *queryResolver) ComponentUsage(ctx context.Context, purl *string, name *string, versionRange *string, first *int, last *int, after *scalar.Cursor, before *scalar.Cursor) (*model.ComponentUsageConnection, error) {
+	if purl == nil && name == nil {
+		return nil, apierror.Errorf("Either the package URL or the name of the component must be set.")
+	}
+
+	var versions vulnerabilityindex.VersionRange
+	if versionRange != nil {
+		var err error
+		versions, err = vulnerabilityindex.ParseVersionRange(*versionRange)
+		if err != nil {
+			return nil, apierror.Errorf("Invalid version range %q: %s", *versionRange, err)
+		}
+	}
+
+	rows, err := r.querier.ComponentUsage(ctx, gensql.ComponentUsageParams{
+		Name: name,
+		Purl: purl,
+	})
+	if err != nil {
+		r.log.WithError(err).Errorf("unable to get component usage")
+		return nil, apierror.ErrDatabase
+	}
+
+	usage := componentUsage(rows, versions)
+
+	pagination, err := model.NewPagination(first, last, after, before)
+	if err != nil {
+		return nil, err
+	}
+	edges := make([]model.ComponentUsageEdge, 0)
+	start, end := pagination.ForSlice(len(usage))
+
+	for i, u := range usage[start:end] {
+		edges = append(edges, model.ComponentUsageEdge{
+			Cursor: scalar.Cursor{Offset: start + i},
+			Node:   u,
+		})
+	}
+
+	var startCursor *scalar.Cursor
+	var endCursor *scalar.Cursor
+	if len(edges) > 0 {
+		startCursor = &edges[0].Cursor
+		endCursor = &edges[len(edges)-1].Cursor
+	}
+
+	hasNext := len(usage) > pagination.First()+pagination.After().Offset+1
+	hasPrevious := pagination.After().Offset > 0
+
+	if pagination.Before() != nil && startCursor != nil {
+		hasNext = true
+		hasPrevious = startCursor.Offset > 0
+	}
+
+	return &model.ComponentUsageConnection{
+		TotalCount: len(usage),
+		Edges:      edges,
+		PageInfo: model.PageInfo{
+			HasNextPage:     hasNext,
+			HasPreviousPage: hasPrevious,
+			StartCursor:     startCursor,
+			EndCursor:       endCursor,
+		},
+	}, nil
+}
+
+// VulnerabilityRiskModel is the resolver for the vulnerabilityRiskModel field.
+func (r *queryResolver) VulnerabilityRiskModel(ctx context.Context) (*model.VulnerabilityRiskModel, error) {
+	return r.dependencyTrackClient.RiskModel(), nil
+}
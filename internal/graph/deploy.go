@@ -1,6 +1,8 @@
 package graph
 
 import (
+	"fmt"
+
 	"github.com/nais/console-backend/internal/graph/model"
 	"github.com/nais/console-backend/internal/graph/scalar"
 	"github.com/nais/console-backend/internal/hookd"
@@ -12,28 +14,30 @@ func deployEdges(deploys []hookd.Deploy, p *model.Pagination) []model.Deployment
 	start, end := p.ForSlice(len(deploys))
 
 	for i, deploy := range deploys[start:end] {
-		deploy := deploy
 		edges = append(edges, model.DeploymentEdge{
 			Cursor: scalar.Cursor{Offset: start + i},
-			Node: model.Deployment{
-				ID:        scalar.DeploymentIdent(deploy.DeploymentInfo.ID),
-				Statuses:  mapStatuses(deploy.Statuses),
-				Resources: mapResources(deploy.Resources),
-				Team: model.Team{
-					Name: deploy.DeploymentInfo.Team,
-					ID:   scalar.TeamIdent(deploy.DeploymentInfo.Team),
-				},
-				Env:        deploy.DeploymentInfo.Cluster,
-				Created:    deploy.DeploymentInfo.Created,
-				Repository: deploy.DeploymentInfo.GithubRepository,
-			},
+			Node:   mapDeployment(deploy),
 		})
-
 	}
 
 	return edges
 }
 
+func mapDeployment(deploy hookd.Deploy) model.Deployment {
+	return model.Deployment{
+		ID:        scalar.DeploymentIdent(deploy.DeploymentInfo.ID),
+		Statuses:  mapStatuses(deploy.Statuses),
+		Resources: mapResources(deploy.Resources),
+		Team: model.Team{
+			Name: deploy.DeploymentInfo.Team,
+			ID:   scalar.TeamIdent(deploy.DeploymentInfo.Team),
+		},
+		Env:        deploy.DeploymentInfo.Cluster,
+		Created:    deploy.DeploymentInfo.Created,
+		Repository: deploy.DeploymentInfo.GithubRepository,
+	}
+}
+
 func mapResources(resources []hookd.Resource) []model.DeploymentResource {
 	ret := make([]model.DeploymentResource, 0)
 	for _, resource := range resources {
@@ -61,3 +65,61 @@ func mapStatuses(statuses []hookd.Status) []model.DeploymentStatus {
 	}
 	return ret
 }
+
+// deployStates maps deployment states to the states of hookd deploys
+var deployStates = map[model.DeploymentState]string{
+	model.DeploymentStateSuccess:    hookd.StateSuccess,
+	model.DeploymentStateFailure:    hookd.StateFailure,
+	model.DeploymentStateInProgress: hookd.StateInProgress,
+}
+
+// deployRequestOptions returns the hookd request options for a filter and a limit. The limit is applied after the
+// filter.
+func deployRequestOptions(filter *model.DeploymentFilter, limit int) ([]hookd.RequestOption, error) {
+	opts := []hookd.RequestOption{hookd.WithLimit(limit)}
+	if filter == nil {
+		return opts, nil
+	}
+
+	if filter.From != nil && filter.To != nil && *filter.From > *filter.To {
+		return nil, fmt.Errorf("from date cannot be after to date")
+	}
+
+	if filter.Env != nil {
+		opts = append(opts, hookd.WithCluster(*filter.Env))
+	}
+
+	if filter.Repository != nil {
+		opts = append(opts, hookd.WithRepository(*filter.Repository))
+	}
+
+	if filter.ResourceKind != nil {
+		opts = append(opts, hookd.WithResourceKind(*filter.ResourceKind))
+	}
+
+	if filter.ResourceName != nil {
+		opts = append(opts, hookd.WithResourceName(*filter.ResourceName))
+	}
+
+	if filter.Status != nil {
+		opts = append(opts, hookd.WithState(deployStates[*filter.Status]))
+	}
+
+	if filter.From != nil {
+		from, err := filter.From.Time()
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, hookd.WithCreatedFrom(from))
+	}
+
+	if filter.To != nil {
+		to, err := filter.To.Time()
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, hookd.WithCreatedBefore(to.AddDate(0, 0, 1)))
+	}
+
+	return opts, nil
+}
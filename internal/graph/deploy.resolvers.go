@@ -14,12 +14,17 @@ import (
 )
 
 // Deployments is the resolver for the deployments field.
-func (r *queryResolver) Deployments(ctx context.Context, first *int, last *int, after *scalar.Cursor, before *scalar.Cursor, limit *int) (*model.DeploymentConnection, error) {
+func (r *queryResolver) Deployments(ctx context.Context, first *int, last *int, after *scalar.Cursor, before *scalar.Cursor, limit *int, filter *model.DeploymentFilter) (*model.DeploymentConnection, error) {
 	l := 100
 	if limit != nil {
 		l = *limit
 	}
-	deploys, err := r.hookdClient.Deployments(ctx, hookd.WithLimit(l), hookd.WithIgnoreTeams("nais-verification"))
+	opts, err := deployRequestOptions(filter, l)
+	if err != nil {
+		return nil, err
+	}
+
+	deploys, err := r.hookdClient.Deployments(ctx, append(opts, hookd.WithIgnoreTeams("nais-verification"))...)
 	if err != nil {
 		return nil, fmt.Errorf("getting deploys from Hookd: %w", err)
 	}
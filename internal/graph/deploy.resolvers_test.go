@@ -0,0 +1,93 @@
+package graph_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nais/console-backend/internal/graph"
+	"github.com/nais/console-backend/internal/graph/model"
+	"github.com/nais/console-backend/internal/graph/scalar"
+	"github.com/nais/console-backend/internal/hookd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_queryResolver_Deployments(t *testing.T) {
+	ctx := context.Background()
+	created := time.Date(2023, time.November, 1, 10, 0, 0, 0, time.UTC)
+
+	deploys := []hookd.Deploy{
+		{DeploymentInfo: hookd.DeploymentInfo{ID: "1", Team: "team", Cluster: "dev", GithubRepository: "org/app", Created: created}},
+		{DeploymentInfo: hookd.DeploymentInfo{ID: "2", Team: "team", Cluster: "dev", GithubRepository: "org/app", Created: created.AddDate(0, 0, 1)}},
+	}
+
+	t.Run("no filter", func(t *testing.T) {
+		hookdClient := hookd.NewMockClient(t)
+		hookdClient.EXPECT().
+			Deployments(ctx, mock.AnythingOfType("hookd.RequestOption"), mock.AnythingOfType("hookd.RequestOption")).
+			RunAndReturn(func(_ context.Context, opts ...hookd.RequestOption) ([]hookd.Deploy, error) {
+				assert.Equal(t, hookd.Filter{Limit: 100, IgnoreTeams: []string{"nais-verification"}}, hookd.FilterFromOptions(opts...))
+				return deploys, nil
+			})
+
+		resp, err := graph.
+			NewResolver(hookdClient, nil, nil, nil, nil, nil, nil, nil).
+			Query().
+			Deployments(ctx, nil, nil, nil, nil, nil, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, resp.TotalCount)
+		assert.Equal(t, "1", resp.Edges[0].Node.ID.ID)
+	})
+
+	t.Run("filter and limit are passed on", func(t *testing.T) {
+		hookdClient := hookd.NewMockClient(t)
+		hookdClient.EXPECT().
+			Deployments(ctx, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			RunAndReturn(func(_ context.Context, opts ...hookd.RequestOption) ([]hookd.Deploy, error) {
+				assert.Equal(t, hookd.Filter{
+					Cluster:       "dev",
+					Limit:         1,
+					IgnoreTeams:   []string{"nais-verification"},
+					Repository:    "org/app",
+					ResourceKind:  "Application",
+					ResourceName:  "app",
+					State:         hookd.StateFailure,
+					CreatedFrom:   created.Truncate(24 * time.Hour),
+					CreatedBefore: created.Truncate(24*time.Hour).AddDate(0, 0, 2),
+				}, hookd.FilterFromOptions(opts...))
+				return deploys[1:], nil
+			})
+
+		limit := 1
+		env, repository, kind, name := "dev", "org/app", "Application", "app"
+		status := model.DeploymentStateFailure
+		from := scalar.NewDate(created)
+		to := scalar.NewDate(created.AddDate(0, 0, 1))
+		resp, err := graph.
+			NewResolver(hookdClient, nil, nil, nil, nil, nil, nil, nil).
+			Query().
+			Deployments(ctx, nil, nil, nil, nil, &limit, &model.DeploymentFilter{
+				Env:          &env,
+				Repository:   &repository,
+				ResourceKind: &kind,
+				ResourceName: &name,
+				Status:       &status,
+				From:         &from,
+				To:           &to,
+			})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, resp.TotalCount)
+		assert.Equal(t, "2", resp.Edges[0].Node.ID.ID)
+	})
+
+	t.Run("from after to", func(t *testing.T) {
+		from := scalar.NewDate(created.AddDate(0, 0, 2))
+		to := scalar.NewDate(created)
+		_, err := graph.
+			NewResolver(hookd.NewMockClient(t), nil, nil, nil, nil, nil, nil, nil).
+			Query().
+			Deployments(ctx, nil, nil, nil, nil, nil, &model.DeploymentFilter{From: &from, To: &to})
+		assert.ErrorContains(t, err, "from date cannot be after to date")
+	})
+}
@@ -2,7 +2,6 @@ package graph_test
 
 import (
 	"context"
-	"net/http"
 	"testing"
 
 	"github.com/nais/console-backend/internal/graph"
@@ -30,12 +29,9 @@ func Test_deployInfoResolver_History(t *testing.T) {
 		Deployments(ctx, mock.AnythingOfType("hookd.RequestOption"), mock.AnythingOfType("hookd.RequestOption")).
 		Run(func(_ context.Context, opts ...hookd.RequestOption) {
 			assert.Len(t, opts, 2)
-			r, _ := http.NewRequest("GET", "http://example.com", nil)
-			for _, opt := range opts {
-				opt(r)
-			}
-			assert.Contains(t, r.URL.RawQuery, "team=some-team")
-			assert.Contains(t, r.URL.RawQuery, "cluster=production")
+			filter := hookd.FilterFromOptions(opts...)
+			assert.Equal(t, "some-team", filter.Team)
+			assert.Equal(t, "production", filter.Cluster)
 		}).
 		Return([]hookd.Deploy{
 			{
@@ -18,6 +18,12 @@ type (
 		AppName string
 	}
 
+	MonthlyCostGQLVars struct {
+		Team string
+		App  string
+		Env  string
+	}
+
 	NaisJobGQLVars struct {
 		Team string
 	}
@@ -27,4 +33,12 @@ type (
 		Team    string
 		NaisJob string
 	}
+
+	TeamBudgetGQLVars struct {
+		Team string
+	}
+
+	UserDashboardGQLVars struct {
+		Teams []string
+	}
 )
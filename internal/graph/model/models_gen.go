@@ -59,6 +59,11 @@ type Storage interface {
 	GetName() string
 }
 
+// A workload is either an application or a job.
+type Workload interface {
+	IsWorkload()
+}
+
 type AccessPolicy struct {
 	Inbound  Inbound  `json:"inbound"`
 	Outbound Outbound `json:"outbound"`
@@ -88,7 +93,13 @@ type App struct {
 	Team            Team                 `json:"team"`
 	AppState        AppState             `json:"appState"`
 	Vulnerabilities *VulnerabilitiesNode `json:"vulnerabilities,omitempty"`
-	GQLVars         AppGQLVars           `json:"-"`
+	// Vulnerability findings for the image of the app. Defaults to ordering by severity, most severe first.
+	VulnerabilityFindings VulnerabilityFindingConnection `json:"vulnerabilityFindings"`
+	// Unsuppressed policy violations of the components of the app, as evaluated by DependencyTrack.
+	PolicyViolations PolicyViolationConnection `json:"policyViolations"`
+	// Daily vulnerability history of the app.
+	VulnerabilityHistory VulnerabilityHistory `json:"vulnerabilityHistory"`
+	GQLVars              AppGQLVars           `json:"-"`
 }
 
 func (App) IsNode() {}
@@ -98,6 +109,8 @@ func (this App) GetID() scalar.Ident { return this.ID }
 
 func (App) IsSearchNode() {}
 
+func (App) IsWorkload() {}
+
 type AppConnection struct {
 	TotalCount int       `json:"totalCount"`
 	PageInfo   PageInfo  `json:"pageInfo"`
@@ -134,6 +147,37 @@ type AppCost struct {
 	Cost []CostEntry `json:"cost"`
 }
 
+// The month-over-month growth in cost of an application. The current period is from the first day of the month of the
+// end date up to and including the end date, and the previous period is the same days of the month before.
+type AppCostGrowth struct {
+	// The name of the team that owns the application.
+	Team string `json:"team"`
+	// The environment of the application.
+	Env string `json:"env"`
+	// The name of the application.
+	App string `json:"app"`
+	// The cost of the current period in euros.
+	CurrentCost float64 `json:"currentCost"`
+	// The cost of the previous period in euros.
+	PreviousCost float64 `json:"previousCost"`
+	// The increase in cost from the previous period in euros.
+	Growth float64 `json:"growth"`
+	// The increase in cost from the previous period, in percent. Null if there was no cost in the previous period.
+	GrowthPercent *float64 `json:"growthPercent,omitempty"`
+}
+
+// The cost of an application in a ranking.
+type AppCostRanking struct {
+	// The name of the team that owns the application.
+	Team string `json:"team"`
+	// The environment of the application.
+	Env string `json:"env"`
+	// The name of the application.
+	App string `json:"app"`
+	// The cost of the application in euros.
+	Sum float64 `json:"sum"`
+}
+
 type AppEdge struct {
 	Cursor scalar.Cursor `json:"cursor"`
 	Node   App           `json:"node"`
@@ -222,6 +266,54 @@ type Claims struct {
 	Groups []Group  `json:"groups"`
 }
 
+// An app running a component.
+type ComponentUsage struct {
+	// The name of the team owning the app.
+	Team string `json:"team"`
+	// The environment of the app.
+	Env string `json:"env"`
+	// The name of the app.
+	App string `json:"app"`
+	// The component.
+	Component VulnerableComponent `json:"component"`
+}
+
+type ComponentUsageConnection struct {
+	TotalCount int                  `json:"totalCount"`
+	PageInfo   PageInfo             `json:"pageInfo"`
+	Edges      []ComponentUsageEdge `json:"edges"`
+}
+
+func (ComponentUsageConnection) IsConnection() {}
+
+// The total count of items in the connection.
+func (this ComponentUsageConnection) GetTotalCount() int { return this.TotalCount }
+
+// Pagination information.
+func (this ComponentUsageConnection) GetPageInfo() PageInfo { return this.PageInfo }
+
+// A list of edges.
+func (this ComponentUsageConnection) GetEdges() []Edge {
+	if this.Edges == nil {
+		return nil
+	}
+	interfaceSlice := make([]Edge, 0, len(this.Edges))
+	for _, concrete := range this.Edges {
+		interfaceSlice = append(interfaceSlice, concrete)
+	}
+	return interfaceSlice
+}
+
+type ComponentUsageEdge struct {
+	Cursor scalar.Cursor  `json:"cursor"`
+	Node   ComponentUsage `json:"node"`
+}
+
+func (ComponentUsageEdge) IsEdge() {}
+
+// A cursor for use in pagination.
+func (this ComponentUsageEdge) GetCursor() scalar.Cursor { return this.Cursor }
+
 type Consume struct {
 	Name string `json:"name"`
 }
@@ -231,6 +323,50 @@ type Consumer struct {
 	Orgno string `json:"orgno"`
 }
 
+// A day, or consecutive days, where the daily cost of an application and cost type increased significantly above its baseline.
+type CostAnomaly struct {
+	// The environment of the application.
+	Env string `json:"env"`
+	// The name of the application.
+	App string `json:"app"`
+	// The type of cost.
+	CostType string `json:"costType"`
+	// The first day the anomaly was seen.
+	FirstSeen scalar.Date `json:"firstSeen"`
+	// The last day the anomaly was seen.
+	LastSeen scalar.Date `json:"lastSeen"`
+	// The cost of the last day the anomaly was seen in euros.
+	Cost float64 `json:"cost"`
+	// The mean daily cost of the 14 days before the last day the anomaly was seen in euros.
+	Baseline float64 `json:"baseline"`
+	// The increase of the cost from the baseline in euros.
+	Magnitude float64 `json:"magnitude"`
+}
+
+// The cost of a team in a date range, broken down hierarchically.
+type CostBreakdown struct {
+	// The total cost of the team in the date range in euros.
+	Sum float64 `json:"sum"`
+	// The top level of the breakdown, ordered by the highest cost first.
+	Groups []CostBreakdownNode `json:"groups"`
+}
+
+// A group of cost in a cost breakdown.
+type CostBreakdownNode struct {
+	// The level of the breakdown the group belongs to.
+	Group CostBreakdownGroup `json:"group"`
+	// The name of the cost type, environment or application.
+	Name string `json:"name"`
+	// The cost of the group in euros.
+	Sum float64 `json:"sum"`
+	// The share of the total cost of the team, in percent.
+	PercentOfTotal float64 `json:"percentOfTotal"`
+	// The share of the cost of the parent group, in percent. Equal to percentOfTotal at the top level.
+	PercentOfParent float64 `json:"percentOfParent"`
+	// The next level of the breakdown, ordered by the highest cost first. Empty at the bottom level.
+	Children []CostBreakdownNode `json:"children"`
+}
+
 // Cost entry type.
 type CostEntry struct {
 	// The date for the entry.
@@ -239,6 +375,20 @@ type CostEntry struct {
 	Cost float64 `json:"cost"`
 }
 
+// Forecast of the cost of a month, projected from the trend of the daily cost of each cost type.
+type CostForecast struct {
+	// The first day of the month.
+	Month scalar.Date `json:"month"`
+	// The projected cost of the month in euros, including the cost recorded so far.
+	Cost float64 `json:"cost"`
+	// The lower bound of the 95% confidence interval of the cost in euros.
+	LowerBound float64 `json:"lowerBound"`
+	// The upper bound of the 95% confidence interval of the cost in euros.
+	UpperBound float64 `json:"upperBound"`
+	// The forecast of each cost type.
+	Series []CostTypeForecast `json:"series"`
+}
+
 // Cost series type.
 type CostSeries struct {
 	// The type of cost.
@@ -249,6 +399,28 @@ type CostSeries struct {
 	Data []CostEntry `json:"data"`
 }
 
+// Forecast of the cost of a cost type for a month.
+type CostTypeForecast struct {
+	// The type of cost.
+	CostType string `json:"costType"`
+	// The projected cost of the month in euros, including the cost recorded so far.
+	Cost float64 `json:"cost"`
+	// The lower bound of the 95% confidence interval of the cost in euros.
+	LowerBound float64 `json:"lowerBound"`
+	// The upper bound of the 95% confidence interval of the cost in euros.
+	UpperBound float64 `json:"upperBound"`
+}
+
+// Input for creating a new team.
+type CreateTeamInput struct {
+	// The name of the team, also known as the team slug.
+	Name string `json:"name"`
+	// The purpose of the team.
+	Purpose string `json:"purpose"`
+	// The main Slack channel for the team.
+	SlackChannel string `json:"slackChannel"`
+}
+
 // Current resource utilization type.
 type CurrentResourceUtilization struct {
 	// The timestamp used for the calculated values.
@@ -277,6 +449,44 @@ type DatabaseUser struct {
 	Name string `json:"name"`
 }
 
+// Delivery metrics type.
+type DeliveryMetrics struct {
+	// Metrics across all applications and jobs.
+	Summary DeliveryMetricsSummary `json:"summary"`
+	// Metrics per application or job and environment.
+	Workloads []WorkloadDeliveryMetrics `json:"workloads"`
+	// Daily number of deployments.
+	Series []DeliveryMetricsEntry `json:"series"`
+}
+
+// Delivery metrics entry type.
+type DeliveryMetricsEntry struct {
+	// The date for the entry.
+	Date scalar.Date `json:"date"`
+	// The number of finished deployments.
+	Deployments int `json:"deployments"`
+	// The number of failed deployments.
+	FailedDeployments int `json:"failedDeployments"`
+}
+
+// Delivery metrics summary type.
+type DeliveryMetricsSummary struct {
+	// The number of finished deployments.
+	Deployments int `json:"deployments"`
+	// The number of failed deployments.
+	FailedDeployments int `json:"failedDeployments"`
+	// The number of times an application was rolled back to a commit it has run before.
+	Rollbacks int `json:"rollbacks"`
+	// The average number of deployments per day.
+	DeploymentFrequency float64 `json:"deploymentFrequency"`
+	// The ratio of deployments that failed or were rolled back, between 0 and 1. Null if there are no deployments.
+	ChangeFailureRate *float64 `json:"changeFailureRate,omitempty"`
+	// The mean time in seconds from an application started failing until the next successful deployment. Null if there are no restores.
+	MeanTimeToRestore *float64 `json:"meanTimeToRestore,omitempty"`
+	// The mean time in seconds from commit until deployment. Null if no commit timestamps are available.
+	MeanLeadTime *float64 `json:"meanLeadTime,omitempty"`
+}
+
 type DeployInfo struct {
 	Deployer  string             `json:"deployer"`
 	Timestamp *time.Time         `json:"timestamp,omitempty"`
@@ -334,6 +544,24 @@ func (DeploymentEdge) IsEdge() {}
 // A cursor for use in pagination.
 func (this DeploymentEdge) GetCursor() scalar.Cursor { return this.Cursor }
 
+// Deployment filter input type.
+type DeploymentFilter struct {
+	// Only include deployments to the given environment.
+	Env *string `json:"env,omitempty"`
+	// Only include deployments from the given GitHub repository, with the org prefix, for instance 'org/repo'.
+	Repository *string `json:"repository,omitempty"`
+	// Only include deployments containing a resource of the given kind, for instance 'Application'.
+	ResourceKind *string `json:"resourceKind,omitempty"`
+	// Only include deployments containing a resource with the given name.
+	ResourceName *string `json:"resourceName,omitempty"`
+	// Only include deployments with the given final status.
+	Status *DeploymentState `json:"status,omitempty"`
+	// Only include deployments created on or after the given date.
+	From *scalar.Date `json:"from,omitempty"`
+	// Only include deployments created on or before the given date.
+	To *scalar.Date `json:"to,omitempty"`
+}
+
 // Deployment key type.
 type DeploymentKey struct {
 	// The unique identifier of the deployment key.
@@ -344,6 +572,8 @@ type DeploymentKey struct {
 	Created time.Time `json:"created"`
 	// The date the deployment key expires.
 	Expires time.Time `json:"expires"`
+	// Whether or not the deployment key expires within the next 14 days.
+	ExpiresSoon bool `json:"expiresSoon"`
 }
 
 func (DeploymentKey) IsNode() {}
@@ -426,6 +656,16 @@ type Error struct {
 
 func (Error) IsDeploymentResponse() {}
 
+// Deployment key that expires soon.
+type ExpiringDeployKey struct {
+	// The team owning the deployment key.
+	Team Team `json:"team"`
+	// The date the deployment key was created.
+	Created time.Time `json:"created"`
+	// The date the deployment key expires.
+	Expires time.Time `json:"expires"`
+}
+
 type Expose struct {
 	AllowedIntegrations []string   `json:"allowedIntegrations"`
 	AtMaxAge            int        `json:"atMaxAge"`
@@ -668,6 +908,9 @@ type MonthlyCost struct {
 	Sum float64 `json:"sum"`
 	// A list of monthly cost entries.
 	Cost []CostEntry `json:"cost"`
+	// Forecast of the cost of the current month. Null if there is no recorded cost.
+	Forecast *CostForecast      `json:"forecast,omitempty"`
+	GQLVars  MonthlyCostGQLVars `json:"-"`
 }
 
 // Monthly cost filter input type.
@@ -681,24 +924,25 @@ type MonthlyCostFilter struct {
 }
 
 type NaisJob struct {
-	ID           scalar.Ident   `json:"id"`
-	AccessPolicy AccessPolicy   `json:"accessPolicy"`
-	DeployInfo   DeployInfo     `json:"deployInfo"`
-	Env          Env            `json:"env"`
-	Image        string         `json:"image"`
-	Runs         []Run          `json:"runs"`
-	Manifest     string         `json:"manifest"`
-	Name         string         `json:"name"`
-	Resources    Resources      `json:"resources"`
-	Schedule     string         `json:"schedule"`
-	Team         Team           `json:"team"`
-	Storage      []Storage      `json:"storage"`
-	Authz        []Authz        `json:"authz"`
-	Completions  int            `json:"completions"`
-	Parallelism  int            `json:"parallelism"`
-	Retries      int            `json:"retries"`
-	JobState     JobState       `json:"jobState"`
-	GQLVars      NaisJobGQLVars `json:"-"`
+	ID              scalar.Ident         `json:"id"`
+	AccessPolicy    AccessPolicy         `json:"accessPolicy"`
+	DeployInfo      DeployInfo           `json:"deployInfo"`
+	Env             Env                  `json:"env"`
+	Image           string               `json:"image"`
+	Runs            []Run                `json:"runs"`
+	Manifest        string               `json:"manifest"`
+	Name            string               `json:"name"`
+	Resources       Resources            `json:"resources"`
+	Schedule        string               `json:"schedule"`
+	Team            Team                 `json:"team"`
+	Storage         []Storage            `json:"storage"`
+	Authz           []Authz              `json:"authz"`
+	Completions     int                  `json:"completions"`
+	Parallelism     int                  `json:"parallelism"`
+	Retries         int                  `json:"retries"`
+	JobState        JobState             `json:"jobState"`
+	Vulnerabilities *VulnerabilitiesNode `json:"vulnerabilities,omitempty"`
+	GQLVars         NaisJobGQLVars       `json:"-"`
 }
 
 func (NaisJob) IsNode() {}
@@ -708,6 +952,8 @@ func (this NaisJob) GetID() scalar.Ident { return this.ID }
 
 func (NaisJob) IsSearchNode() {}
 
+func (NaisJob) IsWorkload() {}
+
 type NaisJobConnection struct {
 	TotalCount int           `json:"totalCount"`
 	PageInfo   PageInfo      `json:"pageInfo"`
@@ -807,10 +1053,83 @@ type PageInfo struct {
 	To        int            `json:"to"`
 }
 
+// A violation of a policy configured in DependencyTrack, for instance a component with a forbidden license.
+type PolicyViolation struct {
+	ID scalar.Ident `json:"id"`
+	// The name of the violated policy.
+	PolicyName string `json:"policyName"`
+	// The type of the violation.
+	Type PolicyViolationType `json:"type"`
+	// The state of the violated policy.
+	State PolicyViolationState `json:"state"`
+	// The component violating the policy.
+	Component VulnerableComponent `json:"component"`
+}
+
+func (PolicyViolation) IsNode() {}
+
+// The unique ID of an object.
+func (this PolicyViolation) GetID() scalar.Ident { return this.ID }
+
+type PolicyViolationConnection struct {
+	TotalCount int                   `json:"totalCount"`
+	PageInfo   PageInfo              `json:"pageInfo"`
+	Edges      []PolicyViolationEdge `json:"edges"`
+}
+
+func (PolicyViolationConnection) IsConnection() {}
+
+// The total count of items in the connection.
+func (this PolicyViolationConnection) GetTotalCount() int { return this.TotalCount }
+
+// Pagination information.
+func (this PolicyViolationConnection) GetPageInfo() PageInfo { return this.PageInfo }
+
+// A list of edges.
+func (this PolicyViolationConnection) GetEdges() []Edge {
+	if this.Edges == nil {
+		return nil
+	}
+	interfaceSlice := make([]Edge, 0, len(this.Edges))
+	for _, concrete := range this.Edges {
+		interfaceSlice = append(interfaceSlice, concrete)
+	}
+	return interfaceSlice
+}
+
+type PolicyViolationEdge struct {
+	Cursor scalar.Cursor   `json:"cursor"`
+	Node   PolicyViolation `json:"node"`
+}
+
+func (PolicyViolationEdge) IsEdge() {}
+
+// A cursor for use in pagination.
+func (this PolicyViolationEdge) GetCursor() scalar.Cursor { return this.Cursor }
+
+type PolicyViolationSummary struct {
+	Total       int `json:"total"`
+	License     int `json:"license"`
+	Security    int `json:"security"`
+	Operational int `json:"operational"`
+}
+
 type Port struct {
 	Port int `json:"port"`
 }
 
+// Reconciler status type.
+type ReconcilerStatus struct {
+	// The name of the reconciler, for instance 'github:team'.
+	Name string `json:"name"`
+	// The state of the reconciler for the team.
+	State ReconcilerState `json:"state"`
+	// The error message from the last failed synchronization, if any.
+	Error *string `json:"error,omitempty"`
+	// The time of the last failed synchronization, if any.
+	ErrorAt *time.Time `json:"errorAt,omitempty"`
+}
+
 type Redis struct {
 	Name   string `json:"name"`
 	Access string `json:"access"`
@@ -971,6 +1290,15 @@ type SearchFilter struct {
 	Type *SearchType `json:"type,omitempty"`
 }
 
+// The score of a finding by severity.
+type SeverityWeights struct {
+	Critical   int `json:"critical"`
+	High       int `json:"high"`
+	Medium     int `json:"medium"`
+	Low        int `json:"low"`
+	Unassigned int `json:"unassigned"`
+}
+
 type Sidecar struct {
 	AutoLogin            bool      `json:"autoLogin"`
 	AutoLoginIgnorePaths []string  `json:"autoLoginIgnorePaths"`
@@ -985,6 +1313,14 @@ type SlackAlertsChannel struct {
 	Env string `json:"env"`
 }
 
+// Slack alerts channel input.
+type SlackAlertsChannelInput struct {
+	// The environment for the Slack alerts channel.
+	Env string `json:"env"`
+	// The name of the Slack alerts channel. Omit to fall back to the main Slack channel of the team.
+	Name *string `json:"name,omitempty"`
+}
+
 type SQLInstance struct {
 	AutoBackupHour      int         `json:"autoBackupHour"`
 	CascadingDelete     bool        `json:"cascadingDelete"`
@@ -1030,6 +1366,10 @@ type Team struct {
 	// Slack alerts channels for the team.
 	SlackAlertsChannels []SlackAlertsChannel `json:"slackAlertsChannels"`
 	GcpProjects         []GcpProject         `json:"gcpProjects"`
+	// The status of the reconcilers that synchronize the team to external systems.
+	Reconcilers []ReconcilerStatus `json:"reconcilers"`
+	// Timestamp of the last successful synchronization of the team. Null if the team has never been synchronized.
+	LastSuccessfulSync *time.Time `json:"lastSuccessfulSync,omitempty"`
 	// The deployments of the team's applications.
 	Deployments DeploymentConnection `json:"deployments"`
 	// The deploy key of the team.
@@ -1038,9 +1378,20 @@ type Team struct {
 	ViewerIsMember bool `json:"viewerIsMember"`
 	// Whether or not the viewer is an administrator of the team.
 	ViewerIsAdmin bool `json:"viewerIsAdmin"`
-	// The vulnerabilities for the team's applications.
-	Vulnerabilities        VulnerabilitiesConnection `json:"vulnerabilities"`
-	VulnerabilitiesSummary VulnerabilitySummary      `json:"vulnerabilitiesSummary"`
+	// The vulnerabilities for the team's applications and naisjobs.
+	Vulnerabilities VulnerabilitiesConnection `json:"vulnerabilities"`
+	// Summary of the vulnerabilities for the team's applications and naisjobs.
+	VulnerabilitiesSummary VulnerabilitySummary `json:"vulnerabilitiesSummary"`
+	// Summary of the unsuppressed policy violations for the team's applications and naisjobs.
+	PolicyViolationsSummary PolicyViolationSummary `json:"policyViolationsSummary"`
+	// Daily vulnerability history of the team's applications.
+	VulnerabilityHistory VulnerabilityHistory `json:"vulnerabilityHistory"`
+	// DORA delivery metrics for the team's applications and jobs.
+	DeliveryMetrics DeliveryMetrics `json:"deliveryMetrics"`
+	// The monthly cost budget of the team. Null if the team has no budget.
+	Budget *TeamBudget `json:"budget,omitempty"`
+	// Cost anomalies of the team's applications seen in a date range, most recent first.
+	CostAnomalies []CostAnomaly `json:"costAnomalies"`
 }
 
 func (Team) IsSearchNode() {}
@@ -1050,6 +1401,25 @@ func (Team) IsNode() {}
 // The unique ID of an object.
 func (this Team) GetID() scalar.Ident { return this.ID }
 
+// Monthly cost budget of a team.
+type TeamBudget struct {
+	// The monthly budget in euros.
+	Amount float64 `json:"amount"`
+	// The first day of the month the spend is recorded for, the month of the last recorded cost.
+	Month scalar.Date `json:"month"`
+	// The cost recorded so far in the month in euros.
+	Spend float64 `json:"spend"`
+	// The share of the budget spent so far in the month, in percent.
+	PercentSpent float64 `json:"percentSpent"`
+	// Forecast of the cost of the month. Null if there is no recorded cost.
+	Forecast *CostForecast `json:"forecast,omitempty"`
+	// The email address of the user that last updated the budget.
+	UpdatedBy string `json:"updatedBy"`
+	// When the budget was last updated.
+	Updated time.Time         `json:"updated"`
+	GQLVars TeamBudgetGQLVars `json:"-"`
+}
+
 // Team connection type.
 type TeamConnection struct {
 	// The total count of available teams.
@@ -1080,6 +1450,39 @@ func (this TeamConnection) GetEdges() []Edge {
 	return interfaceSlice
 }
 
+// The month-over-month growth in cost of a team. The current period is from the first day of the month of the end date up
+// to and including the end date, and the previous period is the same days of the month before.
+type TeamCostGrowth struct {
+	// The name of the team.
+	Team string `json:"team"`
+	// The cost of the current period in euros.
+	CurrentCost float64 `json:"currentCost"`
+	// The cost of the previous period in euros.
+	PreviousCost float64 `json:"previousCost"`
+	// The increase in cost from the previous period in euros.
+	Growth float64 `json:"growth"`
+	// The increase in cost from the previous period, in percent. Null if there was no cost in the previous period.
+	GrowthPercent *float64 `json:"growthPercent,omitempty"`
+}
+
+// The cost of a team in a ranking.
+type TeamCostRanking struct {
+	// The name of the team.
+	Team string `json:"team"`
+	// The cost of the team in euros.
+	Sum float64 `json:"sum"`
+}
+
+// Team summary on the user dashboard.
+type TeamDashboard struct {
+	// The team.
+	Team Team `json:"team"`
+	// The number of failing applications.
+	FailingApps int `json:"failingApps"`
+	// The number of failing jobs.
+	FailingJobs int `json:"failingJobs"`
+}
+
 // Team edge type.
 type TeamEdge struct {
 	// A cursor for use in pagination.
@@ -1153,12 +1556,116 @@ func (TeamMemberEdge) IsEdge() {}
 // A cursor for use in pagination.
 func (this TeamMemberEdge) GetCursor() scalar.Cursor { return this.Cursor }
 
+// Team member input.
+type TeamMemberInput struct {
+	// The email address of the user to add to the team.
+	Email string `json:"email"`
+	// The role of the user in the team.
+	Role TeamRole `json:"role"`
+}
+
 // Team status.
 type TeamStatus struct {
 	Apps AppsStatus `json:"apps"`
 	Jobs JobsStatus `json:"jobs"`
 }
 
+// Team sync type.
+type TeamSync struct {
+	// The correlation ID of the synchronization, used to trace the synchronization in the teams-backend.
+	CorrelationID string `json:"correlationID"`
+}
+
+// Vulnerability summary of the apps of a team in an environment.
+type TeamVulnerabilities struct {
+	// The name of the team.
+	Team string `json:"team"`
+	// The name of the environment.
+	Env string `json:"env"`
+	// The number of apps.
+	Apps int `json:"apps"`
+	// The number of apps without a BOM in DependencyTrack. These apps are not included in the summary.
+	AppsWithoutBom int `json:"appsWithoutBom"`
+	// The vulnerability summary of the apps.
+	Summary VulnerabilitySummary `json:"summary"`
+	// When the summary was last refreshed.
+	Updated time.Time `json:"updated"`
+}
+
+type TeamVulnerabilitiesConnection struct {
+	TotalCount int                       `json:"totalCount"`
+	PageInfo   PageInfo                  `json:"pageInfo"`
+	Edges      []TeamVulnerabilitiesEdge `json:"edges"`
+}
+
+func (TeamVulnerabilitiesConnection) IsConnection() {}
+
+// The total count of items in the connection.
+func (this TeamVulnerabilitiesConnection) GetTotalCount() int { return this.TotalCount }
+
+// Pagination information.
+func (this TeamVulnerabilitiesConnection) GetPageInfo() PageInfo { return this.PageInfo }
+
+// A list of edges.
+func (this TeamVulnerabilitiesConnection) GetEdges() []Edge {
+	if this.Edges == nil {
+		return nil
+	}
+	interfaceSlice := make([]Edge, 0, len(this.Edges))
+	for _, concrete := range this.Edges {
+		interfaceSlice = append(interfaceSlice, concrete)
+	}
+	return interfaceSlice
+}
+
+type TeamVulnerabilitiesEdge struct {
+	Cursor scalar.Cursor       `json:"cursor"`
+	Node   TeamVulnerabilities `json:"node"`
+}
+
+func (TeamVulnerabilitiesEdge) IsEdge() {}
+
+// A cursor for use in pagination.
+func (this TeamVulnerabilitiesEdge) GetCursor() scalar.Cursor { return this.Cursor }
+
+// Teams filter input type.
+type TeamsFilter struct {
+	// Only include teams with a name containing the given string, case-insensitive.
+	Name *string `json:"name,omitempty"`
+	// Only include teams with, or without, failing applications.
+	HasFailingApps *bool `json:"hasFailingApps,omitempty"`
+	// Only include teams that the viewer is, or is not, a member of.
+	ViewerIsMember *bool `json:"viewerIsMember,omitempty"`
+	// Only include teams with, or without, a Slack channel.
+	HasSlackChannel *bool `json:"hasSlackChannel,omitempty"`
+}
+
+// The cost of all teams in the tenant in a date range.
+type TenantCost struct {
+	// The total cost of the tenant in euros.
+	Sum float64 `json:"sum"`
+	// The cost of each environment, highest cost first.
+	Envs []TenantEnvCost `json:"envs"`
+	// The teams with the highest cost, highest cost first.
+	TopTeams []TeamCostRanking `json:"topTeams"`
+	// The applications with the highest cost, highest cost first.
+	TopApps []AppCostRanking `json:"topApps"`
+	// The first day of the current period of the month-over-month growth, the first day of the month of the end date.
+	GrowthFrom scalar.Date `json:"growthFrom"`
+	// The teams with the highest month-over-month growth in cost, highest growth first.
+	FastestGrowingTeams []TeamCostGrowth `json:"fastestGrowingTeams"`
+	// The applications with the highest month-over-month growth in cost, highest growth first.
+	FastestGrowingApps []AppCostGrowth `json:"fastestGrowingApps"`
+}
+
+// The cost of an environment in the tenant.
+type TenantEnvCost struct {
+	// The name of the environment.
+	Env string `json:"env"`
+	// The cost of the environment in euros.
+	Sum float64 `json:"sum"`
+}
+
 type TokenX struct {
 	MountSecretsAsFilesOnly bool `json:"mountSecretsAsFilesOnly"`
 }
@@ -1170,6 +1677,16 @@ type Topic struct {
 	ACL  []ACL  `json:"acl"`
 }
 
+// Input for updating an existing team. Fields that are omitted will not be changed.
+type UpdateTeamInput struct {
+	// The purpose of the team.
+	Purpose *string `json:"purpose,omitempty"`
+	// The main Slack channel for the team.
+	SlackChannel *string `json:"slackChannel,omitempty"`
+	// Slack alerts channels for the team, one per environment.
+	SlackAlertsChannels []SlackAlertsChannelInput `json:"slackAlertsChannels,omitempty"`
+}
+
 type User struct {
 	// The unique identifier for the user.
 	ID scalar.Ident `json:"id"`
@@ -1179,6 +1696,10 @@ type User struct {
 	Email string `json:"email"`
 	// Teams that the user is a member and/or owner of.
 	Teams TeamConnection `json:"teams"`
+	// Applications and jobs owned by the teams that the user is a member of.
+	Workloads WorkloadConnection `json:"workloads"`
+	// Aggregated status of all teams that the user is a member of.
+	Dashboard UserDashboard `json:"dashboard"`
 }
 
 func (User) IsNode() {}
@@ -1186,6 +1707,23 @@ func (User) IsNode() {}
 // The unique ID of an object.
 func (this User) GetID() scalar.Ident { return this.ID }
 
+// User dashboard type.
+type UserDashboard struct {
+	// Failing applications across all teams.
+	FailingApps []App `json:"failingApps"`
+	// Failing jobs across all teams.
+	FailingJobs []NaisJob `json:"failingJobs"`
+	// The most recent deployments across all teams, newest first.
+	RecentDeployments []Deployment `json:"recentDeployments"`
+	// Applications with critical vulnerabilities across all teams, the most critical first.
+	CriticalVulnerabilities []VulnerabilitiesNode `json:"criticalVulnerabilities"`
+	// The cost of all teams for the current month, up until the last recorded date, in euros.
+	MonthToDateCost float64 `json:"monthToDateCost"`
+	// Per-team summaries, ordered by the number of failing workloads.
+	Teams   []TeamDashboard      `json:"teams"`
+	GQLVars UserDashboardGQLVars `json:"-"`
+}
+
 type Variable struct {
 	Name  string `json:"name"`
 	Value string `json:"value"`
@@ -1195,6 +1733,8 @@ type VulnerabilitiesConnection struct {
 	TotalCount int                   `json:"totalCount"`
 	PageInfo   PageInfo              `json:"pageInfo"`
 	Edges      []VulnerabilitiesEdge `json:"edges"`
+	// Apps and naisjobs where the vulnerabilities could not be fetched from DependencyTrack. Workloads with stale vulnerabilities are included in the edges as well.
+	Errors []VulnerabilitiesError `json:"errors"`
 }
 
 func (VulnerabilitiesConnection) IsConnection() {}
@@ -1227,9 +1767,36 @@ func (VulnerabilitiesEdge) IsEdge() {}
 // A cursor for use in pagination.
 func (this VulnerabilitiesEdge) GetCursor() scalar.Cursor { return this.Cursor }
 
+// An error fetching the vulnerabilities of an app or a naisjob.
+type VulnerabilitiesError struct {
+	// The name of the app or naisjob.
+	AppName string `json:"appName"`
+	// Whether the workload is an app or a naisjob.
+	WorkloadType WorkloadType `json:"workloadType"`
+	// The environment of the app.
+	Env string `json:"env"`
+	// A description of the error.
+	Message string `json:"message"`
+	// Whether or not the app is included in the edges with the last known vulnerabilities.
+	Stale bool `json:"stale"`
+}
+
+// Input for filtering vulnerability summaries.
+type VulnerabilitiesFilter struct {
+	// Only include the team with the given name.
+	Team *string `json:"team,omitempty"`
+	// Only include the environment with the given name.
+	Env *string `json:"env,omitempty"`
+	// Only include teams and environments with at least one vulnerability of the given severity.
+	Severity *VulnerabilitySeverity `json:"severity,omitempty"`
+}
+
 type VulnerabilitiesNode struct {
-	ID           scalar.Ident          `json:"id"`
-	AppName      string                `json:"appName"`
+	ID scalar.Ident `json:"id"`
+	// The name of the app or naisjob.
+	AppName string `json:"appName"`
+	// Whether the workload is an app or a naisjob.
+	WorkloadType WorkloadType          `json:"workloadType"`
 	Env          string                `json:"env"`
 	FindingsLink string                `json:"findingsLink"`
 	Summary      *VulnerabilitySummary `json:"summary,omitempty"`
@@ -1241,6 +1808,98 @@ func (VulnerabilitiesNode) IsNode() {}
 // The unique ID of an object.
 func (this VulnerabilitiesNode) GetID() scalar.Ident { return this.ID }
 
+// A vulnerability found in a component of an app.
+type VulnerabilityFinding struct {
+	ID scalar.Ident `json:"id"`
+	// The identifier of the vulnerability, for instance a CVE or GHSA id.
+	VulnerabilityID string `json:"vulnerabilityId"`
+	// The source of the vulnerability, for instance NVD or GITHUB.
+	Source string `json:"source"`
+	// The severity of the vulnerability.
+	Severity VulnerabilitySeverity `json:"severity"`
+	// The CVSS v3 base score of the vulnerability, if known.
+	CvssScore *float64 `json:"cvssScore,omitempty"`
+	// The vulnerable component.
+	Component VulnerableComponent `json:"component"`
+	// The versions of the component where the vulnerability is fixed, if known.
+	FixedVersion *string `json:"fixedVersion,omitempty"`
+	// The analysis state of the finding, for instance NOT_SET, FALSE_POSITIVE or NOT_AFFECTED.
+	AnalysisState string `json:"analysisState"`
+	// Whether or not the finding has been suppressed.
+	Suppressed bool `json:"suppressed"`
+}
+
+func (VulnerabilityFinding) IsNode() {}
+
+// The unique ID of an object.
+func (this VulnerabilityFinding) GetID() scalar.Ident { return this.ID }
+
+type VulnerabilityFindingConnection struct {
+	TotalCount int                        `json:"totalCount"`
+	PageInfo   PageInfo                   `json:"pageInfo"`
+	Edges      []VulnerabilityFindingEdge `json:"edges"`
+}
+
+func (VulnerabilityFindingConnection) IsConnection() {}
+
+// The total count of items in the connection.
+func (this VulnerabilityFindingConnection) GetTotalCount() int { return this.TotalCount }
+
+// Pagination information.
+func (this VulnerabilityFindingConnection) GetPageInfo() PageInfo { return this.PageInfo }
+
+// A list of edges.
+func (this VulnerabilityFindingConnection) GetEdges() []Edge {
+	if this.Edges == nil {
+		return nil
+	}
+	interfaceSlice := make([]Edge, 0, len(this.Edges))
+	for _, concrete := range this.Edges {
+		interfaceSlice = append(interfaceSlice, concrete)
+	}
+	return interfaceSlice
+}
+
+type VulnerabilityFindingEdge struct {
+	Cursor scalar.Cursor        `json:"cursor"`
+	Node   VulnerabilityFinding `json:"node"`
+}
+
+func (VulnerabilityFindingEdge) IsEdge() {}
+
+// A cursor for use in pagination.
+func (this VulnerabilityFindingEdge) GetCursor() scalar.Cursor { return this.Cursor }
+
+// Vulnerability history of an app or the apps of a team.
+type VulnerabilityHistory struct {
+	// Daily vulnerability summaries, oldest first. Days without a snapshot are not included.
+	Series []VulnerabilityHistoryEntry `json:"series"`
+	// The number of critical findings that disappeared in the period.
+	RemediatedCritical int `json:"remediatedCritical"`
+	// The mean time in seconds from a critical finding appeared until it disappeared or was suppressed, for findings that disappeared in the period. Null if no critical findings disappeared.
+	MeanTimeToRemediateCritical *float64 `json:"meanTimeToRemediateCritical,omitempty"`
+}
+
+// Vulnerability summary of a single day.
+type VulnerabilityHistoryEntry struct {
+	// The date of the snapshot.
+	Date scalar.Date `json:"date"`
+	// The vulnerability summary at the time of the snapshot.
+	Summary VulnerabilitySummary `json:"summary"`
+}
+
+// The model used to compute risk scores. The risk score of an app is the sum of the scores of its findings, rounded to the nearest integer. Findings left out by the model are neither scored nor counted in vulnerability summaries. Suppressed findings are always left out.
+type VulnerabilityRiskModel struct {
+	// How each finding is scored.
+	Mode RiskScoreMode `json:"mode"`
+	// The score of a finding by severity, used by the SEVERITY mode, and by the other modes for findings without a CVSS score.
+	Weights SeverityWeights `json:"weights"`
+	// Findings first seen by DependencyTrack more than this many hours ago are left out. 0 means findings of any age are included.
+	GracePeriodHours int `json:"gracePeriodHours"`
+	// Whether or not findings without a known fixed version are left out.
+	ExcludeUnfixed bool `json:"excludeUnfixed"`
+}
+
 type VulnerabilitySummary struct {
 	Total      int `json:"total"`
 	RiskScore  int `json:"riskScore"`
@@ -1251,15 +1910,184 @@ type VulnerabilitySummary struct {
 	Unassigned int `json:"unassigned"`
 }
 
-type ErrorLevel string
-
-const (
-	ErrorLevelInfo    ErrorLevel = "INFO"
-	ErrorLevelWarning ErrorLevel = "WARNING"
-	ErrorLevelError   ErrorLevel = "ERROR"
-)
+// A component containing a vulnerability or violating a policy.
+type VulnerableComponent struct {
+	// The name of the component, including the group if any.
+	Name string `json:"name"`
+	// The version of the component.
+	Version string `json:"version"`
+	// The package URL of the component.
+	Purl string `json:"purl"`
+}
 
-var AllErrorLevel = []ErrorLevel{
+// Workload connection type.
+type WorkloadConnection struct {
+	// The total count of available workloads.
+	TotalCount int `json:"totalCount"`
+	// Pagination information.
+	PageInfo PageInfo `json:"pageInfo"`
+	// A list of workload edges.
+	Edges []WorkloadEdge `json:"edges"`
+}
+
+func (WorkloadConnection) IsConnection() {}
+
+// The total count of items in the connection.
+func (this WorkloadConnection) GetTotalCount() int { return this.TotalCount }
+
+// Pagination information.
+func (this WorkloadConnection) GetPageInfo() PageInfo { return this.PageInfo }
+
+// A list of edges.
+func (this WorkloadConnection) GetEdges() []Edge {
+	if this.Edges == nil {
+		return nil
+	}
+	interfaceSlice := make([]Edge, 0, len(this.Edges))
+	for _, concrete := range this.Edges {
+		interfaceSlice = append(interfaceSlice, concrete)
+	}
+	return interfaceSlice
+}
+
+// Delivery metrics for a single application or job.
+type WorkloadDeliveryMetrics struct {
+	// The name of the application or job.
+	Name string `json:"name"`
+	// The environment of the application or job.
+	Env string `json:"env"`
+	// The metrics for the application or job.
+	Metrics DeliveryMetricsSummary `json:"metrics"`
+}
+
+// Workload edge type.
+type WorkloadEdge struct {
+	// A cursor for use in pagination.
+	Cursor scalar.Cursor `json:"cursor"`
+	// The workload at the end of the edge.
+	Node Workload `json:"node"`
+}
+
+func (WorkloadEdge) IsEdge() {}
+
+// A cursor for use in pagination.
+func (this WorkloadEdge) GetCursor() scalar.Cursor { return this.Cursor }
+
+// Workload filter input type.
+type WorkloadFilter struct {
+	// Only include workloads owned by the given teams.
+	Teams []string `json:"teams,omitempty"`
+	// Only include workloads running in the given environments.
+	Envs []string `json:"envs,omitempty"`
+	// Only include workloads with the given state.
+	State *State `json:"state,omitempty"`
+	// Only include workloads of the given type.
+	Type *WorkloadType `json:"type,omitempty"`
+}
+
+// The level of a cost breakdown.
+type CostBreakdownGroup string
+
+const (
+	// Group by the type of cost.
+	CostBreakdownGroupCostType CostBreakdownGroup = "COST_TYPE"
+	// Group by environment.
+	CostBreakdownGroupEnv CostBreakdownGroup = "ENV"
+	// Group by application.
+	CostBreakdownGroupApp CostBreakdownGroup = "APP"
+)
+
+var AllCostBreakdownGroup = []CostBreakdownGroup{
+	CostBreakdownGroupCostType,
+	CostBreakdownGroupEnv,
+	CostBreakdownGroupApp,
+}
+
+func (e CostBreakdownGroup) IsValid() bool {
+	switch e {
+	case CostBreakdownGroupCostType, CostBreakdownGroupEnv, CostBreakdownGroupApp:
+		return true
+	}
+	return false
+}
+
+func (e CostBreakdownGroup) String() string {
+	return string(e)
+}
+
+func (e *CostBreakdownGroup) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CostBreakdownGroup(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CostBreakdownGroup", str)
+	}
+	return nil
+}
+
+func (e CostBreakdownGroup) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Deployment states, based on the most recent status of a deployment.
+type DeploymentState string
+
+const (
+	// The deployment succeeded.
+	DeploymentStateSuccess DeploymentState = "SUCCESS"
+	// The deployment failed.
+	DeploymentStateFailure DeploymentState = "FAILURE"
+	// The deployment has not finished yet.
+	DeploymentStateInProgress DeploymentState = "IN_PROGRESS"
+)
+
+var AllDeploymentState = []DeploymentState{
+	DeploymentStateSuccess,
+	DeploymentStateFailure,
+	DeploymentStateInProgress,
+}
+
+func (e DeploymentState) IsValid() bool {
+	switch e {
+	case DeploymentStateSuccess, DeploymentStateFailure, DeploymentStateInProgress:
+		return true
+	}
+	return false
+}
+
+func (e DeploymentState) String() string {
+	return string(e)
+}
+
+func (e *DeploymentState) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DeploymentState(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DeploymentState", str)
+	}
+	return nil
+}
+
+func (e DeploymentState) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ErrorLevel string
+
+const (
+	ErrorLevelInfo    ErrorLevel = "INFO"
+	ErrorLevelWarning ErrorLevel = "WARNING"
+	ErrorLevelError   ErrorLevel = "ERROR"
+)
+
+var AllErrorLevel = []ErrorLevel{
 	ErrorLevelInfo,
 	ErrorLevelWarning,
 	ErrorLevelError,
@@ -1294,6 +2122,114 @@ func (e ErrorLevel) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// Justification of the analysis state of a vulnerability finding.
+type FindingAnalysisJustification string
+
+const (
+	FindingAnalysisJustificationNotSet                       FindingAnalysisJustification = "NOT_SET"
+	FindingAnalysisJustificationCodeNotPresent               FindingAnalysisJustification = "CODE_NOT_PRESENT"
+	FindingAnalysisJustificationCodeNotReachable             FindingAnalysisJustification = "CODE_NOT_REACHABLE"
+	FindingAnalysisJustificationRequiresConfiguration        FindingAnalysisJustification = "REQUIRES_CONFIGURATION"
+	FindingAnalysisJustificationRequiresDependency           FindingAnalysisJustification = "REQUIRES_DEPENDENCY"
+	FindingAnalysisJustificationRequiresEnvironment          FindingAnalysisJustification = "REQUIRES_ENVIRONMENT"
+	FindingAnalysisJustificationProtectedByCompiler          FindingAnalysisJustification = "PROTECTED_BY_COMPILER"
+	FindingAnalysisJustificationProtectedAtRuntime           FindingAnalysisJustification = "PROTECTED_AT_RUNTIME"
+	FindingAnalysisJustificationProtectedAtPerimeter         FindingAnalysisJustification = "PROTECTED_AT_PERIMETER"
+	FindingAnalysisJustificationProtectedByMitigatingControl FindingAnalysisJustification = "PROTECTED_BY_MITIGATING_CONTROL"
+)
+
+var AllFindingAnalysisJustification = []FindingAnalysisJustification{
+	FindingAnalysisJustificationNotSet,
+	FindingAnalysisJustificationCodeNotPresent,
+	FindingAnalysisJustificationCodeNotReachable,
+	FindingAnalysisJustificationRequiresConfiguration,
+	FindingAnalysisJustificationRequiresDependency,
+	FindingAnalysisJustificationRequiresEnvironment,
+	FindingAnalysisJustificationProtectedByCompiler,
+	FindingAnalysisJustificationProtectedAtRuntime,
+	FindingAnalysisJustificationProtectedAtPerimeter,
+	FindingAnalysisJustificationProtectedByMitigatingControl,
+}
+
+func (e FindingAnalysisJustification) IsValid() bool {
+	switch e {
+	case FindingAnalysisJustificationNotSet, FindingAnalysisJustificationCodeNotPresent, FindingAnalysisJustificationCodeNotReachable, FindingAnalysisJustificationRequiresConfiguration, FindingAnalysisJustificationRequiresDependency, FindingAnalysisJustificationRequiresEnvironment, FindingAnalysisJustificationProtectedByCompiler, FindingAnalysisJustificationProtectedAtRuntime, FindingAnalysisJustificationProtectedAtPerimeter, FindingAnalysisJustificationProtectedByMitigatingControl:
+		return true
+	}
+	return false
+}
+
+func (e FindingAnalysisJustification) String() string {
+	return string(e)
+}
+
+func (e *FindingAnalysisJustification) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FindingAnalysisJustification(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FindingAnalysisJustification", str)
+	}
+	return nil
+}
+
+func (e FindingAnalysisJustification) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Analysis state of a vulnerability finding.
+type FindingAnalysisState string
+
+const (
+	FindingAnalysisStateNotSet        FindingAnalysisState = "NOT_SET"
+	FindingAnalysisStateInTriage      FindingAnalysisState = "IN_TRIAGE"
+	FindingAnalysisStateExploitable   FindingAnalysisState = "EXPLOITABLE"
+	FindingAnalysisStateFalsePositive FindingAnalysisState = "FALSE_POSITIVE"
+	FindingAnalysisStateNotAffected   FindingAnalysisState = "NOT_AFFECTED"
+	FindingAnalysisStateResolved      FindingAnalysisState = "RESOLVED"
+)
+
+var AllFindingAnalysisState = []FindingAnalysisState{
+	FindingAnalysisStateNotSet,
+	FindingAnalysisStateInTriage,
+	FindingAnalysisStateExploitable,
+	FindingAnalysisStateFalsePositive,
+	FindingAnalysisStateNotAffected,
+	FindingAnalysisStateResolved,
+}
+
+func (e FindingAnalysisState) IsValid() bool {
+	switch e {
+	case FindingAnalysisStateNotSet, FindingAnalysisStateInTriage, FindingAnalysisStateExploitable, FindingAnalysisStateFalsePositive, FindingAnalysisStateNotAffected, FindingAnalysisStateResolved:
+		return true
+	}
+	return false
+}
+
+func (e FindingAnalysisState) String() string {
+	return string(e)
+}
+
+func (e *FindingAnalysisState) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FindingAnalysisState(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FindingAnalysisState", str)
+	}
+	return nil
+}
+
+func (e FindingAnalysisState) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type InstanceState string
 
 const (
@@ -1364,8 +2300,16 @@ const (
 	OrderByFieldSeverityLow OrderByField = "SEVERITY_LOW"
 	// Order apps by vulnerability severity unassigned
 	OrderByFieldSeverityUnassigned OrderByField = "SEVERITY_UNASSIGNED"
+	// Order findings by severity
+	OrderByFieldSeverity OrderByField = "SEVERITY"
+	// Order findings by CVSS score
+	OrderByFieldCvssScore OrderByField = "CVSS_SCORE"
 	// Order by authorizations
 	OrderByFieldRole OrderByField = "ROLE"
+	// Order teams by the number of applications
+	OrderByFieldAppCount OrderByField = "APP_COUNT"
+	// Order teams by the cost for the current month
+	OrderByFieldMonthlyCost OrderByField = "MONTHLY_COST"
 )
 
 var AllOrderByField = []OrderByField{
@@ -1381,12 +2325,16 @@ var AllOrderByField = []OrderByField{
 	OrderByFieldSeverityMedium,
 	OrderByFieldSeverityLow,
 	OrderByFieldSeverityUnassigned,
+	OrderByFieldSeverity,
+	OrderByFieldCvssScore,
 	OrderByFieldRole,
+	OrderByFieldAppCount,
+	OrderByFieldMonthlyCost,
 }
 
 func (e OrderByField) IsValid() bool {
 	switch e {
-	case OrderByFieldName, OrderByFieldEnv, OrderByFieldDeployed, OrderByFieldStatus, OrderByFieldAppName, OrderByFieldEnvName, OrderByFieldRiskScore, OrderByFieldSeverityCritical, OrderByFieldSeverityHigh, OrderByFieldSeverityMedium, OrderByFieldSeverityLow, OrderByFieldSeverityUnassigned, OrderByFieldRole:
+	case OrderByFieldName, OrderByFieldEnv, OrderByFieldDeployed, OrderByFieldStatus, OrderByFieldAppName, OrderByFieldEnvName, OrderByFieldRiskScore, OrderByFieldSeverityCritical, OrderByFieldSeverityHigh, OrderByFieldSeverityMedium, OrderByFieldSeverityLow, OrderByFieldSeverityUnassigned, OrderByFieldSeverity, OrderByFieldCvssScore, OrderByFieldRole, OrderByFieldAppCount, OrderByFieldMonthlyCost:
 		return true
 	}
 	return false
@@ -1413,6 +2361,138 @@ func (e OrderByField) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// State of a violated policy, from informational to failing.
+type PolicyViolationState string
+
+const (
+	PolicyViolationStateInfo PolicyViolationState = "INFO"
+	PolicyViolationStateWarn PolicyViolationState = "WARN"
+	PolicyViolationStateFail PolicyViolationState = "FAIL"
+)
+
+var AllPolicyViolationState = []PolicyViolationState{
+	PolicyViolationStateInfo,
+	PolicyViolationStateWarn,
+	PolicyViolationStateFail,
+}
+
+func (e PolicyViolationState) IsValid() bool {
+	switch e {
+	case PolicyViolationStateInfo, PolicyViolationStateWarn, PolicyViolationStateFail:
+		return true
+	}
+	return false
+}
+
+func (e PolicyViolationState) String() string {
+	return string(e)
+}
+
+func (e *PolicyViolationState) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PolicyViolationState(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PolicyViolationState", str)
+	}
+	return nil
+}
+
+func (e PolicyViolationState) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Type of a policy violation.
+type PolicyViolationType string
+
+const (
+	PolicyViolationTypeLicense     PolicyViolationType = "LICENSE"
+	PolicyViolationTypeSecurity    PolicyViolationType = "SECURITY"
+	PolicyViolationTypeOperational PolicyViolationType = "OPERATIONAL"
+)
+
+var AllPolicyViolationType = []PolicyViolationType{
+	PolicyViolationTypeLicense,
+	PolicyViolationTypeSecurity,
+	PolicyViolationTypeOperational,
+}
+
+func (e PolicyViolationType) IsValid() bool {
+	switch e {
+	case PolicyViolationTypeLicense, PolicyViolationTypeSecurity, PolicyViolationTypeOperational:
+		return true
+	}
+	return false
+}
+
+func (e PolicyViolationType) String() string {
+	return string(e)
+}
+
+func (e *PolicyViolationType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PolicyViolationType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PolicyViolationType", str)
+	}
+	return nil
+}
+
+func (e PolicyViolationType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Reconciler states.
+type ReconcilerState string
+
+const (
+	// The last synchronization of the team succeeded.
+	ReconcilerStateOk ReconcilerState = "OK"
+	// The last synchronization of the team failed.
+	ReconcilerStateFailing ReconcilerState = "FAILING"
+)
+
+var AllReconcilerState = []ReconcilerState{
+	ReconcilerStateOk,
+	ReconcilerStateFailing,
+}
+
+func (e ReconcilerState) IsValid() bool {
+	switch e {
+	case ReconcilerStateOk, ReconcilerStateFailing:
+		return true
+	}
+	return false
+}
+
+func (e ReconcilerState) String() string {
+	return string(e)
+}
+
+func (e *ReconcilerState) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ReconcilerState(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ReconcilerState", str)
+	}
+	return nil
+}
+
+func (e ReconcilerState) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // Repo authorizations.
 type RepositoryAuthorization string
 
@@ -1496,6 +2576,53 @@ func (e ResourceType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// How findings are scored.
+type RiskScoreMode string
+
+const (
+	// A finding is scored by the weight of its severity, like in DependencyTrack.
+	RiskScoreModeSeverity RiskScoreMode = "SEVERITY"
+	// A finding is scored by its CVSS v3 base score.
+	RiskScoreModeCvss RiskScoreMode = "CVSS"
+	// A finding is scored by its CVSS v3 base score, multiplied by its EPSS probability of exploitation. Findings without an EPSS score are scored as if certain to be exploited.
+	RiskScoreModeEpss RiskScoreMode = "EPSS"
+)
+
+var AllRiskScoreMode = []RiskScoreMode{
+	RiskScoreModeSeverity,
+	RiskScoreModeCvss,
+	RiskScoreModeEpss,
+}
+
+func (e RiskScoreMode) IsValid() bool {
+	switch e {
+	case RiskScoreModeSeverity, RiskScoreModeCvss, RiskScoreModeEpss:
+		return true
+	}
+	return false
+}
+
+func (e RiskScoreMode) String() string {
+	return string(e)
+}
+
+func (e *RiskScoreMode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RiskScoreMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RiskScoreMode", str)
+	}
+	return nil
+}
+
+func (e RiskScoreMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type SearchType string
 
 const (
@@ -1670,3 +2797,95 @@ func (e *TeamRole) UnmarshalGQL(v interface{}) error {
 func (e TeamRole) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+// Severity of a vulnerability.
+type VulnerabilitySeverity string
+
+const (
+	VulnerabilitySeverityCritical   VulnerabilitySeverity = "CRITICAL"
+	VulnerabilitySeverityHigh       VulnerabilitySeverity = "HIGH"
+	VulnerabilitySeverityMedium     VulnerabilitySeverity = "MEDIUM"
+	VulnerabilitySeverityLow        VulnerabilitySeverity = "LOW"
+	VulnerabilitySeverityUnassigned VulnerabilitySeverity = "UNASSIGNED"
+)
+
+var AllVulnerabilitySeverity = []VulnerabilitySeverity{
+	VulnerabilitySeverityCritical,
+	VulnerabilitySeverityHigh,
+	VulnerabilitySeverityMedium,
+	VulnerabilitySeverityLow,
+	VulnerabilitySeverityUnassigned,
+}
+
+func (e VulnerabilitySeverity) IsValid() bool {
+	switch e {
+	case VulnerabilitySeverityCritical, VulnerabilitySeverityHigh, VulnerabilitySeverityMedium, VulnerabilitySeverityLow, VulnerabilitySeverityUnassigned:
+		return true
+	}
+	return false
+}
+
+func (e VulnerabilitySeverity) String() string {
+	return string(e)
+}
+
+func (e *VulnerabilitySeverity) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = VulnerabilitySeverity(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid VulnerabilitySeverity", str)
+	}
+	return nil
+}
+
+func (e VulnerabilitySeverity) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Workload types.
+type WorkloadType string
+
+const (
+	// NAIS applications.
+	WorkloadTypeApp WorkloadType = "APP"
+	// NAIS jobs.
+	WorkloadTypeNaisjob WorkloadType = "NAISJOB"
+)
+
+var AllWorkloadType = []WorkloadType{
+	WorkloadTypeApp,
+	WorkloadTypeNaisjob,
+}
+
+func (e WorkloadType) IsValid() bool {
+	switch e {
+	case WorkloadTypeApp, WorkloadTypeNaisjob:
+		return true
+	}
+	return false
+}
+
+func (e WorkloadType) String() string {
+	return string(e)
+}
+
+func (e *WorkloadType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WorkloadType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WorkloadType", str)
+	}
+	return nil
+}
+
+func (e WorkloadType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -74,3 +74,78 @@ func summaryIsNil(a *model.VulnerabilitiesNode, b *model.VulnerabilitiesNode, di
 	}
 	return isNil, returnValue
 }
+
+// severityRank is used to order findings by severity, where unassigned is the least severe
+var severityRank = map[model.VulnerabilitySeverity]int{
+	model.VulnerabilitySeverityUnassigned: 0,
+	model.VulnerabilitySeverityLow:        1,
+	model.VulnerabilitySeverityMedium:     2,
+	model.VulnerabilitySeverityHigh:       3,
+	model.VulnerabilitySeverityCritical:   4,
+}
+
+// SortFindings sorts the findings in place. Findings without a CVSS score are sorted as if the score was 0.
+func SortFindings(f []*model.VulnerabilityFinding, field model.OrderByField, direction model.SortOrder) {
+	switch field {
+	case model.OrderByFieldName:
+		model.SortWith(f, func(a, b *model.VulnerabilityFinding) bool {
+			return model.Compare(a.Component.Name, b.Component.Name, direction)
+		})
+	case model.OrderByFieldSeverity:
+		model.SortWith(f, func(a, b *model.VulnerabilityFinding) bool {
+			if severityRank[a.Severity] == severityRank[b.Severity] {
+				return model.Compare(cvssScore(a), cvssScore(b), direction)
+			}
+			return model.Compare(severityRank[a.Severity], severityRank[b.Severity], direction)
+		})
+	case model.OrderByFieldCvssScore:
+		model.SortWith(f, func(a, b *model.VulnerabilityFinding) bool {
+			return model.Compare(cvssScore(a), cvssScore(b), direction)
+		})
+	}
+}
+
+func cvssScore(f *model.VulnerabilityFinding) float64 {
+	if f.CvssScore == nil {
+		return 0
+	}
+	return *f.CvssScore
+}
+
+// SortTeamVulnerabilities sorts the team vulnerability summaries in place
+func SortTeamVulnerabilities(v []model.TeamVulnerabilities, field model.OrderByField, direction model.SortOrder) {
+	switch field {
+	case model.OrderByFieldName:
+		model.SortWith(v, func(a, b model.TeamVulnerabilities) bool {
+			return model.Compare(a.Team, b.Team, direction)
+		})
+	case model.OrderByFieldEnv, model.OrderByFieldEnvName:
+		model.SortWith(v, func(a, b model.TeamVulnerabilities) bool {
+			return model.Compare(a.Env, b.Env, direction)
+		})
+	case model.OrderByFieldRiskScore:
+		model.SortWith(v, func(a, b model.TeamVulnerabilities) bool {
+			return model.Compare(a.Summary.RiskScore, b.Summary.RiskScore, direction)
+		})
+	case model.OrderByFieldSeverityCritical:
+		model.SortWith(v, func(a, b model.TeamVulnerabilities) bool {
+			return model.Compare(a.Summary.Critical, b.Summary.Critical, direction)
+		})
+	case model.OrderByFieldSeverityHigh:
+		model.SortWith(v, func(a, b model.TeamVulnerabilities) bool {
+			return model.Compare(a.Summary.High, b.Summary.High, direction)
+		})
+	case model.OrderByFieldSeverityMedium:
+		model.SortWith(v, func(a, b model.TeamVulnerabilities) bool {
+			return model.Compare(a.Summary.Medium, b.Summary.Medium, direction)
+		})
+	case model.OrderByFieldSeverityLow:
+		model.SortWith(v, func(a, b model.TeamVulnerabilities) bool {
+			return model.Compare(a.Summary.Low, b.Summary.Low, direction)
+		})
+	case model.OrderByFieldSeverityUnassigned:
+		model.SortWith(v, func(a, b model.TeamVulnerabilities) bool {
+			return model.Compare(a.Summary.Unassigned, b.Summary.Unassigned, direction)
+		})
+	}
+}
@@ -7,6 +7,7 @@ package graph
 import (
 	"context"
 
+	"github.com/nais/console-backend/internal/dependencytrack"
 	"github.com/nais/console-backend/internal/graph/model"
 )
 
@@ -33,6 +34,11 @@ func (r *naisJobResolver) Team(ctx context.Context, obj *model.NaisJob) (*model.
 	return r.teamsClient.GetTeam(ctx, obj.GQLVars.Team)
 }
 
+// Vulnerabilities is the resolver for the vulnerabilities field.
+func (r *naisJobResolver) Vulnerabilities(ctx context.Context, obj *model.NaisJob) (*model.VulnerabilitiesNode, error) {
+	return r.dependencyTrackClient.VulnerabilitySummary(ctx, &dependencytrack.AppInstance{Env: obj.Env.Name, Team: obj.GQLVars.Team, App: obj.Name, Image: obj.Image, Kind: model.WorkloadTypeNaisjob})
+}
+
 // Naisjob is the resolver for the naisjob field.
 func (r *queryResolver) Naisjob(ctx context.Context, name string, team string, env string) (*model.NaisJob, error) {
 	return r.k8sClient.NaisJob(ctx, name, team, env)
@@ -14,17 +14,19 @@ import (
 type IdentType string
 
 const (
-	IdentTypeApp                IdentType = "app"
-	IdentTypeDeployKey          IdentType = "deployKey"
-	IdentTypeDeployment         IdentType = "deployment"
-	IdentTypeDeploymentResource IdentType = "deploymentResource"
-	IdentTypeDeploymentStatus   IdentType = "deploymentStatus"
-	IdentTypeEnv                IdentType = "env"
-	IdentTypeJob                IdentType = "job"
-	IdentTypePod                IdentType = "pod"
-	IdentTypeTeam               IdentType = "team"
-	IdentTypeUser               IdentType = "user"
-	IdentTypeVulnerabilities    IdentType = "vulnerabilities"
+	IdentTypeApp                  IdentType = "app"
+	IdentTypeDeployKey            IdentType = "deployKey"
+	IdentTypeDeployment           IdentType = "deployment"
+	IdentTypeDeploymentResource   IdentType = "deploymentResource"
+	IdentTypeDeploymentStatus     IdentType = "deploymentStatus"
+	IdentTypeEnv                  IdentType = "env"
+	IdentTypeJob                  IdentType = "job"
+	IdentTypePod                  IdentType = "pod"
+	IdentTypePolicyViolation      IdentType = "policyViolation"
+	IdentTypeTeam                 IdentType = "team"
+	IdentTypeUser                 IdentType = "user"
+	IdentTypeVulnerabilities      IdentType = "vulnerabilities"
+	IdentTypeVulnerabilityFinding IdentType = "vulnerabilityFinding"
 )
 
 type Ident struct {
@@ -85,6 +87,10 @@ func PodIdent(id types.UID) Ident {
 	return newIdent(string(id), IdentTypePod)
 }
 
+func PolicyViolationIdent(id string) Ident {
+	return newIdent(id, IdentTypePolicyViolation)
+}
+
 func TeamIdent(id string) Ident {
 	return newIdent(id, IdentTypeTeam)
 }
@@ -109,6 +115,10 @@ func VulnerabilitiesIdent(id string) Ident {
 	return newIdent(id, IdentTypeVulnerabilities)
 }
 
+func VulnerabilityFindingIdent(id string) Ident {
+	return newIdent(id, IdentTypeVulnerabilityFinding)
+}
+
 func newIdent(id string, t IdentType) Ident {
 	return Ident{
 		ID:   id,
@@ -2,43 +2,158 @@ package graph
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/nais/console-backend/internal/auth"
+	"github.com/nais/console-backend/internal/database/gensql"
 	"github.com/nais/console-backend/internal/graph/model"
 	"github.com/nais/console-backend/internal/graph/scalar"
 	t "github.com/nais/console-backend/internal/teams"
 )
 
-func teamEdges(teams []t.Team, p *model.Pagination) []model.TeamEdge {
+func teamEdges(teams []*model.Team, p *model.Pagination) []model.TeamEdge {
 	edges := make([]model.TeamEdge, 0)
 	start, end := p.ForSlice(len(teams))
 
 	for i, team := range teams[start:end] {
-		team := team
 		edges = append(edges, model.TeamEdge{
 			Cursor: scalar.Cursor{Offset: start + i},
-			Node: model.Team{
-				ID:           scalar.TeamIdent(team.Slug),
-				Name:         team.Slug,
-				Description:  team.Purpose,
-				SlackChannel: team.SlackChannel,
-				SlackAlertsChannels: func(t []t.SlackAlertsChannel) []model.SlackAlertsChannel {
-					ret := make([]model.SlackAlertsChannel, 0)
-					for _, v := range t {
-						ret = append(ret, model.SlackAlertsChannel{
-							Env:  v.Environment,
-							Name: v.ChannelName,
-						})
-					}
-					return ret
-				}(team.SlackAlertsChannels),
-			},
+			Node:   *team,
 		})
 	}
 
 	return edges
 }
 
+// filterTeams returns the teams matching the filter. The returned slice is always a copy, so it can be sorted without
+// affecting the teams cache.
+func (r *Resolver) filterTeams(ctx context.Context, teams []*model.Team, filter *model.TeamsFilter) ([]*model.Team, error) {
+	if filter == nil {
+		return slices.Clone(teams), nil
+	}
+
+	var memberOf []string
+	if filter.ViewerIsMember != nil {
+		email, err := auth.GetEmail(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("getting email from context: %w", err)
+		}
+
+		memberOf, err = r.userTeams(ctx, email, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ret := make([]*model.Team, 0)
+	for _, team := range teams {
+		if filter.Name != nil && !strings.Contains(strings.ToLower(team.Name), strings.ToLower(*filter.Name)) {
+			continue
+		}
+
+		if filter.HasSlackChannel != nil && *filter.HasSlackChannel != (team.SlackChannel != "") {
+			continue
+		}
+
+		if filter.ViewerIsMember != nil && *filter.ViewerIsMember != slices.Contains(memberOf, team.Name) {
+			continue
+		}
+
+		if filter.HasFailingApps != nil {
+			apps, err := r.k8sClient.Apps(ctx, team.Name)
+			if err != nil {
+				return nil, fmt.Errorf("getting apps from Kubernetes: %w", err)
+			}
+
+			hasFailingApps := slices.ContainsFunc(apps, func(app *model.App) bool {
+				return app.AppState.State == model.StateFailing
+			})
+			if *filter.HasFailingApps != hasFailingApps {
+				continue
+			}
+		}
+
+		ret = append(ret, team)
+	}
+
+	return ret, nil
+}
+
+// sortTeams sorts the teams in place. Teams are already in alphabetical order, which is kept for teams that are equal
+// according to orderBy.
+func (r *Resolver) sortTeams(ctx context.Context, teams []*model.Team, orderBy *model.OrderBy) error {
+	if orderBy == nil {
+		return nil
+	}
+
+	switch orderBy.Field {
+	case model.OrderByFieldName:
+		model.SortWith(teams, func(a, b *model.Team) bool {
+			return model.Compare(a.Name, b.Name, orderBy.Direction)
+		})
+	case model.OrderByFieldAppCount:
+		appCount := make(map[string]int)
+		for _, team := range teams {
+			apps, err := r.k8sClient.Apps(ctx, team.Name)
+			if err != nil {
+				return fmt.Errorf("getting apps from Kubernetes: %w", err)
+			}
+			appCount[team.Name] = len(apps)
+		}
+
+		model.SortWith(teams, func(a, b *model.Team) bool {
+			return model.Compare(appCount[a.Name], appCount[b.Name], orderBy.Direction)
+		})
+	case model.OrderByFieldMonthlyCost:
+		slugs := make([]string, 0, len(teams))
+		for _, team := range teams {
+			slugs = append(slugs, team.Name)
+		}
+
+		now := time.Now()
+		rows, err := r.querier.CostForTeams(ctx, gensql.CostForTeamsParams{
+			FromDate: pgtype.Date{Time: time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC), Valid: true},
+			ToDate:   pgtype.Date{Time: now, Valid: true},
+			Teams:    slugs,
+		})
+		if err != nil {
+			return fmt.Errorf("getting cost for teams: %w", err)
+		}
+
+		cost := make(map[string]float32)
+		for _, row := range rows {
+			if row.Team != nil {
+				cost[*row.Team] = row.DailyCost
+			}
+		}
+
+		model.SortWith(teams, func(a, b *model.Team) bool {
+			return model.Compare(cost[a.Name], cost[b.Name], orderBy.Direction)
+		})
+	case model.OrderByFieldSeverityCritical:
+		// the index holds the apps and naisjobs of all teams, so a single query covers every team
+		rows, err := r.querier.VulnerabilitySummaries(ctx, gensql.VulnerabilitySummariesParams{})
+		if err != nil {
+			return fmt.Errorf("getting vulnerability summaries: %w", err)
+		}
+
+		critical := make(map[string]int)
+		for _, row := range rows {
+			critical[row.Team] += int(row.Critical)
+		}
+
+		model.SortWith(teams, func(a, b *model.Team) bool {
+			return model.Compare(critical[a.Name], critical[b.Name], orderBy.Direction)
+		})
+	}
+
+	return nil
+}
+
 func naisJobEdges(naisjobs []*model.NaisJob, team string, p *model.Pagination) []model.NaisJobEdge {
 	edges := make([]model.NaisJobEdge, 0)
 	start, end := p.ForSlice(len(naisjobs))
@@ -152,3 +267,29 @@ func (r *Resolver) hasAccess(ctx context.Context, teamName string) bool {
 
 	return false
 }
+
+func (r *Resolver) isTeamOwner(ctx context.Context, teamName string) bool {
+	isAdmin, err := r.Team().ViewerIsAdmin(ctx, &model.Team{Name: teamName})
+	if err != nil {
+		r.log.Errorf("checking team ownership: %v", err)
+		return false
+	}
+	return isAdmin
+}
+
+// isTenantAdmin returns true if the viewer has the global admin role of the tenant
+func (r *Resolver) isTenantAdmin(ctx context.Context) bool {
+	email, err := auth.GetEmail(ctx)
+	if err != nil {
+		r.log.Errorf("getting email from context: %v", err)
+		return false
+	}
+
+	user, err := r.teamsClient.GetUser(ctx, email)
+	if err != nil {
+		r.log.Errorf("getting user from Teams: %v", err)
+		return false
+	}
+
+	return user.IsAdmin()
+}
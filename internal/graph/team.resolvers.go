@@ -6,11 +6,16 @@ package graph
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	pgx "github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/nais/console-backend/internal/auth"
-	"github.com/nais/console-backend/internal/dependencytrack"
+	"github.com/nais/console-backend/internal/database/gensql"
+	"github.com/nais/console-backend/internal/deploykeys"
 	"github.com/nais/console-backend/internal/graph/apierror"
 	"github.com/nais/console-backend/internal/graph/model"
 	"github.com/nais/console-backend/internal/graph/model/vulnerabilities"
@@ -29,11 +34,23 @@ func (r *mutationResolver) ChangeDeployKey(ctx context.Context, team string) (*m
 	if err != nil {
 		return nil, fmt.Errorf("changing deploy key in Hookd: %w", err)
 	}
+
+	// refresh the stored expiry, so that the rotated key is no longer reported as expiring
+	_, err = r.querier.DeployKeyUpsert(ctx, gensql.DeployKeyUpsertParams{
+		Team:    team,
+		Created: pgtype.Timestamptz{Time: deployKey.Created, Valid: true},
+		Expires: pgtype.Timestamptz{Time: deployKey.Expires, Valid: true},
+	})
+	if err != nil {
+		r.log.WithError(err).WithField("team", team).Errorf("unable to store expiry of rotated deploy key")
+	}
+
 	return &model.DeploymentKey{
-		ID:      scalar.DeployKeyIdent(team),
-		Key:     deployKey.Key,
-		Created: deployKey.Created,
-		Expires: deployKey.Expires,
+		ID:          scalar.DeployKeyIdent(team),
+		Key:         deployKey.Key,
+		Created:     deployKey.Created,
+		Expires:     deployKey.Expires,
+		ExpiresSoon: deploykeys.ExpiresSoon(deployKey.Expires),
 	}, nil
 }
 
@@ -53,13 +70,99 @@ func (r *mutationResolver) DeauthorizeRepository(ctx context.Context, authorizat
 	return r.teamsClient.DeauthorizeRepository(ctx, authorization, team, repository)
 }
 
+// CreateTeam is the resolver for the createTeam field.
+func (r *mutationResolver) CreateTeam(ctx context.Context, input model.CreateTeamInput) (*model.Team, error) {
+	email, err := auth.GetEmail(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting email from context: %w", err)
+	}
+
+	user, err := r.teamsClient.GetUser(ctx, email)
+	if err != nil {
+		return nil, fmt.Errorf("getting user from Teams: %w", err)
+	}
+
+	team, err := r.teamsClient.CreateTeam(ctx, input.Name, input.Purpose, input.SlackChannel, user.ID)
+	if errors.Is(err, teams.ErrOwnerNotAdded) {
+		return nil, apierror.Errorf("The team %q was created, but we were unable to add you as an owner of the team. Please contact the NAIS team to be added as an owner.", input.Name)
+	} else if err != nil {
+		return nil, apierror.Errorf("Unable to create team: %s", err)
+	}
+
+	return team, nil
+}
+
+// UpdateTeam is the resolver for the updateTeam field.
+func (r *mutationResolver) UpdateTeam(ctx context.Context, team string, input model.UpdateTeamInput) (*model.Team, error) {
+	if !r.isTeamOwner(ctx, team) {
+		return nil, fmt.Errorf("access denied")
+	}
+	return r.teamsClient.UpdateTeam(ctx, team, input)
+}
+
+// AddTeamMember is the resolver for the addTeamMember field.
+func (r *mutationResolver) AddTeamMember(ctx context.Context, team string, member model.TeamMemberInput) (*model.Team, error) {
+	if !r.isTeamOwner(ctx, team) {
+		return nil, fmt.Errorf("access denied")
+	}
+
+	user, err := r.teamsClient.GetUser(ctx, member.Email)
+	if err != nil {
+		return nil, apierror.Errorf("Unable to find user: %s", member.Email)
+	}
+	return r.teamsClient.AddTeamMember(ctx, team, user.ID, member.Role)
+}
+
+// RemoveTeamMember is the resolver for the removeTeamMember field.
+func (r *mutationResolver) RemoveTeamMember(ctx context.Context, team string, email string) (*model.Team, error) {
+	if !r.isTeamOwner(ctx, team) {
+		return nil, fmt.Errorf("access denied")
+	}
+
+	user, err := r.teamsClient.GetUser(ctx, email)
+	if err != nil {
+		return nil, apierror.Errorf("Unable to find user: %s", email)
+	}
+	return r.teamsClient.RemoveTeamMember(ctx, team, user.ID)
+}
+
+// SetTeamMemberRole is the resolver for the setTeamMemberRole field.
+func (r *mutationResolver) SetTeamMemberRole(ctx context.Context, team string, email string, role model.TeamRole) (*model.Team, error) {
+	if !r.isTeamOwner(ctx, team) {
+		return nil, fmt.Errorf("access denied")
+	}
+
+	user, err := r.teamsClient.GetUser(ctx, email)
+	if err != nil {
+		return nil, apierror.Errorf("Unable to find user: %s", email)
+	}
+	return r.teamsClient.SetTeamMemberRole(ctx, team, user.ID, role)
+}
+
+// SynchronizeTeam is the resolver for the synchronizeTeam field.
+func (r *mutationResolver) SynchronizeTeam(ctx context.Context, team string) (*model.TeamSync, error) {
+	if !r.hasAccess(ctx, team) {
+		return nil, fmt.Errorf("access denied")
+	}
+	return r.teamsClient.SynchronizeTeam(ctx, team)
+}
+
 // Teams is the resolver for the teams field.
-func (r *queryResolver) Teams(ctx context.Context, first *int, last *int, after *scalar.Cursor, before *scalar.Cursor) (*model.TeamConnection, error) {
-	teams, err := r.teamsClient.GetTeams(ctx)
+func (r *queryResolver) Teams(ctx context.Context, first *int, last *int, after *scalar.Cursor, before *scalar.Cursor, filter *model.TeamsFilter, orderBy *model.OrderBy) (*model.TeamConnection, error) {
+	teams, err := r.teamsClient.GetCachedTeams(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("getting teams from Teams: %w", err)
 	}
 
+	teams, err = r.filterTeams(ctx, teams, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.sortTeams(ctx, teams, orderBy); err != nil {
+		return nil, err
+	}
+
 	pagination, err := model.NewPagination(first, last, after, before)
 	if err != nil {
 		return nil, err
@@ -103,6 +206,40 @@ func (r *queryResolver) Team(ctx context.Context, name string) (*model.Team, err
 	return team, nil
 }
 
+// ExpiringDeployKeys is the resolver for the expiringDeployKeys field.
+func (r *queryResolver) ExpiringDeployKeys(ctx context.Context) ([]model.ExpiringDeployKey, error) {
+	rows, err := r.querier.ExpiringDeployKeys(ctx, pgtype.Timestamptz{Time: time.Now().Add(deploykeys.ExpiresSoonThreshold), Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("getting expiring deploy keys: %w", err)
+	}
+
+	allTeams, err := r.teamsClient.GetCachedTeams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting teams from Teams: %w", err)
+	}
+
+	cachedTeams := make(map[string]*model.Team)
+	for _, team := range allTeams {
+		cachedTeams[team.Name] = team
+	}
+
+	ret := make([]model.ExpiringDeployKey, 0)
+	for _, row := range rows {
+		team, exists := cachedTeams[row.Team]
+		if !exists {
+			continue
+		}
+
+		ret = append(ret, model.ExpiringDeployKey{
+			Team:    *team,
+			Created: row.Created.Time,
+			Expires: row.Expires.Time,
+		})
+	}
+
+	return ret, nil
+}
+
 // Status is the resolver for the status field.
 func (r *teamResolver) Status(ctx context.Context, obj *model.Team) (*model.TeamStatus, error) {
 	apps, err := r.k8sClient.Apps(ctx, obj.Name)
@@ -419,13 +556,18 @@ func (r *teamResolver) GcpProjects(ctx context.Context, obj *model.Team) ([]mode
 }
 
 // Deployments is the resolver for the deployments field.
-func (r *teamResolver) Deployments(ctx context.Context, obj *model.Team, first *int, last *int, after *scalar.Cursor, before *scalar.Cursor, limit *int) (*model.DeploymentConnection, error) {
+func (r *teamResolver) Deployments(ctx context.Context, obj *model.Team, first *int, last *int, after *scalar.Cursor, before *scalar.Cursor, limit *int, filter *model.DeploymentFilter) (*model.DeploymentConnection, error) {
 	if limit == nil {
 		limit = new(int)
 		*limit = 10
 	}
 
-	deploys, err := r.hookdClient.Deployments(ctx, hookd.WithTeam(obj.Name), hookd.WithLimit(*limit))
+	opts, err := deployRequestOptions(filter, *limit)
+	if err != nil {
+		return nil, err
+	}
+
+	deploys, err := r.hookdClient.Deployments(ctx, append(opts, hookd.WithTeam(obj.Name))...)
 	if err != nil {
 		return nil, fmt.Errorf("getting deploys from Hookd: %w", err)
 	}
@@ -475,10 +617,11 @@ func (r *teamResolver) DeployKey(ctx context.Context, obj *model.Team) (*model.D
 	}
 
 	return &model.DeploymentKey{
-		ID:      scalar.DeployKeyIdent(obj.Name),
-		Key:     key.Key,
-		Created: key.Created,
-		Expires: key.Expires,
+		ID:          scalar.DeployKeyIdent(obj.Name),
+		Key:         key.Key,
+		Created:     key.Created,
+		Expires:     key.Expires,
+		ExpiresSoon: deploykeys.ExpiresSoon(key.Expires),
 	}, nil
 }
 
@@ -529,25 +672,12 @@ func (r *teamResolver) ViewerIsAdmin(ctx context.Context, obj *model.Team) (bool
 
 // Vulnerabilities is the resolver for the vulnerabilities field.
 func (r *teamResolver) Vulnerabilities(ctx context.Context, obj *model.Team, first *int, last *int, after *scalar.Cursor, before *scalar.Cursor, orderBy *model.OrderBy) (*model.VulnerabilitiesConnection, error) {
-	apps, err := r.k8sClient.Apps(ctx, obj.Name)
+	instances, err := r.workloadInstances(ctx, obj.Name)
 	if err != nil {
-		return nil, fmt.Errorf("getting apps from Kubernetes: %w", err)
-	}
-
-	instances := make([]*dependencytrack.AppInstance, 0)
-	for _, app := range apps {
-		instances = append(instances, &dependencytrack.AppInstance{
-			Env:   app.Env.Name,
-			App:   app.Name,
-			Image: app.Image,
-			Team:  obj.Name,
-		})
+		return nil, err
 	}
 
-	nodes, err := r.dependencyTrackClient.GetVulnerabilities(ctx, instances)
-	if err != nil {
-		return nil, fmt.Errorf("getting vulnerabilities from DependencyTrack: %w", err)
-	}
+	nodes, vulnerabilityErrors := vulnerabilityNodes(r.dependencyTrackClient.FetchVulnerabilities(ctx, instances))
 
 	if orderBy != nil {
 		vulnerabilities.Sort(nodes, orderBy.Field, orderBy.Direction)
@@ -585,6 +715,7 @@ func (r *teamResolver) Vulnerabilities(ctx context.Context, obj *model.Team, fir
 	return &model.VulnerabilitiesConnection{
 		TotalCount: len(nodes),
 		Edges:      edges,
+		Errors:     vulnerabilityErrors,
 		PageInfo: model.PageInfo{
 			HasNextPage:     hasNext,
 			HasPreviousPage: hasPrevious,
@@ -596,19 +727,9 @@ func (r *teamResolver) Vulnerabilities(ctx context.Context, obj *model.Team, fir
 
 // VulnerabilitiesSummary is the resolver for the vulnerabilitiesSummary field.
 func (r *teamResolver) VulnerabilitiesSummary(ctx context.Context, obj *model.Team) (*model.VulnerabilitySummary, error) {
-	apps, err := r.k8sClient.Apps(ctx, obj.Name)
+	instances, err := r.workloadInstances(ctx, obj.Name)
 	if err != nil {
-		return nil, fmt.Errorf("getting apps from Kubernetes: %w", err)
-	}
-
-	instances := make([]*dependencytrack.AppInstance, 0)
-	for _, app := range apps {
-		instances = append(instances, &dependencytrack.AppInstance{
-			Env:   app.Env.Name,
-			App:   app.Name,
-			Image: app.Image,
-			Team:  obj.Name,
-		})
+		return nil, err
 	}
 
 	nodes, err := r.dependencyTrackClient.GetVulnerabilities(ctx, instances)
@@ -646,6 +767,127 @@ func (r *teamResolver) VulnerabilitiesSummary(ctx context.Context, obj *model.Te
 	return retVal, nil
 }
 
+// PolicyViolationsSummary is the resolver for the policyViolationsSummary field.
+func (r *teamResolver) PolicyViolationsSummary(ctx context.Context, obj *model.Team) (*model.PolicyViolationSummary, error) {
+	instances, err := r.workloadInstances(ctx, obj.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := r.dependencyTrackClient.PolicyViolationSummary(ctx, instances)
+	if err != nil {
+		return nil, fmt.Errorf("getting policy violations from DependencyTrack: %w", err)
+	}
+	return summary, nil
+}
+
+// VulnerabilityHistory is the resolver for the vulnerabilityHistory field.
+func (r *teamResolver) VulnerabilityHistory(ctx context.Context, obj *model.Team, from scalar.Date, to scalar.Date) (*model.VulnerabilityHistory, error) {
+	err := ValidateDateInterval(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	fromDate, err := from.PgDate()
+	if err != nil {
+		return nil, err
+	}
+
+	toDate, err := to.PgDate()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.querier.VulnerabilitySnapshotsForTeam(ctx, gensql.VulnerabilitySnapshotsForTeamParams{
+		Team:     obj.Name,
+		FromDate: fromDate,
+		ToDate:   toDate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("vulnerability snapshots query: %w", err)
+	}
+
+	remediation, err := r.querier.CriticalFindingsRemediation(ctx, gensql.CriticalFindingsRemediationParams{
+		Team:     obj.Name,
+		FromDate: fromDate,
+		ToDate:   toDate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("critical findings remediation query: %w", err)
+	}
+
+	return VulnerabilityHistoryFromDatabaseRows(rows, remediation), nil
+}
+
+// DeliveryMetrics is the resolver for the deliveryMetrics field.
+func (r *teamResolver) DeliveryMetrics(ctx context.Context, obj *model.Team, from scalar.Date, to scalar.Date) (*model.DeliveryMetrics, error) {
+	err := ValidateDateInterval(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	fromDate, err := from.PgDate()
+	if err != nil {
+		return nil, err
+	}
+
+	toDate, err := to.PgDate()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.querier.DeliveryMetricsForTeam(ctx, gensql.DeliveryMetricsForTeamParams{
+		Team:     obj.Name,
+		FromDate: fromDate,
+		ToDate:   toDate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("delivery metrics query: %w", err)
+	}
+
+	return DeliveryMetricsFromDatabaseRows(from, to, rows), nil
+}
+
+// Budget is the resolver for the budget field.
+func (r *teamResolver) Budget(ctx context.Context, obj *model.Team) (*model.TeamBudget, error) {
+	budget, err := r.querier.CostBudget(ctx, obj.Name)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("budget query: %w", err)
+	}
+	return r.teamBudget(ctx, budget)
+}
+
+// CostAnomalies is the resolver for the costAnomalies field.
+func (r *teamResolver) CostAnomalies(ctx context.Context, obj *model.Team, from scalar.Date, to scalar.Date) ([]model.CostAnomaly, error) {
+	err := ValidateDateInterval(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	fromDate, err := from.PgDate()
+	if err != nil {
+		return nil, err
+	}
+
+	toDate, err := to.PgDate()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.querier.CostAnomaliesForTeam(ctx, gensql.CostAnomaliesForTeamParams{
+		Team:     obj.Name,
+		FromDate: fromDate,
+		ToDate:   toDate,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cost anomalies query: %w", err)
+	}
+
+	return CostAnomaliesFromDatabaseRows(rows), nil
+}
+
 // Team returns TeamResolver implementation.
 func (r *Resolver) Team() TeamResolver { return &teamResolver{r} }
 
@@ -0,0 +1,198 @@
+package graph_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nais/console-backend/internal/auth"
+	"github.com/nais/console-backend/internal/database/gensql"
+	"github.com/nais/console-backend/internal/graph"
+	"github.com/nais/console-backend/internal/graph/model"
+	"github.com/nais/console-backend/internal/hookd"
+	"github.com/nais/console-backend/internal/teams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_queryResolver_Teams(t *testing.T) {
+	ctx := context.Background()
+
+	cachedTeams := func() []*model.Team {
+		return []*model.Team{
+			{Name: "team-a", SlackChannel: "#team-a"},
+			{Name: "team-b"},
+			{Name: "other-team", SlackChannel: "#other-team"},
+			{Name: "team-c", SlackChannel: "#team-c"},
+		}
+	}
+
+	t.Run("no filter or order", func(t *testing.T) {
+		teamsClient := teams.NewMockClient(t)
+		teamsClient.EXPECT().GetCachedTeams(ctx).Return(cachedTeams(), nil)
+
+		resp, err := graph.
+			NewResolver(nil, teamsClient, nil, nil, nil, nil, nil, nil).
+			Query().
+			Teams(ctx, nil, nil, nil, nil, nil, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 4, resp.TotalCount)
+		assert.Equal(t, "team-a", resp.Edges[0].Node.Name)
+		assert.Equal(t, "team-c", resp.Edges[3].Node.Name)
+	})
+
+	t.Run("error from the teams-backend", func(t *testing.T) {
+		teamsClient := teams.NewMockClient(t)
+		teamsClient.EXPECT().GetCachedTeams(ctx).Return(nil, fmt.Errorf("teams: 502 Bad Gateway"))
+
+		resp, err := graph.
+			NewResolver(nil, teamsClient, nil, nil, nil, nil, nil, nil).
+			Query().
+			Teams(ctx, nil, nil, nil, nil, nil, nil)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "getting teams from Teams: teams: 502 Bad Gateway")
+	})
+
+	t.Run("filter on name and slack channel", func(t *testing.T) {
+		teamsClient := teams.NewMockClient(t)
+		teamsClient.EXPECT().GetCachedTeams(ctx).Return(cachedTeams(), nil)
+
+		name := "TEAM-"
+		hasSlackChannel := true
+		resp, err := graph.
+			NewResolver(nil, teamsClient, nil, nil, nil, nil, nil, nil).
+			Query().
+			Teams(ctx, nil, nil, nil, nil, &model.TeamsFilter{Name: &name, HasSlackChannel: &hasSlackChannel}, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, resp.TotalCount)
+		assert.Equal(t, "team-a", resp.Edges[0].Node.Name)
+		assert.Equal(t, "team-c", resp.Edges[1].Node.Name)
+	})
+
+	t.Run("order by monthly cost", func(t *testing.T) {
+		teamsClient := teams.NewMockClient(t)
+		teamsClient.EXPECT().GetCachedTeams(ctx).Return(cachedTeams(), nil)
+
+		teamA, teamC := "team-a", "team-c"
+		querier := gensql.NewMockQuerier(t)
+		querier.
+			EXPECT().
+			CostForTeams(ctx, mock.AnythingOfType("gensql.CostForTeamsParams")).
+			Return([]*gensql.CostForTeamsRow{
+				{Team: &teamA, DailyCost: 10},
+				{Team: &teamC, DailyCost: 20},
+			}, nil)
+
+		resp, err := graph.
+			NewResolver(nil, teamsClient, nil, nil, nil, querier, nil, nil).
+			Query().
+			Teams(ctx, nil, nil, nil, nil, nil, &model.OrderBy{Field: model.OrderByFieldMonthlyCost, Direction: model.SortOrderDesc})
+		assert.NoError(t, err)
+		assert.Equal(t, 4, resp.TotalCount)
+		assert.Equal(t, "team-c", resp.Edges[0].Node.Name)
+		assert.Equal(t, "team-a", resp.Edges[1].Node.Name)
+		assert.Equal(t, "team-b", resp.Edges[2].Node.Name)
+		assert.Equal(t, "other-team", resp.Edges[3].Node.Name)
+	})
+
+	t.Run("order by critical vulnerabilities", func(t *testing.T) {
+		teamsClient := teams.NewMockClient(t)
+		teamsClient.EXPECT().GetCachedTeams(ctx).Return(cachedTeams(), nil)
+
+		querier := gensql.NewMockQuerier(t)
+		querier.
+			EXPECT().
+			VulnerabilitySummaries(ctx, gensql.VulnerabilitySummariesParams{}).
+			Return([]*gensql.VulnerabilitySummariesRow{
+				{Team: "team-a", Env: "dev", Critical: 1},
+				{Team: "team-b", Env: "dev", Critical: 2},
+				{Team: "team-b", Env: "prod", Critical: 0},
+				{Team: "team-c", Env: "dev", Critical: 2},
+				{Team: "team-c", Env: "prod", Critical: 3},
+			}, nil)
+
+		resp, err := graph.
+			NewResolver(nil, teamsClient, nil, nil, nil, querier, nil, nil).
+			Query().
+			Teams(ctx, nil, nil, nil, nil, nil, &model.OrderBy{Field: model.OrderByFieldSeverityCritical, Direction: model.SortOrderDesc})
+		assert.NoError(t, err)
+		assert.Equal(t, 4, resp.TotalCount)
+		assert.Equal(t, "team-c", resp.Edges[0].Node.Name)
+		assert.Equal(t, "team-b", resp.Edges[1].Node.Name)
+		assert.Equal(t, "team-a", resp.Edges[2].Node.Name)
+		assert.Equal(t, "other-team", resp.Edges[3].Node.Name)
+	})
+}
+
+func Test_queryResolver_ExpiringDeployKeys(t *testing.T) {
+	ctx := context.Background()
+	expires := time.Now().Add(24 * time.Hour)
+
+	querier := gensql.NewMockQuerier(t)
+	querier.EXPECT().
+		ExpiringDeployKeys(ctx, mock.AnythingOfType("pgtype.Timestamptz")).
+		Return([]*gensql.DeployKey{
+			{Team: "team-b", Expires: pgtype.Timestamptz{Time: expires, Valid: true}},
+			{Team: "deleted-team", Expires: pgtype.Timestamptz{Time: expires, Valid: true}},
+			{Team: "team-a", Expires: pgtype.Timestamptz{Time: expires.Add(time.Hour), Valid: true}},
+		}, nil)
+
+	teamsClient := teams.NewMockClient(t)
+	teamsClient.EXPECT().GetCachedTeams(ctx).Return([]*model.Team{
+		{Name: "team-a"},
+		{Name: "team-b"},
+	}, nil)
+
+	resp, err := graph.
+		NewResolver(nil, teamsClient, nil, nil, nil, querier, nil, nil).
+		Query().
+		ExpiringDeployKeys(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, resp, 2)
+	assert.Equal(t, "team-b", resp[0].Team.Name)
+	assert.Equal(t, expires, resp[0].Expires)
+	assert.Equal(t, "team-a", resp[1].Team.Name)
+}
+
+func Test_mutationResolver_ChangeDeployKey(t *testing.T) {
+	var ctx context.Context
+	auth.StaticUser("user@example.com")(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		ctx = r.Context()
+	})).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/query", nil))
+
+	created := time.Now()
+	expires := created.AddDate(1, 0, 0)
+
+	teamsClient := teams.NewMockClient(t)
+	teamsClient.EXPECT().GetTeamsForUser(ctx, "user@example.com").Return([]teams.TeamMembership{
+		{Team: teams.Team{Slug: "team-a"}},
+	}, nil)
+
+	hookdClient := hookd.NewMockClient(t)
+	hookdClient.EXPECT().ChangeDeployKey(ctx, "team-a").Return(&hookd.DeployKey{
+		Team:    "team-a",
+		Key:     "key",
+		Created: created,
+		Expires: expires,
+	}, nil)
+
+	querier := gensql.NewMockQuerier(t)
+	querier.EXPECT().DeployKeyUpsert(ctx, gensql.DeployKeyUpsertParams{
+		Team:    "team-a",
+		Created: pgtype.Timestamptz{Time: created, Valid: true},
+		Expires: pgtype.Timestamptz{Time: expires, Valid: true},
+	}).Return(&gensql.DeployKey{Team: "team-a"}, nil)
+
+	resp, err := graph.
+		NewResolver(hookdClient, teamsClient, nil, nil, nil, querier, nil, nil).
+		Mutation().
+		ChangeDeployKey(ctx, "team-a")
+	assert.NoError(t, err)
+	assert.Equal(t, "key", resp.Key)
+	assert.Equal(t, expires, resp.Expires)
+	assert.False(t, resp.ExpiresSoon)
+}
@@ -1,11 +1,26 @@
 package graph
 
 import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
 	"github.com/nais/console-backend/internal/graph/model"
 	"github.com/nais/console-backend/internal/graph/scalar"
 	t "github.com/nais/console-backend/internal/teams"
 )
 
+// workload is a common representation of apps and naisjobs, used when filtering and sorting workloads across teams
+type workload struct {
+	node     model.Workload
+	team     string
+	name     string
+	env      string
+	state    model.State
+	deployed *time.Time
+}
+
 func userTeamEdges(teams []t.TeamMembership, p *model.Pagination) []model.TeamEdge {
 	edges := make([]model.TeamEdge, 0)
 	start, end := p.ForSlice(len(teams))
@@ -35,3 +50,139 @@ func userTeamEdges(teams []t.TeamMembership, p *model.Pagination) []model.TeamEd
 
 	return edges
 }
+
+func workloadEdges(workloads []workload, p *model.Pagination) []model.WorkloadEdge {
+	edges := make([]model.WorkloadEdge, 0)
+	start, end := p.ForSlice(len(workloads))
+
+	for i, w := range workloads[start:end] {
+		edges = append(edges, model.WorkloadEdge{
+			Cursor: scalar.Cursor{Offset: start + i},
+			Node:   w.node,
+		})
+	}
+
+	return edges
+}
+
+// userTeams returns the slugs of the teams the user is a member of. If only is non-empty, the result is limited to
+// those teams.
+func (r *Resolver) userTeams(ctx context.Context, email string, only []string) ([]string, error) {
+	memberships, err := r.teamsClient.GetTeamsForUser(ctx, email)
+	if err != nil {
+		return nil, fmt.Errorf("getting teams from Teams: %w", err)
+	}
+
+	slugs := make([]string, 0)
+	for _, membership := range memberships {
+		if len(only) > 0 && !slices.Contains(only, membership.Team.Slug) {
+			continue
+		}
+		slugs = append(slugs, membership.Team.Slug)
+	}
+	return slugs, nil
+}
+
+// userWorkloads returns the apps and naisjobs of the given teams that match the filter
+func (r *Resolver) userWorkloads(ctx context.Context, teams []string, filter *model.WorkloadFilter) ([]workload, error) {
+	if filter == nil {
+		filter = &model.WorkloadFilter{}
+	}
+
+	workloads := make([]workload, 0)
+	for _, team := range teams {
+		if filter.Type == nil || *filter.Type == model.WorkloadTypeApp {
+			apps, err := r.k8sClient.Apps(ctx, team)
+			if err != nil {
+				return nil, fmt.Errorf("getting apps from Kubernetes: %w", err)
+			}
+
+			for _, app := range apps {
+				app.GQLVars = model.AppGQLVars{Team: team}
+				workloads = append(workloads, workload{
+					node:     app,
+					team:     team,
+					name:     app.Name,
+					env:      app.Env.Name,
+					state:    app.AppState.State,
+					deployed: app.DeployInfo.Timestamp,
+				})
+			}
+		}
+
+		if filter.Type == nil || *filter.Type == model.WorkloadTypeNaisjob {
+			jobs, err := r.k8sClient.NaisJobs(ctx, team)
+			if err != nil {
+				return nil, fmt.Errorf("getting naisjobs from Kubernetes: %w", err)
+			}
+
+			for _, job := range jobs {
+				job.GQLVars = model.NaisJobGQLVars{Team: team}
+				workloads = append(workloads, workload{
+					node:     job,
+					team:     team,
+					name:     job.Name,
+					env:      job.Env.Name,
+					state:    job.JobState.State,
+					deployed: job.DeployInfo.Timestamp,
+				})
+			}
+		}
+	}
+
+	return filterWorkloads(workloads, filter), nil
+}
+
+// filterWorkloads removes the workloads that do not match the teams, environments and state of the filter
+func filterWorkloads(workloads []workload, filter *model.WorkloadFilter) []workload {
+	return slices.DeleteFunc(workloads, func(w workload) bool {
+		if len(filter.Teams) > 0 && !slices.Contains(filter.Teams, w.team) {
+			return true
+		}
+		if len(filter.Envs) > 0 && !slices.Contains(filter.Envs, w.env) {
+			return true
+		}
+		return filter.State != nil && *filter.State != w.state
+	})
+}
+
+// sortWorkloads sorts the workloads in place, using the same semantics as the apps and naisjobs of a team
+func sortWorkloads(workloads []workload, orderBy *model.OrderBy) {
+	if orderBy == nil {
+		return
+	}
+
+	switch orderBy.Field {
+	case model.OrderByFieldName:
+		model.SortWith(workloads, func(a, b workload) bool {
+			return model.Compare(a.name, b.name, orderBy.Direction)
+		})
+	case model.OrderByFieldEnv:
+		model.SortWith(workloads, func(a, b workload) bool {
+			return model.Compare(a.env, b.env, orderBy.Direction)
+		})
+	case model.OrderByFieldDeployed:
+		model.SortWith(workloads, func(a, b workload) bool {
+			if a.deployed == nil {
+				return false
+			}
+			if b.deployed == nil {
+				return true
+			}
+			return model.Compare(b.deployed.UnixMilli(), a.deployed.UnixMilli(), orderBy.Direction)
+		})
+	case model.OrderByFieldStatus:
+		sortOrder := []model.State{model.StateFailing, model.StateNotnais, model.StateUnknown, model.StateNais}
+		model.SortWith(workloads, func(a, b workload) bool {
+			aIndex := slices.Index(sortOrder, a.state)
+			bIndex := slices.Index(sortOrder, b.state)
+			if aIndex == -1 {
+				return false
+			}
+			if bIndex == -1 {
+				return true
+			}
+			return model.Compare(aIndex, bIndex, orderBy.Direction)
+		})
+	}
+}
@@ -7,11 +7,16 @@ package graph
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/nais/console-backend/internal/auth"
+	"github.com/nais/console-backend/internal/database/gensql"
+	"github.com/nais/console-backend/internal/dependencytrack"
 	"github.com/nais/console-backend/internal/graph/apierror"
 	"github.com/nais/console-backend/internal/graph/model"
 	"github.com/nais/console-backend/internal/graph/scalar"
+	"github.com/nais/console-backend/internal/hookd"
 )
 
 // User is the resolver for the user field.
@@ -74,7 +79,188 @@ func (r *userResolver) Teams(ctx context.Context, obj *model.User, first *int, a
 	}, nil
 }
 
+// Workloads is the resolver for the workloads field.
+func (r *userResolver) Workloads(ctx context.Context, obj *model.User, first *int, after *scalar.Cursor, last *int, before *scalar.Cursor, orderBy *model.OrderBy, filter *model.WorkloadFilter) (*model.WorkloadConnection, error) {
+	teams, err := r.userTeams(ctx, obj.Email, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	workloads, err := r.userWorkloads(ctx, teams, filter)
+	if err != nil {
+		return nil, err
+	}
+	sortWorkloads(workloads, orderBy)
+
+	pagination, err := model.NewPagination(first, last, after, before)
+	if err != nil {
+		return nil, err
+	}
+	edges := workloadEdges(workloads, pagination)
+
+	var startCursor *scalar.Cursor
+	var endCursor *scalar.Cursor
+	if len(edges) > 0 {
+		startCursor = &edges[0].Cursor
+		endCursor = &edges[len(edges)-1].Cursor
+	}
+
+	hasNext := len(workloads) > pagination.First()+pagination.After().Offset+1
+	hasPrevious := pagination.After().Offset > 0
+
+	if pagination.Before() != nil && startCursor != nil {
+		hasNext = true
+		hasPrevious = startCursor.Offset > 0
+	}
+
+	return &model.WorkloadConnection{
+		TotalCount: len(workloads),
+		Edges:      edges,
+		PageInfo: model.PageInfo{
+			HasNextPage:     hasNext,
+			HasPreviousPage: hasPrevious,
+			StartCursor:     startCursor,
+			EndCursor:       endCursor,
+		},
+	}, nil
+}
+
+// Dashboard is the resolver for the dashboard field.
+func (r *userResolver) Dashboard(ctx context.Context, obj *model.User, teams []string) (*model.UserDashboard, error) {
+	slugs, err := r.userTeams(ctx, obj.Email, teams)
+	if err != nil {
+		return nil, err
+	}
+
+	dashboard := &model.UserDashboard{
+		FailingApps: make([]model.App, 0),
+		FailingJobs: make([]model.NaisJob, 0),
+		Teams:       make([]model.TeamDashboard, 0),
+		GQLVars:     model.UserDashboardGQLVars{Teams: slugs},
+	}
+
+	for _, slug := range slugs {
+		team, err := r.teamsClient.GetTeam(ctx, slug)
+		if err != nil {
+			team = &model.Team{ID: scalar.TeamIdent(slug), Name: slug}
+		}
+		teamDashboard := model.TeamDashboard{Team: *team}
+
+		apps, err := r.k8sClient.Apps(ctx, slug)
+		if err != nil {
+			return nil, fmt.Errorf("getting apps from Kubernetes: %w", err)
+		}
+		for _, app := range apps {
+			if app.AppState.State == model.StateFailing {
+				app.GQLVars = model.AppGQLVars{Team: slug}
+				dashboard.FailingApps = append(dashboard.FailingApps, *app)
+				teamDashboard.FailingApps++
+			}
+		}
+
+		jobs, err := r.k8sClient.NaisJobs(ctx, slug)
+		if err != nil {
+			return nil, fmt.Errorf("getting naisjobs from Kubernetes: %w", err)
+		}
+		for _, job := range jobs {
+			if job.JobState.State == model.StateFailing {
+				job.GQLVars = model.NaisJobGQLVars{Team: slug}
+				dashboard.FailingJobs = append(dashboard.FailingJobs, *job)
+				teamDashboard.FailingJobs++
+			}
+		}
+
+		dashboard.Teams = append(dashboard.Teams, teamDashboard)
+	}
+
+	model.SortWith(dashboard.Teams, func(a, b model.TeamDashboard) bool {
+		return a.FailingApps+a.FailingJobs > b.FailingApps+b.FailingJobs
+	})
+
+	return dashboard, nil
+}
+
+// RecentDeployments is the resolver for the recentDeployments field.
+func (r *userDashboardResolver) RecentDeployments(ctx context.Context, obj *model.UserDashboard, limit *int) ([]model.Deployment, error) {
+	if limit == nil {
+		limit = new(int)
+		*limit = 10
+	}
+
+	if len(obj.GQLVars.Teams) == 0 {
+		return []model.Deployment{}, nil
+	}
+
+	deploys, err := r.hookdClient.Deployments(ctx, hookd.WithTeams(obj.GQLVars.Teams...), hookd.WithLimit(*limit))
+	if err != nil {
+		return nil, fmt.Errorf("getting deploys from Hookd: %w", err)
+	}
+
+	ret := make([]model.Deployment, 0, len(deploys))
+	for _, deploy := range deploys {
+		ret = append(ret, mapDeployment(deploy))
+	}
+	return ret, nil
+}
+
+// CriticalVulnerabilities is the resolver for the criticalVulnerabilities field.
+func (r *userDashboardResolver) CriticalVulnerabilities(ctx context.Context, obj *model.UserDashboard) ([]model.VulnerabilitiesNode, error) {
+	instances := make([]*dependencytrack.AppInstance, 0)
+	for _, team := range obj.GQLVars.Teams {
+		teamInstances, err := r.workloadInstances(ctx, team)
+		if err != nil {
+			return nil, err
+		}
+		instances = append(instances, teamInstances...)
+	}
+
+	nodes, err := r.dependencyTrackClient.GetVulnerabilities(ctx, instances)
+	if err != nil {
+		return nil, fmt.Errorf("getting vulnerabilities from DependencyTrack: %w", err)
+	}
+
+	ret := make([]model.VulnerabilitiesNode, 0)
+	for _, n := range nodes {
+		if n.Summary != nil && n.Summary.Critical > 0 {
+			ret = append(ret, *n)
+		}
+	}
+
+	model.SortWith(ret, func(a, b model.VulnerabilitiesNode) bool {
+		if a.Summary.Critical == b.Summary.Critical {
+			return a.Summary.RiskScore > b.Summary.RiskScore
+		}
+		return a.Summary.Critical > b.Summary.Critical
+	})
+	return ret, nil
+}
+
+// MonthToDateCost is the resolver for the monthToDateCost field.
+func (r *userDashboardResolver) MonthToDateCost(ctx context.Context, obj *model.UserDashboard) (float64, error) {
+	now := time.Now()
+	rows, err := r.querier.CostForTeams(ctx, gensql.CostForTeamsParams{
+		FromDate: pgtype.Date{Time: time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC), Valid: true},
+		ToDate:   pgtype.Date{Time: now, Valid: true},
+		Teams:    obj.GQLVars.Teams,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("getting cost for teams: %w", err)
+	}
+
+	sum := 0.0
+	for _, row := range rows {
+		sum += float64(row.DailyCost)
+	}
+	return sum, nil
+}
+
 // User returns UserResolver implementation.
 func (r *Resolver) User() UserResolver { return &userResolver{r} }
 
-type userResolver struct{ *Resolver }
+// UserDashboard returns UserDashboardResolver implementation.
+func (r *Resolver) UserDashboard() UserDashboardResolver { return &userDashboardResolver{r} }
+
+type (
+	userResolver          struct{ *Resolver }
+	userDashboardResolver struct{ *Resolver }
+)
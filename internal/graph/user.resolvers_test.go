@@ -0,0 +1,177 @@
+package graph_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nais/console-backend/internal/database/gensql"
+	"github.com/nais/console-backend/internal/graph"
+	"github.com/nais/console-backend/internal/graph/model"
+	"github.com/nais/console-backend/internal/graph/scalar"
+	"github.com/nais/console-backend/internal/hookd"
+	"github.com/nais/console-backend/internal/k8s"
+	"github.com/nais/console-backend/internal/teams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func Test_userDashboardResolver_RecentDeployments(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	dashboard := &model.UserDashboard{
+		GQLVars: model.UserDashboardGQLVars{Teams: []string{"team-a", "team-b"}},
+	}
+
+	hookdClient := hookd.NewMockClient(t)
+	hookdClient.
+		EXPECT().
+		Deployments(ctx, mock.AnythingOfType("hookd.RequestOption"), mock.AnythingOfType("hookd.RequestOption")).
+		RunAndReturn(func(_ context.Context, opts ...hookd.RequestOption) ([]hookd.Deploy, error) {
+			filter := hookd.FilterFromOptions(opts...)
+			assert.Equal(t, []string{"team-a", "team-b"}, filter.Teams)
+			assert.Equal(t, 2, filter.Limit)
+			return []hookd.Deploy{
+				{DeploymentInfo: hookd.DeploymentInfo{ID: "a-1", Team: "team-a", Created: now.Add(-1 * time.Hour)}},
+				{DeploymentInfo: hookd.DeploymentInfo{ID: "b-1", Team: "team-b", Created: now.Add(-2 * time.Hour)}},
+			}, nil
+		}).
+		Once()
+
+	limit := 2
+	resp, err := graph.
+		NewResolver(hookdClient, nil, nil, nil, nil, nil, nil, nil).
+		UserDashboard().
+		RecentDeployments(ctx, dashboard, &limit)
+	assert.NoError(t, err)
+	assert.Len(t, resp, 2)
+	assert.Equal(t, "a-1", resp[0].ID.ID)
+	assert.Equal(t, "b-1", resp[1].ID.ID)
+}
+
+func Test_userDashboardResolver_MonthToDateCost(t *testing.T) {
+	ctx := context.Background()
+
+	dashboard := &model.UserDashboard{
+		GQLVars: model.UserDashboardGQLVars{Teams: []string{"team-a", "team-b"}},
+	}
+
+	teamA, teamB := "team-a", "team-b"
+	querier := gensql.NewMockQuerier(t)
+	querier.
+		EXPECT().
+		CostForTeams(ctx, mock.AnythingOfType("gensql.CostForTeamsParams")).
+		Run(func(_ context.Context, arg gensql.CostForTeamsParams) {
+			assert.Equal(t, []string{"team-a", "team-b"}, arg.Teams)
+			assert.Equal(t, 1, arg.FromDate.Time.Day())
+		}).
+		Return([]*gensql.CostForTeamsRow{
+			{Team: &teamA, DailyCost: 100.5},
+			{Team: &teamB, DailyCost: 50.25},
+		}, nil)
+
+	sum, err := graph.
+		NewResolver(nil, nil, nil, nil, nil, querier, nil, nil).
+		UserDashboard().
+		MonthToDateCost(ctx, dashboard)
+	assert.NoError(t, err)
+	assert.Equal(t, 150.75, sum)
+}
+
+func Test_userResolver_Workloads(t *testing.T) {
+	ctx := context.Background()
+	user := &model.User{Email: "user@example.com"}
+
+	t.Run("unable to get teams of user", func(t *testing.T) {
+		teamsClient := teams.NewMockClient(t)
+		teamsClient.EXPECT().GetTeamsForUser(ctx, "user@example.com").Return(nil, fmt.Errorf("some error"))
+
+		resp, err := graph.
+			NewResolver(nil, teamsClient, &k8s.Client{}, nil, nil, nil, nil, nil).
+			User().
+			Workloads(ctx, user, nil, nil, nil, nil, nil, nil)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "getting teams from Teams: some error")
+	})
+
+	t.Run("no workloads", func(t *testing.T) {
+		teamsClient := teams.NewMockClient(t)
+		teamsClient.EXPECT().GetTeamsForUser(ctx, "user@example.com").Return([]teams.TeamMembership{
+			{Team: teams.Team{Slug: "team-a"}},
+			{Team: teams.Team{Slug: "team-b"}},
+		}, nil)
+
+		resp, err := graph.
+			NewResolver(nil, teamsClient, &k8s.Client{}, nil, nil, nil, nil, nil).
+			User().
+			Workloads(ctx, user, nil, nil, nil, nil, &model.OrderBy{Field: model.OrderByFieldName, Direction: model.SortOrderAsc}, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, resp.TotalCount)
+		assert.Empty(t, resp.Edges)
+		assert.False(t, resp.PageInfo.HasNextPage)
+		assert.False(t, resp.PageInfo.HasPreviousPage)
+		assert.Nil(t, resp.PageInfo.StartCursor)
+	})
+}
+
+func Test_userResolver_Dashboard(t *testing.T) {
+	ctx := context.Background()
+	user := &model.User{Email: "user@example.com"}
+
+	memberships := []teams.TeamMembership{
+		{Team: teams.Team{Slug: "team-a"}},
+		{Team: teams.Team{Slug: "team-b"}},
+		{Team: teams.Team{Slug: "team-c"}},
+	}
+
+	t.Run("unable to get teams of user", func(t *testing.T) {
+		teamsClient := teams.NewMockClient(t)
+		teamsClient.EXPECT().GetTeamsForUser(ctx, "user@example.com").Return(nil, fmt.Errorf("some error"))
+
+		resp, err := graph.
+			NewResolver(nil, teamsClient, &k8s.Client{}, nil, nil, nil, nil, nil).
+			User().
+			Dashboard(ctx, user, nil)
+		assert.Nil(t, resp)
+		assert.EqualError(t, err, "getting teams from Teams: some error")
+	})
+
+	t.Run("all teams of user", func(t *testing.T) {
+		teamsClient := teams.NewMockClient(t)
+		teamsClient.EXPECT().GetTeamsForUser(ctx, "user@example.com").Return(memberships, nil)
+		teamsClient.EXPECT().GetTeam(ctx, "team-a").Return(&model.Team{Name: "team-a", SlackChannel: "#team-a"}, nil)
+		teamsClient.EXPECT().GetTeam(ctx, "team-b").Return(&model.Team{Name: "team-b"}, nil)
+		teamsClient.EXPECT().GetTeam(ctx, "team-c").Return(&model.Team{Name: "team-c"}, nil)
+
+		resp, err := graph.
+			NewResolver(nil, teamsClient, &k8s.Client{}, nil, nil, nil, nil, nil).
+			User().
+			Dashboard(ctx, user, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"team-a", "team-b", "team-c"}, resp.GQLVars.Teams)
+		assert.Len(t, resp.Teams, 3)
+		assert.Equal(t, "#team-a", resp.Teams[0].Team.SlackChannel)
+		assert.Empty(t, resp.FailingApps)
+		assert.Empty(t, resp.FailingJobs)
+	})
+
+	t.Run("only the given teams, and a team missing from the cache", func(t *testing.T) {
+		teamsClient := teams.NewMockClient(t)
+		teamsClient.EXPECT().GetTeamsForUser(ctx, "user@example.com").Return(memberships, nil)
+		teamsClient.EXPECT().GetTeam(ctx, "team-a").Return(&model.Team{Name: "team-a"}, nil)
+		teamsClient.EXPECT().GetTeam(ctx, "team-c").Return(nil, fmt.Errorf("team not found: team-c"))
+
+		resp, err := graph.
+			NewResolver(nil, teamsClient, &k8s.Client{}, nil, nil, nil, nil, nil).
+			User().
+			Dashboard(ctx, user, []string{"team-a", "team-c", "team-d"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"team-a", "team-c"}, resp.GQLVars.Teams)
+		assert.Len(t, resp.Teams, 2)
+		assert.Equal(t, "team-a", resp.Teams[0].Team.Name)
+		assert.Equal(t, "team-c", resp.Teams[1].Team.Name)
+		assert.Equal(t, scalar.TeamIdent("team-c"), resp.Teams[1].Team.ID)
+	})
+}
@@ -0,0 +1,78 @@
+package graph
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nais/console-backend/internal/graph/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterWorkloads(t *testing.T) {
+	workloads := func() []workload {
+		return []workload{
+			{name: "app-1", team: "team-a", env: "dev", state: model.StateNais},
+			{name: "app-2", team: "team-a", env: "prod", state: model.StateFailing},
+			{name: "job-1", team: "team-b", env: "dev", state: model.StateFailing},
+			{name: "job-2", team: "team-b", env: "prod", state: model.StateNais},
+		}
+	}
+
+	names := func(workloads []workload) []string {
+		ret := make([]string, 0)
+		for _, w := range workloads {
+			ret = append(ret, w.name)
+		}
+		return ret
+	}
+
+	t.Run("empty filter", func(t *testing.T) {
+		assert.Equal(t, []string{"app-1", "app-2", "job-1", "job-2"}, names(filterWorkloads(workloads(), &model.WorkloadFilter{})))
+	})
+
+	t.Run("filter on teams", func(t *testing.T) {
+		filter := &model.WorkloadFilter{Teams: []string{"team-b"}}
+		assert.Equal(t, []string{"job-1", "job-2"}, names(filterWorkloads(workloads(), filter)))
+	})
+
+	t.Run("filter on envs and state", func(t *testing.T) {
+		state := model.StateFailing
+		filter := &model.WorkloadFilter{Envs: []string{"prod"}, State: &state}
+		assert.Equal(t, []string{"app-2"}, names(filterWorkloads(workloads(), filter)))
+	})
+}
+
+func TestSortWorkloads(t *testing.T) {
+	deployed := time.Date(2023, time.November, 1, 12, 0, 0, 0, time.UTC)
+	newer := deployed.Add(time.Hour)
+
+	workloads := func() []workload {
+		return []workload{
+			{name: "b", env: "prod", state: model.StateNais, deployed: &deployed},
+			{name: "c", env: "dev", state: model.StateFailing},
+			{name: "a", env: "staging", state: model.StateNotnais, deployed: &newer},
+		}
+	}
+
+	tests := []struct {
+		orderBy  *model.OrderBy
+		expected []string
+	}{
+		{nil, []string{"b", "c", "a"}},
+		{&model.OrderBy{Field: model.OrderByFieldName, Direction: model.SortOrderAsc}, []string{"a", "b", "c"}},
+		{&model.OrderBy{Field: model.OrderByFieldEnv, Direction: model.SortOrderDesc}, []string{"a", "b", "c"}},
+		{&model.OrderBy{Field: model.OrderByFieldDeployed, Direction: model.SortOrderAsc}, []string{"a", "b", "c"}},
+		{&model.OrderBy{Field: model.OrderByFieldStatus, Direction: model.SortOrderAsc}, []string{"c", "a", "b"}},
+	}
+
+	for _, tt := range tests {
+		w := workloads()
+		sortWorkloads(w, tt.orderBy)
+
+		sorted := make([]string, 0)
+		for _, workload := range w {
+			sorted = append(sorted, workload.name)
+		}
+		assert.Equal(t, tt.expected, sorted)
+	}
+}
@@ -0,0 +1,37 @@
+package graph
+
+import (
+	"github.com/nais/console-backend/internal/database/gensql"
+	"github.com/nais/console-backend/internal/graph/model"
+	"github.com/nais/console-backend/internal/graph/scalar"
+)
+
+// VulnerabilityHistoryFromDatabaseRows will create a vulnerability history from snapshot rows sorted by date. The
+// snapshots of each day are summed, so the history of a team covers all apps that were snapshotted that day.
+func VulnerabilityHistoryFromDatabaseRows(rows []*gensql.VulnerabilitySnapshot, remediation *gensql.CriticalFindingsRemediationRow) *model.VulnerabilityHistory {
+	series := make([]model.VulnerabilityHistoryEntry, 0)
+	for _, row := range rows {
+		date := scalar.NewDate(row.Date.Time)
+		if len(series) == 0 || series[len(series)-1].Date != date {
+			series = append(series, model.VulnerabilityHistoryEntry{Date: date})
+		}
+
+		summary := &series[len(series)-1].Summary
+		summary.Critical += int(row.Critical)
+		summary.High += int(row.High)
+		summary.Medium += int(row.Medium)
+		summary.Low += int(row.Low)
+		summary.Unassigned += int(row.Unassigned)
+		summary.RiskScore += int(row.RiskScore)
+		summary.Total += int(row.Critical + row.High + row.Medium + row.Low + row.Unassigned)
+	}
+
+	history := &model.VulnerabilityHistory{
+		Series:             series,
+		RemediatedCritical: int(remediation.Resolved),
+	}
+	if remediation.Resolved > 0 {
+		history.MeanTimeToRemediateCritical = &remediation.MeanSeconds
+	}
+	return history
+}
@@ -0,0 +1,45 @@
+package graph
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nais/console-backend/internal/database/gensql"
+	"github.com/nais/console-backend/internal/graph/model"
+	"github.com/nais/console-backend/internal/graph/scalar"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVulnerabilityHistoryFromDatabaseRows(t *testing.T) {
+	day := time.Date(2023, time.November, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("no rows", func(t *testing.T) {
+		history := VulnerabilityHistoryFromDatabaseRows([]*gensql.VulnerabilitySnapshot{}, &gensql.CriticalFindingsRemediationRow{})
+		assert.Empty(t, history.Series)
+		assert.Equal(t, 0, history.RemediatedCritical)
+		assert.Nil(t, history.MeanTimeToRemediateCritical)
+	})
+
+	t.Run("snapshots of multiple apps are summed per day", func(t *testing.T) {
+		rows := []*gensql.VulnerabilitySnapshot{
+			{Date: pgtype.Date{Time: day, Valid: true}, App: "a", Critical: 1, High: 2, RiskScore: 20},
+			{Date: pgtype.Date{Time: day, Valid: true}, App: "b", Medium: 3, Low: 4, Unassigned: 1, RiskScore: 18},
+			{Date: pgtype.Date{Time: day.AddDate(0, 0, 2), Valid: true}, App: "a", High: 1, RiskScore: 5},
+		}
+
+		history := VulnerabilityHistoryFromDatabaseRows(rows, &gensql.CriticalFindingsRemediationRow{Resolved: 2, MeanSeconds: 3600})
+		assert.Equal(t, []model.VulnerabilityHistoryEntry{
+			{
+				Date:    scalar.NewDate(day),
+				Summary: model.VulnerabilitySummary{Total: 11, RiskScore: 38, Critical: 1, High: 2, Medium: 3, Low: 4, Unassigned: 1},
+			},
+			{
+				Date:    scalar.NewDate(day.AddDate(0, 0, 2)),
+				Summary: model.VulnerabilitySummary{Total: 1, RiskScore: 5, High: 1},
+			},
+		}, history.Series)
+		assert.Equal(t, 2, history.RemediatedCritical)
+		assert.Equal(t, 3600.0, *history.MeanTimeToRemediateCritical)
+	})
+}
@@ -6,12 +6,15 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/nais/console-backend/internal/config"
+	"github.com/nais/console-backend/internal/upstream"
 	"github.com/sirupsen/logrus"
 	"go.opentelemetry.io/otel/attribute"
 	api "go.opentelemetry.io/otel/metric"
@@ -46,6 +49,10 @@ type DeploymentInfo struct {
 	Cluster          string    `json:"cluster"`
 	Created          time.Time `json:"created"`
 	GithubRepository string    `json:"githubRepository"`
+
+	// CommitTimestamp is the time of the commit that was deployed. hookd does not return it, but deployment events may
+	// include it.
+	CommitTimestamp *time.Time `json:"commitTimestamp,omitempty"`
 }
 
 type Status struct {
@@ -64,6 +71,37 @@ type Resource struct {
 	Namespace string `json:"namespace"`
 }
 
+// States of a deploy, given by its most recent final status
+const (
+	StateSuccess    = "success"
+	StateFailure    = "failure"
+	StateInProgress = "in_progress"
+)
+
+// State returns the state of the deploy, based on its most recent status. A deploy with an "error" status has failed,
+// and a deploy without statuses is in progress.
+func (d Deploy) State() string {
+	if len(d.Statuses) == 0 {
+		return StateInProgress
+	}
+
+	latest := d.Statuses[0]
+	for _, status := range d.Statuses[1:] {
+		if status.Created.After(latest.Created) {
+			latest = status
+		}
+	}
+
+	switch latest.Status {
+	case "success":
+		return StateSuccess
+	case "failure", "error":
+		return StateFailure
+	default:
+		return StateInProgress
+	}
+}
+
 type DeployKey struct {
 	Team    string    `json:"team"`
 	Key     string    `json:"key"`
@@ -71,54 +109,202 @@ type DeployKey struct {
 	Created time.Time `json:"created"`
 }
 
-type RequestOption func(*http.Request)
+// Filter is the set of filters of a deployments request, given by request options
+type Filter struct {
+	Team        string
+	Cluster     string
+	Limit       int
+	Offset      int
+	IgnoreTeams []string
+
+	// The filters below are not supported by the hookd API, deployments of several teams are fetched one team at a time
+	Teams        []string
+	Repository   string
+	ResourceKind string
+	ResourceName string
+	State        string
+
+	// CreatedFrom is inclusive and CreatedBefore is exclusive
+	CreatedFrom   time.Time
+	CreatedBefore time.Time
+}
+
+type RequestOption func(*Filter)
 
 func WithTeam(team string) RequestOption {
-	return func(req *http.Request) {
-		q := req.URL.Query()
-		q.Set("team", team)
-		req.URL.RawQuery = q.Encode()
+	return func(filter *Filter) {
+		filter.Team = team
 	}
 }
 
 func WithCluster(cluster string) RequestOption {
-	return func(req *http.Request) {
-		q := req.URL.Query()
-		q.Set("cluster", cluster)
-		req.URL.RawQuery = q.Encode()
+	return func(filter *Filter) {
+		filter.Cluster = cluster
 	}
 }
 
 func WithLimit(limit int) RequestOption {
-	return func(req *http.Request) {
-		q := req.URL.Query()
-		q.Set("limit", strconv.Itoa(limit))
-		req.URL.RawQuery = q.Encode()
+	return func(filter *Filter) {
+		filter.Limit = limit
+	}
+}
+
+func WithOffset(offset int) RequestOption {
+	return func(filter *Filter) {
+		filter.Offset = offset
 	}
 }
 
 func WithIgnoreTeams(teams ...string) RequestOption {
-	return func(req *http.Request) {
-		q := req.URL.Query()
-		q.Set("ignoreTeam", strings.Join(teams, ","))
-		req.URL.RawQuery = q.Encode()
+	return func(filter *Filter) {
+		filter.IgnoreTeams = teams
+	}
+}
+
+func WithTeams(teams ...string) RequestOption {
+	return func(filter *Filter) {
+		filter.Teams = teams
+	}
+}
+
+func WithRepository(repository string) RequestOption {
+	return func(filter *Filter) {
+		filter.Repository = repository
+	}
+}
+
+func WithResourceKind(kind string) RequestOption {
+	return func(filter *Filter) {
+		filter.ResourceKind = kind
+	}
+}
+
+func WithResourceName(name string) RequestOption {
+	return func(filter *Filter) {
+		filter.ResourceName = name
+	}
+}
+
+func WithState(state string) RequestOption {
+	return func(filter *Filter) {
+		filter.State = state
+	}
+}
+
+func WithCreatedFrom(from time.Time) RequestOption {
+	return func(filter *Filter) {
+		filter.CreatedFrom = from
+	}
+}
+
+func WithCreatedBefore(before time.Time) RequestOption {
+	return func(filter *Filter) {
+		filter.CreatedBefore = before
+	}
+}
+
+// FilterFromOptions returns the filter described by the request options
+func FilterFromOptions(opts ...RequestOption) Filter {
+	var filter Filter
+	for _, opt := range opts {
+		opt(&filter)
+	}
+	return filter
+}
+
+// Query returns the query parameters of the filter for the hookd deployments API
+func (f Filter) Query() url.Values {
+	q := url.Values{}
+	if f.Team != "" {
+		q.Set("team", f.Team)
+	}
+	if f.Cluster != "" {
+		q.Set("cluster", f.Cluster)
+	}
+	if f.Limit > 0 {
+		q.Set("limit", strconv.Itoa(f.Limit))
+	}
+	if f.Offset > 0 {
+		q.Set("offset", strconv.Itoa(f.Offset))
+	}
+	if len(f.IgnoreTeams) > 0 {
+		q.Set("ignoreTeam", strings.Join(f.IgnoreTeams, ","))
+	}
+	return q
+}
+
+// Matches returns true if the deploy matches the filters that are not supported by the hookd API
+func (f Filter) Matches(deploy Deploy) bool {
+	if len(f.Teams) > 0 && !slices.Contains(f.Teams, deploy.DeploymentInfo.Team) {
+		return false
+	}
+
+	if f.Repository != "" && deploy.DeploymentInfo.GithubRepository != f.Repository {
+		return false
+	}
+
+	if f.ResourceKind != "" || f.ResourceName != "" {
+		hasResource := slices.ContainsFunc(deploy.Resources, func(resource Resource) bool {
+			return (f.ResourceKind == "" || resource.Kind == f.ResourceKind) &&
+				(f.ResourceName == "" || resource.Name == f.ResourceName)
+		})
+		if !hasResource {
+			return false
+		}
+	}
+
+	if f.State != "" && deploy.State() != f.State {
+		return false
+	}
+
+	created := deploy.DeploymentInfo.Created
+	if !f.CreatedFrom.IsZero() && created.Before(f.CreatedFrom) {
+		return false
+	}
+
+	if !f.CreatedBefore.IsZero() && !created.Before(f.CreatedBefore) {
+		return false
+	}
+
+	return true
+}
+
+type Option func(*client)
+
+// WithMeter will register a gauge reporting the circuit breaker state of hookd with the given meter
+func WithMeter(meter api.Meter) Option {
+	return func(c *client) {
+		if err := c.httpClient.client.RegisterMetrics(meter); err != nil {
+			c.log.WithError(err).Error("register hookd upstream metrics")
+		}
 	}
 }
 
 // New creates a new hookd client
-func New(cfg config.Hookd, errors api.Int64Counter, log logrus.FieldLogger) Client {
-	return &client{
+func New(cfg config.Hookd, errors api.Int64Counter, log logrus.FieldLogger, opts ...Option) Client {
+	c := &client{
 		endpoint: cfg.Endpoint,
 		httpClient: &httpClient{
-			client: &http.Client{},
-			psk:    cfg.PSK,
+			client: upstream.New(upstream.Config{
+				Name:       "hookd",
+				Timeout:    cfg.Timeout,
+				MaxRetries: cfg.MaxRetries,
+			}),
+			psk: cfg.PSK,
 		},
 		log:    log,
 		errors: errors,
 	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
 }
 
-// Deployments returns a list of deployments from hookd
+// Deployments returns a list of deployments from hookd. The filters that are not supported by the hookd API are applied
+// to the deployments returned by hookd, after the limit, so fewer deployments than the limit may be returned.
 func (c *client) Deployments(ctx context.Context, opts ...RequestOption) ([]Deploy, error) {
 	url := c.endpoint + "/internal/api/v1/console/deployments"
 
@@ -127,10 +313,13 @@ func (c *client) Deployments(ctx context.Context, opts ...RequestOption) ([]Depl
 		return nil, c.error(ctx, err, "create request for hookd")
 	}
 
-	for _, opt := range opts {
-		opt(req)
+	filter := FilterFromOptions(opts...)
+	if len(filter.Teams) > 0 {
+		return c.deploymentsForTeams(ctx, filter, opts)
 	}
 
+	req.URL.RawQuery = filter.Query().Encode()
+
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, c.error(ctx, err, "calling hookd")
@@ -147,7 +336,12 @@ func (c *client) Deployments(ctx context.Context, opts ...RequestOption) ([]Depl
 		return nil, c.error(ctx, err, "decoding response from hookd")
 	}
 
-	ret := deploymentsResponse.Deployments
+	ret := make([]Deploy, 0, len(deploymentsResponse.Deployments))
+	for _, deploy := range deploymentsResponse.Deployments {
+		if filter.Matches(deploy) {
+			ret = append(ret, deploy)
+		}
+	}
 
 	sort.Slice(ret, func(i, j int) bool {
 		return ret[i].DeploymentInfo.Created.After(ret[j].DeploymentInfo.Created)
@@ -156,6 +350,28 @@ func (c *client) Deployments(ctx context.Context, opts ...RequestOption) ([]Depl
 	return ret, nil
 }
 
+// deploymentsForTeams returns the deployments of several teams, newest first, by fetching the deployments of one team at
+// a time. At most filter.Limit deployments are returned.
+func (c *client) deploymentsForTeams(ctx context.Context, filter Filter, opts []RequestOption) ([]Deploy, error) {
+	ret := make([]Deploy, 0)
+	for _, team := range filter.Teams {
+		deploys, err := c.Deployments(ctx, append(opts, WithTeams(), WithTeam(team))...)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, deploys...)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].DeploymentInfo.Created.After(ret[j].DeploymentInfo.Created)
+	})
+
+	if filter.Limit > 0 && len(ret) > filter.Limit {
+		ret = ret[:filter.Limit]
+	}
+	return ret, nil
+}
+
 // ChangeDeployKey changes the deploy key for a team
 func (c *client) ChangeDeployKey(ctx context.Context, team string) (*DeployKey, error) {
 	url := fmt.Sprintf("%s/internal/api/v1/console/apikey/%s", c.endpoint, team)
@@ -114,6 +114,80 @@ func TestClient(t *testing.T) {
 		assert.Equal(t, "1", deployments[2].DeploymentInfo.ID)
 	})
 
+	t.Run("filters unsupported by hookd are applied to the deployments from hookd", func(t *testing.T) {
+		created := time.Date(2023, time.November, 1, 10, 0, 0, 0, time.UTC)
+		hookdServer := httptest.NewHttpServerWithHandlers(t, []http.HandlerFunc{
+			func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "team=team", r.URL.RawQuery)
+				resp, _ := json.Marshal(hookd.DeploymentsResponse{
+					Deployments: []hookd.Deploy{
+						{
+							DeploymentInfo: hookd.DeploymentInfo{ID: "1", GithubRepository: "org/app", Created: created},
+							Statuses:       []hookd.Status{{Status: "success", Created: created}},
+							Resources:      []hookd.Resource{{Kind: "Application", Name: "app"}},
+						},
+						{
+							DeploymentInfo: hookd.DeploymentInfo{ID: "2", GithubRepository: "org/app", Created: created.AddDate(0, 0, 1)},
+							Statuses:       []hookd.Status{{Status: "error", Created: created.AddDate(0, 0, 1)}},
+							Resources:      []hookd.Resource{{Kind: "Application", Name: "app"}},
+						},
+						{
+							DeploymentInfo: hookd.DeploymentInfo{ID: "3", GithubRepository: "org/job", Created: created.AddDate(0, 0, 1)},
+							Statuses:       []hookd.Status{{Status: "failure", Created: created.AddDate(0, 0, 1)}},
+							Resources:      []hookd.Resource{{Kind: "Naisjob", Name: "job"}},
+						},
+					},
+				})
+				w.Write(resp)
+			},
+		})
+
+		cfg.Endpoint = hookdServer.URL
+		client := hookd.New(cfg, counter, logger)
+
+		deployments, err := client.Deployments(
+			ctx,
+			hookd.WithTeam("team"),
+			hookd.WithRepository("org/app"),
+			hookd.WithResourceKind("Application"),
+			hookd.WithState(hookd.StateFailure),
+			hookd.WithCreatedFrom(created.AddDate(0, 0, 1)),
+			hookd.WithCreatedBefore(created.AddDate(0, 0, 2)),
+		)
+		assert.NoError(t, err)
+		assert.Len(t, deployments, 1)
+		assert.Equal(t, "2", deployments[0].DeploymentInfo.ID)
+	})
+
+	t.Run("deployments of several teams are fetched one team at a time", func(t *testing.T) {
+		created := time.Date(2023, time.November, 1, 10, 0, 0, 0, time.UTC)
+		teamDeploys := func(team string, created ...time.Time) http.HandlerFunc {
+			return func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, team, r.URL.Query().Get("team"))
+				assert.Equal(t, "2", r.URL.Query().Get("limit"))
+				deploys := make([]hookd.Deploy, 0)
+				for i, c := range created {
+					deploys = append(deploys, hookd.Deploy{DeploymentInfo: hookd.DeploymentInfo{ID: fmt.Sprintf("%s-%d", team, i), Team: team, Created: c}})
+				}
+				resp, _ := json.Marshal(hookd.DeploymentsResponse{Deployments: deploys})
+				w.Write(resp)
+			}
+		}
+		hookdServer := httptest.NewHttpServerWithHandlers(t, []http.HandlerFunc{
+			teamDeploys("team-a", created, created.Add(-2*time.Hour)),
+			teamDeploys("team-b", created.Add(-time.Hour)),
+		})
+
+		cfg.Endpoint = hookdServer.URL
+		client := hookd.New(cfg, counter, logger)
+
+		deployments, err := client.Deployments(ctx, hookd.WithTeams("team-a", "team-b"), hookd.WithLimit(2))
+		assert.NoError(t, err)
+		assert.Len(t, deployments, 2)
+		assert.Equal(t, "team-a-0", deployments[0].DeploymentInfo.ID)
+		assert.Equal(t, "team-b-0", deployments[1].DeploymentInfo.ID)
+	})
+
 	t.Run("get deploykey errors when error is returned from backend", func(t *testing.T) {
 		hookdServer := httptest.NewHttpServerWithHandlers(t, []http.HandlerFunc{
 			func(w http.ResponseWriter, r *http.Request) {
@@ -167,18 +241,60 @@ func TestRequestOptions(t *testing.T) {
 	const limit = 42
 	ignoreTeams := []string{"team1", "team2"}
 
-	r, err := http.NewRequest(http.MethodGet, "http://localhost", nil)
-	assert.NoError(t, err)
+	q := hookd.FilterFromOptions(
+		hookd.WithTeam(team),
+		hookd.WithCluster(cluster),
+		hookd.WithLimit(limit),
+		hookd.WithOffset(limit),
+		hookd.WithIgnoreTeams(ignoreTeams...),
+	).Query()
+
+	assert.Equal(t, team, q.Get("team"))
+	assert.Equal(t, cluster, q.Get("cluster"))
+	assert.Equal(t, strconv.FormatInt(limit, 10), q.Get("limit"))
+	assert.Equal(t, strconv.FormatInt(limit, 10), q.Get("offset"))
+	assert.Equal(t, "team1,team2", q.Get("ignoreTeam"))
+}
 
-	hookd.WithTeam(team)(r)
-	hookd.WithCluster(cluster)(r)
-	hookd.WithLimit(limit)(r)
-	hookd.WithIgnoreTeams(ignoreTeams...)(r)
+func TestDeploy_State(t *testing.T) {
+	created := time.Date(2023, time.November, 1, 10, 0, 0, 0, time.UTC)
 
-	assert.Equal(t, team, r.URL.Query().Get("team"))
-	assert.Equal(t, cluster, r.URL.Query().Get("cluster"))
-	assert.Equal(t, strconv.FormatInt(limit, 10), r.URL.Query().Get("limit"))
-	assert.Equal(t, "team1,team2", r.URL.Query().Get("ignoreTeam"))
+	assert.Equal(t, hookd.StateInProgress, hookd.Deploy{}.State())
+	assert.Equal(t, hookd.StateSuccess, hookd.Deploy{Statuses: []hookd.Status{
+		{Status: "in_progress", Created: created},
+		{Status: "success", Created: created.Add(time.Minute)},
+	}}.State())
+	assert.Equal(t, hookd.StateFailure, hookd.Deploy{Statuses: []hookd.Status{
+		{Status: "error", Created: created.Add(time.Minute)},
+		{Status: "queued", Created: created},
+	}}.State())
+	assert.Equal(t, hookd.StateInProgress, hookd.Deploy{Statuses: []hookd.Status{
+		{Status: "queued", Created: created},
+	}}.State())
+}
+
+func TestFilterFromOptions(t *testing.T) {
+	t.Run("no options", func(t *testing.T) {
+		assert.Equal(t, hookd.Filter{}, hookd.FilterFromOptions())
+		assert.Empty(t, hookd.FilterFromOptions().Query())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		filter := hookd.FilterFromOptions(
+			hookd.WithTeam("team"),
+			hookd.WithCluster("cluster"),
+			hookd.WithLimit(42),
+			hookd.WithOffset(84),
+			hookd.WithIgnoreTeams("team1", "team2"),
+		)
+		assert.Equal(t, hookd.Filter{
+			Team:        "team",
+			Cluster:     "cluster",
+			Limit:       42,
+			Offset:      84,
+			IgnoreTeams: []string{"team1", "team2"},
+		}, filter)
+	})
 }
 
 func getMetricMeter() (met.Meter, error) {
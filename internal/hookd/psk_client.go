@@ -2,10 +2,12 @@ package hookd
 
 import (
 	"net/http"
+
+	"github.com/nais/console-backend/internal/upstream"
 )
 
 type httpClient struct {
-	client *http.Client
+	client *upstream.Client
 	psk    string
 }
 
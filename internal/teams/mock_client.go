@@ -9,6 +9,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	search "github.com/nais/console-backend/internal/search"
+
+	uuid "github.com/google/uuid"
 )
 
 // MockClient is an autogenerated mock type for the Client type
@@ -24,6 +26,63 @@ func (_m *MockClient) EXPECT() *MockClient_Expecter {
 	return &MockClient_Expecter{mock: &_m.Mock}
 }
 
+// AddTeamMember provides a mock function with given fields: ctx, teamSlug, userID, role
+func (_m *MockClient) AddTeamMember(ctx context.Context, teamSlug string, userID uuid.UUID, role model.TeamRole) (*model.Team, error) {
+	ret := _m.Called(ctx, teamSlug, userID, role)
+
+	var r0 *model.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, model.TeamRole) (*model.Team, error)); ok {
+		return rf(ctx, teamSlug, userID, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, model.TeamRole) *model.Team); ok {
+		r0 = rf(ctx, teamSlug, userID, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uuid.UUID, model.TeamRole) error); ok {
+		r1 = rf(ctx, teamSlug, userID, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_AddTeamMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTeamMember'
+type MockClient_AddTeamMember_Call struct {
+	*mock.Call
+}
+
+// AddTeamMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamSlug string
+//   - userID uuid.UUID
+//   - role model.TeamRole
+func (_e *MockClient_Expecter) AddTeamMember(ctx interface{}, teamSlug interface{}, userID interface{}, role interface{}) *MockClient_AddTeamMember_Call {
+	return &MockClient_AddTeamMember_Call{Call: _e.mock.On("AddTeamMember", ctx, teamSlug, userID, role)}
+}
+
+func (_c *MockClient_AddTeamMember_Call) Run(run func(ctx context.Context, teamSlug string, userID uuid.UUID, role model.TeamRole)) *MockClient_AddTeamMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID), args[3].(model.TeamRole))
+	})
+	return _c
+}
+
+func (_c *MockClient_AddTeamMember_Call) Return(_a0 *model.Team, _a1 error) *MockClient_AddTeamMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockClient_AddTeamMember_Call) RunAndReturn(run func(context.Context, string, uuid.UUID, model.TeamRole) (*model.Team, error)) *MockClient_AddTeamMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AuthorizeRepository provides a mock function with given fields: ctx, authorization, team, repository
 func (_m *MockClient) AuthorizeRepository(ctx context.Context, authorization model.RepositoryAuthorization, team string, repository string) (*model.GithubRepository, error) {
 	ret := _m.Called(ctx, authorization, team, repository)
@@ -81,6 +140,64 @@ func (_c *MockClient_AuthorizeRepository_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// CreateTeam provides a mock function with given fields: ctx, teamSlug, purpose, slackChannel, ownerID
+func (_m *MockClient) CreateTeam(ctx context.Context, teamSlug string, purpose string, slackChannel string, ownerID uuid.UUID) (*model.Team, error) {
+	ret := _m.Called(ctx, teamSlug, purpose, slackChannel, ownerID)
+
+	var r0 *model.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, uuid.UUID) (*model.Team, error)); ok {
+		return rf(ctx, teamSlug, purpose, slackChannel, ownerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, uuid.UUID) *model.Team); ok {
+		r0 = rf(ctx, teamSlug, purpose, slackChannel, ownerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, uuid.UUID) error); ok {
+		r1 = rf(ctx, teamSlug, purpose, slackChannel, ownerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_CreateTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTeam'
+type MockClient_CreateTeam_Call struct {
+	*mock.Call
+}
+
+// CreateTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamSlug string
+//   - purpose string
+//   - slackChannel string
+//   - ownerID uuid.UUID
+func (_e *MockClient_Expecter) CreateTeam(ctx interface{}, teamSlug interface{}, purpose interface{}, slackChannel interface{}, ownerID interface{}) *MockClient_CreateTeam_Call {
+	return &MockClient_CreateTeam_Call{Call: _e.mock.On("CreateTeam", ctx, teamSlug, purpose, slackChannel, ownerID)}
+}
+
+func (_c *MockClient_CreateTeam_Call) Run(run func(ctx context.Context, teamSlug string, purpose string, slackChannel string, ownerID uuid.UUID)) *MockClient_CreateTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockClient_CreateTeam_Call) Return(_a0 *model.Team, _a1 error) *MockClient_CreateTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockClient_CreateTeam_Call) RunAndReturn(run func(context.Context, string, string, string, uuid.UUID) (*model.Team, error)) *MockClient_CreateTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeauthorizeRepository provides a mock function with given fields: ctx, authorization, team, repository
 func (_m *MockClient) DeauthorizeRepository(ctx context.Context, authorization model.RepositoryAuthorization, team string, repository string) (*model.GithubRepository, error) {
 	ret := _m.Called(ctx, authorization, team, repository)
@@ -138,6 +255,60 @@ func (_c *MockClient_DeauthorizeRepository_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// GetCachedTeams provides a mock function with given fields: ctx
+func (_m *MockClient) GetCachedTeams(ctx context.Context) ([]*model.Team, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.Team, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.Team); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_GetCachedTeams_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCachedTeams'
+type MockClient_GetCachedTeams_Call struct {
+	*mock.Call
+}
+
+// GetCachedTeams is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockClient_Expecter) GetCachedTeams(ctx interface{}) *MockClient_GetCachedTeams_Call {
+	return &MockClient_GetCachedTeams_Call{Call: _e.mock.On("GetCachedTeams", ctx)}
+}
+
+func (_c *MockClient_GetCachedTeams_Call) Run(run func(ctx context.Context)) *MockClient_GetCachedTeams_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockClient_GetCachedTeams_Call) Return(_a0 []*model.Team, _a1 error) *MockClient_GetCachedTeams_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockClient_GetCachedTeams_Call) RunAndReturn(run func(context.Context) ([]*model.Team, error)) *MockClient_GetCachedTeams_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetGithubRepositories provides a mock function with given fields: ctx, teamSlug
 func (_m *MockClient) GetGithubRepositories(ctx context.Context, teamSlug string) ([]GitHubRepository, error) {
 	ret := _m.Called(ctx, teamSlug)
@@ -522,6 +693,95 @@ func (_c *MockClient_GetUserByID_Call) RunAndReturn(run func(context.Context, st
 	return _c
 }
 
+// RemoveTeamMember provides a mock function with given fields: ctx, teamSlug, userID
+func (_m *MockClient) RemoveTeamMember(ctx context.Context, teamSlug string, userID uuid.UUID) (*model.Team, error) {
+	ret := _m.Called(ctx, teamSlug, userID)
+
+	var r0 *model.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) (*model.Team, error)); ok {
+		return rf(ctx, teamSlug, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID) *model.Team); ok {
+		r0 = rf(ctx, teamSlug, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uuid.UUID) error); ok {
+		r1 = rf(ctx, teamSlug, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_RemoveTeamMember_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTeamMember'
+type MockClient_RemoveTeamMember_Call struct {
+	*mock.Call
+}
+
+// RemoveTeamMember is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamSlug string
+//   - userID uuid.UUID
+func (_e *MockClient_Expecter) RemoveTeamMember(ctx interface{}, teamSlug interface{}, userID interface{}) *MockClient_RemoveTeamMember_Call {
+	return &MockClient_RemoveTeamMember_Call{Call: _e.mock.On("RemoveTeamMember", ctx, teamSlug, userID)}
+}
+
+func (_c *MockClient_RemoveTeamMember_Call) Run(run func(ctx context.Context, teamSlug string, userID uuid.UUID)) *MockClient_RemoveTeamMember_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *MockClient_RemoveTeamMember_Call) Return(_a0 *model.Team, _a1 error) *MockClient_RemoveTeamMember_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockClient_RemoveTeamMember_Call) RunAndReturn(run func(context.Context, string, uuid.UUID) (*model.Team, error)) *MockClient_RemoveTeamMember_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Run provides a mock function with given fields: ctx
+func (_m *MockClient) Run(ctx context.Context) {
+	_m.Called(ctx)
+}
+
+// MockClient_Run_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Run'
+type MockClient_Run_Call struct {
+	*mock.Call
+}
+
+// Run is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockClient_Expecter) Run(ctx interface{}) *MockClient_Run_Call {
+	return &MockClient_Run_Call{Call: _e.mock.On("Run", ctx)}
+}
+
+func (_c *MockClient_Run_Call) Run(run func(ctx context.Context)) *MockClient_Run_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *MockClient_Run_Call) Return() *MockClient_Run_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockClient_Run_Call) RunAndReturn(run func(context.Context)) *MockClient_Run_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Search provides a mock function with given fields: ctx, query, filter
 func (_m *MockClient) Search(ctx context.Context, query string, filter *model.SearchFilter) []*search.Result {
 	ret := _m.Called(ctx, query, filter)
@@ -568,6 +828,118 @@ func (_c *MockClient_Search_Call) RunAndReturn(run func(context.Context, string,
 	return _c
 }
 
+// SetTeamMemberRole provides a mock function with given fields: ctx, teamSlug, userID, role
+func (_m *MockClient) SetTeamMemberRole(ctx context.Context, teamSlug string, userID uuid.UUID, role model.TeamRole) (*model.Team, error) {
+	ret := _m.Called(ctx, teamSlug, userID, role)
+
+	var r0 *model.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, model.TeamRole) (*model.Team, error)); ok {
+		return rf(ctx, teamSlug, userID, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uuid.UUID, model.TeamRole) *model.Team); ok {
+		r0 = rf(ctx, teamSlug, userID, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uuid.UUID, model.TeamRole) error); ok {
+		r1 = rf(ctx, teamSlug, userID, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_SetTeamMemberRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTeamMemberRole'
+type MockClient_SetTeamMemberRole_Call struct {
+	*mock.Call
+}
+
+// SetTeamMemberRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamSlug string
+//   - userID uuid.UUID
+//   - role model.TeamRole
+func (_e *MockClient_Expecter) SetTeamMemberRole(ctx interface{}, teamSlug interface{}, userID interface{}, role interface{}) *MockClient_SetTeamMemberRole_Call {
+	return &MockClient_SetTeamMemberRole_Call{Call: _e.mock.On("SetTeamMemberRole", ctx, teamSlug, userID, role)}
+}
+
+func (_c *MockClient_SetTeamMemberRole_Call) Run(run func(ctx context.Context, teamSlug string, userID uuid.UUID, role model.TeamRole)) *MockClient_SetTeamMemberRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uuid.UUID), args[3].(model.TeamRole))
+	})
+	return _c
+}
+
+func (_c *MockClient_SetTeamMemberRole_Call) Return(_a0 *model.Team, _a1 error) *MockClient_SetTeamMemberRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockClient_SetTeamMemberRole_Call) RunAndReturn(run func(context.Context, string, uuid.UUID, model.TeamRole) (*model.Team, error)) *MockClient_SetTeamMemberRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SynchronizeTeam provides a mock function with given fields: ctx, teamSlug
+func (_m *MockClient) SynchronizeTeam(ctx context.Context, teamSlug string) (*model.TeamSync, error) {
+	ret := _m.Called(ctx, teamSlug)
+
+	var r0 *model.TeamSync
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.TeamSync, error)); ok {
+		return rf(ctx, teamSlug)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.TeamSync); ok {
+		r0 = rf(ctx, teamSlug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TeamSync)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, teamSlug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_SynchronizeTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SynchronizeTeam'
+type MockClient_SynchronizeTeam_Call struct {
+	*mock.Call
+}
+
+// SynchronizeTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamSlug string
+func (_e *MockClient_Expecter) SynchronizeTeam(ctx interface{}, teamSlug interface{}) *MockClient_SynchronizeTeam_Call {
+	return &MockClient_SynchronizeTeam_Call{Call: _e.mock.On("SynchronizeTeam", ctx, teamSlug)}
+}
+
+func (_c *MockClient_SynchronizeTeam_Call) Run(run func(ctx context.Context, teamSlug string)) *MockClient_SynchronizeTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockClient_SynchronizeTeam_Call) Return(_a0 *model.TeamSync, _a1 error) *MockClient_SynchronizeTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockClient_SynchronizeTeam_Call) RunAndReturn(run func(context.Context, string) (*model.TeamSync, error)) *MockClient_SynchronizeTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TeamExists provides a mock function with given fields: ctx, teamSlug
 func (_m *MockClient) TeamExists(ctx context.Context, teamSlug string) bool {
 	ret := _m.Called(ctx, teamSlug)
@@ -611,6 +983,62 @@ func (_c *MockClient_TeamExists_Call) RunAndReturn(run func(context.Context, str
 	return _c
 }
 
+// UpdateTeam provides a mock function with given fields: ctx, teamSlug, input
+func (_m *MockClient) UpdateTeam(ctx context.Context, teamSlug string, input model.UpdateTeamInput) (*model.Team, error) {
+	ret := _m.Called(ctx, teamSlug, input)
+
+	var r0 *model.Team
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.UpdateTeamInput) (*model.Team, error)); ok {
+		return rf(ctx, teamSlug, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.UpdateTeamInput) *model.Team); ok {
+		r0 = rf(ctx, teamSlug, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Team)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, model.UpdateTeamInput) error); ok {
+		r1 = rf(ctx, teamSlug, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClient_UpdateTeam_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTeam'
+type MockClient_UpdateTeam_Call struct {
+	*mock.Call
+}
+
+// UpdateTeam is a helper method to define mock.On call
+//   - ctx context.Context
+//   - teamSlug string
+//   - input model.UpdateTeamInput
+func (_e *MockClient_Expecter) UpdateTeam(ctx interface{}, teamSlug interface{}, input interface{}) *MockClient_UpdateTeam_Call {
+	return &MockClient_UpdateTeam_Call{Call: _e.mock.On("UpdateTeam", ctx, teamSlug, input)}
+}
+
+func (_c *MockClient_UpdateTeam_Call) Run(run func(ctx context.Context, teamSlug string, input model.UpdateTeamInput)) *MockClient_UpdateTeam_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(model.UpdateTeamInput))
+	})
+	return _c
+}
+
+func (_c *MockClient_UpdateTeam_Call) Return(_a0 *model.Team, _a1 error) *MockClient_UpdateTeam_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockClient_UpdateTeam_Call) RunAndReturn(run func(context.Context, string, model.UpdateTeamInput) (*model.Team, error)) *MockClient_UpdateTeam_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockClient creates a new instance of MockClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockClient(t interface {
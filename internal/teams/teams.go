@@ -4,11 +4,16 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math/rand"
 	"net/http"
 	"os"
+	"slices"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
@@ -16,17 +21,97 @@ import (
 	"github.com/nais/console-backend/internal/graph/model"
 	"github.com/nais/console-backend/internal/graph/scalar"
 	"github.com/nais/console-backend/internal/search"
+	"github.com/nais/console-backend/internal/upstream"
+	"github.com/patrickmn/go-cache"
 	"github.com/sirupsen/logrus"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 )
 
-const teamsCacheTTL = 15 * time.Minute
+const (
+	// teamsRefreshInterval is how often the background refresher fetches teams from the teams-backend
+	teamsRefreshInterval = 5 * time.Minute
+
+	// teamsRefreshJitter is the maximum random delay added to each refresh, to avoid replicas refreshing in lockstep
+	teamsRefreshJitter = 30 * time.Second
+
+	// teamsRefreshMinBackoff is the initial delay before retrying a failed refresh, doubled on each consecutive failure
+	teamsRefreshMinBackoff = 5 * time.Second
+
+	// teamsRefreshTimeout is the timeout for a single refresh of the teams cache
+	teamsRefreshTimeout = 30 * time.Second
+
+	// teamsCacheTTL is the age after which a read triggers a refresh of the teams cache in the background
+	teamsCacheTTL = 15 * time.Minute
+
+	// userTeamsCacheTTL is how long the team memberships of a single user are cached
+	userTeamsCacheTTL = time.Minute
+
+	// addOwnerAttempts is the number of attempts at adding the owner of a newly created team
+	addOwnerAttempts = 3
+
+	// addOwnerBackoff is the delay before retrying to add the owner of a newly created team, doubled on each attempt
+	addOwnerBackoff = 500 * time.Millisecond
+)
+
+// ErrOwnerNotAdded is returned by CreateTeam when the team was created, but the owner could not be added to it
+var ErrOwnerNotAdded = errors.New("team created without owner")
+
+// teamFields is the selection set used when fetching teams from the teams-backend
+const teamFields = `
+	slug
+	purpose
+	slackChannel
+	slackAlertsChannels {
+		channelName
+		environment
+	}
+	reconcilerState {
+		gcpProjects {
+			projectId
+			projectName
+			environment
+		}
+	}
+	syncErrors {
+		reconciler
+		error
+		createdAt
+	}
+	lastSuccessfulSync`
+
+// reconcilers is the list of teams-backend reconcilers that are reported for all teams, in addition to any reconciler
+// with a sync error
+var reconcilers = []string{
+	"github:team",
+	"azure:group",
+	"google:workspace-admin",
+	"nais:namespace",
+}
+
+// adminRoleName is the name of the global teams-backend role of tenant admins
+const adminRoleName = "Admin"
 
 type User struct {
 	Name  string           `json:"name"`
 	ID    uuid.UUID        `json:"id"`
 	Teams []TeamMembership `json:"teams"`
+	Roles []UserRole       `json:"roles"`
+}
+
+type UserRole struct {
+	Name     string `json:"name"`
+	IsGlobal bool   `json:"isGlobal"`
+}
+
+// IsAdmin returns true if the user has the global admin role of the tenant
+func (u *User) IsAdmin() bool {
+	for _, role := range u.Roles {
+		if role.IsGlobal && role.Name == adminRoleName {
+			return true
+		}
+	}
+	return false
 }
 
 type TeamMembership struct {
@@ -51,6 +136,14 @@ type Team struct {
 	SlackAlertsChannels []SlackAlertsChannel `json:"slackAlertsChannels"`
 	Members             []Member             `json:"members"`
 	ReconcilerState     ReconcilerState      `json:"reconcilerState"`
+	SyncErrors          []SyncError          `json:"syncErrors"`
+	LastSuccessfulSync  *time.Time           `json:"lastSuccessfulSync"`
+}
+
+type SyncError struct {
+	Reconciler string    `json:"reconciler"`
+	Error      string    `json:"error"`
+	CreatedAt  time.Time `json:"createdAt"`
 }
 
 type GitHubRepository struct {
@@ -97,41 +190,121 @@ type Client interface {
 	GetGithubRepositories(ctx context.Context, teamSlug string) ([]GitHubRepository, error)
 	GetTeamMembers(ctx context.Context, teamSlug string) ([]Member, error)
 	GetTeams(ctx context.Context) ([]Team, error)
+	GetCachedTeams(ctx context.Context) ([]*model.Team, error)
 	GetTeamsForUser(ctx context.Context, email string) ([]TeamMembership, error)
 	GetUserByID(ctx context.Context, id string) (*model.User, error)
 	GetUser(ctx context.Context, email string) (*User, error)
 	TeamExists(ctx context.Context, teamSlug string) bool
+	Run(ctx context.Context)
+	CreateTeam(ctx context.Context, teamSlug, purpose, slackChannel string, ownerID uuid.UUID) (*model.Team, error)
+	UpdateTeam(ctx context.Context, teamSlug string, input model.UpdateTeamInput) (*model.Team, error)
+	AddTeamMember(ctx context.Context, teamSlug string, userID uuid.UUID, role model.TeamRole) (*model.Team, error)
+	RemoveTeamMember(ctx context.Context, teamSlug string, userID uuid.UUID) (*model.Team, error)
+	SetTeamMemberRole(ctx context.Context, teamSlug string, userID uuid.UUID, role model.TeamRole) (*model.Team, error)
+	SynchronizeTeam(ctx context.Context, teamSlug string) (*model.TeamSync, error)
 }
 
 type client struct {
-	endpoint   string
-	httpClient *httpClient
-	lock       sync.RWMutex
-	teams      []*model.Team
-	updated    time.Time
-	log        logrus.FieldLogger
-	errors     metric.Int64Counter
+	endpoint      string
+	httpClient    *httpClient
+	snapshot      atomic.Pointer[teamsSnapshot]
+	refreshLock   sync.Mutex
+	refreshing    atomic.Bool
+	invalidations atomic.Int64
+	userTeams     *cache.Cache
+	log           logrus.FieldLogger
+	errors        metric.Int64Counter
+}
+
+// teamsSnapshot is an immutable copy of all teams, swapped in atomically on each refresh
+type teamsSnapshot struct {
+	teams   []*model.Team
+	updated time.Time
+
+	// stale is set when the teams have been changed after the snapshot was fetched
+	stale bool
+}
+
+type Option func(*client)
+
+// WithMeter will register gauges reporting the age of the teams cache and the circuit breaker state of the teams
+// backend with the given meter
+func WithMeter(meter metric.Meter) Option {
+	return func(c *client) {
+		_, err := meter.Float64ObservableGauge(
+			"teams_cache_age",
+			metric.WithDescription("age of the teams cache"),
+			metric.WithUnit("s"),
+			metric.WithFloat64Callback(func(_ context.Context, o metric.Float64Observer) error {
+				if snapshot := c.snapshot.Load(); snapshot != nil {
+					o.Observe(time.Since(snapshot.updated).Seconds())
+				}
+				return nil
+			}),
+		)
+		if err != nil {
+			c.log.WithError(err).Error("create teams cache age gauge")
+		}
+
+		if err := c.httpClient.client.RegisterMetrics(meter); err != nil {
+			c.log.WithError(err).Error("register teams upstream metrics")
+		}
+	}
 }
 
-func New(cfg config.Teams, errors metric.Int64Counter, log logrus.FieldLogger) Client {
-	return &client{
+func New(cfg config.Teams, errors metric.Int64Counter, log logrus.FieldLogger, opts ...Option) Client {
+	c := &client{
 		endpoint: cfg.Endpoint,
 		httpClient: &httpClient{
-			client:   &http.Client{},
+			client: upstream.New(upstream.Config{
+				Name:       "teams",
+				Timeout:    cfg.Timeout,
+				MaxRetries: cfg.MaxRetries,
+			}),
 			apiToken: cfg.Token,
 		},
-		log:    log,
-		errors: errors,
+		userTeams: cache.New(userTeamsCacheTTL, 2*userTeamsCacheTTL),
+		log:       log,
+		errors:    errors,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// Run keeps the teams cache up to date by refreshing it in the background until the context is cancelled. Failed
+// refreshes are retried with an exponential backoff, while readers keep getting the last successful snapshot.
+func (c *client) Run(ctx context.Context) {
+	backoff := teamsRefreshMinBackoff
+	for {
+		wait := teamsRefreshInterval
+		if err := c.refreshTeams(ctx); err != nil {
+			c.error(ctx, err, "refresh teams from the teams-backend")
+			wait = backoff
+			backoff = min(2*backoff, teamsRefreshInterval)
+		} else {
+			backoff = teamsRefreshMinBackoff
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait + time.Duration(rand.Int63n(int64(teamsRefreshJitter)))):
+		}
 	}
 }
 
 // TeamExists checks if a team exists on the backend or not
 func (c *client) TeamExists(ctx context.Context, teamSlug string) bool {
-	c.updateTeams(ctx)
-	c.lock.RLock()
-	defer c.lock.RUnlock()
+	teams, err := c.cachedTeams(ctx)
+	if err != nil {
+		return false
+	}
 
-	for _, team := range c.teams {
+	for _, team := range teams {
 		if team.Name == teamSlug {
 			return true
 		}
@@ -145,12 +318,13 @@ func (c *client) Search(ctx context.Context, query string, filter *model.SearchF
 		return nil
 	}
 
-	c.updateTeams(ctx)
-	c.lock.RLock()
-	defer c.lock.RUnlock()
+	teams, err := c.cachedTeams(ctx)
+	if err != nil {
+		return nil
+	}
 
 	edges := make([]*search.Result, 0)
-	for _, team := range c.teams {
+	for _, team := range teams {
 		rank := search.Match(query, team.Name)
 		if rank == -1 {
 			continue
@@ -163,13 +337,19 @@ func (c *client) Search(ctx context.Context, query string, filter *model.SearchF
 	return edges
 }
 
+// GetCachedTeams get all teams from the cache, in alphabetical order
+func (c *client) GetCachedTeams(ctx context.Context) ([]*model.Team, error) {
+	return c.cachedTeams(ctx)
+}
+
 // GetTeam get a team by the team slug
 func (c *client) GetTeam(ctx context.Context, teamSlug string) (*model.Team, error) {
-	c.updateTeams(ctx)
-	c.lock.RLock()
-	defer c.lock.RUnlock()
+	teams, err := c.cachedTeams(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	for _, team := range c.teams {
+	for _, team := range teams {
 		if team.Name == teamSlug {
 			return team, nil
 		}
@@ -184,7 +364,7 @@ func (c *client) DeauthorizeRepository(ctx context.Context, authorization model.
 		}
 	}`
 
-	vars := map[string]string{
+	vars := map[string]any{
 		"teamSlug":      team,
 		"repoName":      repository,
 		"authorization": authorization.String(),
@@ -221,7 +401,7 @@ func (c *client) AuthorizeRepository(ctx context.Context, authorization model.Re
 		}
 	}`
 
-	vars := map[string]string{
+	vars := map[string]any{
 		"teamSlug":      team,
 		"repoName":      repository,
 		"authorization": authorization.String(),
@@ -270,7 +450,7 @@ func (c *client) GetGithubRepositories(ctx context.Context, teamSlug string) ([]
 		}
 	}`
 
-	vars := map[string]string{
+	vars := map[string]any{
 		"slug": teamSlug,
 	}
 
@@ -306,7 +486,7 @@ func (c *client) GetTeamMembers(ctx context.Context, teamSlug string) ([]Member,
 		}
 	}`
 
-	vars := map[string]string{
+	vars := map[string]any{
 		"slug": teamSlug,
 	}
 
@@ -330,21 +510,7 @@ func (c *client) GetTeamMembers(ctx context.Context, teamSlug string) ([]Member,
 
 func (c *client) GetTeams(ctx context.Context) ([]Team, error) {
 	query := `query {
-		teams {
-			slug
-			purpose
-			slackChannel
-			slackAlertsChannels {
-				channelName
-				environment
-			}
-			reconcilerState {
-				gcpProjects {
-					projectId
-					projectName
-					environment
-				}
-			}
+		teams {` + teamFields + `
 		}
 	}`
 
@@ -362,7 +528,12 @@ func (c *client) GetTeams(ctx context.Context) ([]Team, error) {
 	return respBody.Data.Teams, nil
 }
 
+// GetTeamsForUser get the team memberships of a user, cached per user for a short while
 func (c *client) GetTeamsForUser(ctx context.Context, email string) ([]TeamMembership, error) {
+	if teams, found := c.userTeams.Get(email); found {
+		return teams.([]TeamMembership), nil
+	}
+
 	query := `query ($email: String!) {
 		userByEmail(email: $email) {
 			teams {
@@ -374,7 +545,7 @@ func (c *client) GetTeamsForUser(ctx context.Context, email string) ([]TeamMembe
 		}
 	}`
 
-	vars := map[string]string{
+	vars := map[string]any{
 		"email": email,
 	}
 
@@ -389,6 +560,11 @@ func (c *client) GetTeamsForUser(ctx context.Context, email string) ([]TeamMembe
 		return nil, c.error(ctx, err, "querying teams for user teams")
 	}
 
+	if respBody.Data.UserByEmail == nil {
+		return nil, fmt.Errorf("user %s not found", email)
+	}
+
+	c.userTeams.Set(email, respBody.Data.UserByEmail.Teams, cache.DefaultExpiration)
 	return respBody.Data.UserByEmail.Teams, nil
 }
 
@@ -400,7 +576,7 @@ func (c *client) GetUserByID(ctx context.Context, id string) (*model.User, error
 		}
 	}`
 
-	vars := map[string]string{
+	vars := map[string]any{
 		"id": id,
 	}
 
@@ -432,10 +608,14 @@ func (c *client) GetUser(ctx context.Context, email string) (*User, error) {
 		userByEmail(email: $email) {
 			name
 			id
+			roles {
+				name
+				isGlobal
+			}
 		}
 	}`
 
-	vars := map[string]string{
+	vars := map[string]any{
 		"email": email,
 	}
 
@@ -457,10 +637,227 @@ func (c *client) GetUser(ctx context.Context, email string) (*User, error) {
 	return respBody.Data.UserByEmail, nil
 }
 
-func (c *client) teamsQuery(ctx context.Context, query string, vars map[string]string, respBody interface{}) error {
+// CreateTeam creates a new team in the teams-backend, and adds the user as owner of the team. The teams-backend can not
+// create a team and add its owner in a single mutation, and a created team can not be deleted without the approval of
+// another owner, so adding the owner is retried. If the owner can still not be added, the returned error wraps
+// ErrOwnerNotAdded.
+func (c *client) CreateTeam(ctx context.Context, teamSlug, purpose, slackChannel string, ownerID uuid.UUID) (*model.Team, error) {
+	query := `mutation ($input: CreateTeamInput!) {
+		createTeam(input: $input) {` + teamFields + `
+		}
+	}`
+
+	vars := map[string]any{
+		"input": map[string]string{
+			"slug":         teamSlug,
+			"purpose":      purpose,
+			"slackChannel": slackChannel,
+		},
+	}
+
+	if _, err := c.teamMutation(ctx, query, vars, "createTeam", "creating team"); err != nil {
+		return nil, err
+	}
+
+	var err error
+	backoff := addOwnerBackoff
+	for attempt := 1; attempt <= addOwnerAttempts; attempt++ {
+		var team *model.Team
+		if team, err = c.AddTeamMember(ctx, teamSlug, ownerID, model.TeamRoleOwner); err == nil {
+			return team, nil
+		}
+
+		if attempt == addOwnerAttempts {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%w: %s: %w", ErrOwnerNotAdded, teamSlug, ctx.Err())
+		case <-time.After(backoff):
+			backoff *= 2
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s: %w", ErrOwnerNotAdded, teamSlug, err)
+}
+
+// UpdateTeam updates the purpose and Slack channels of a team in the teams-backend
+func (c *client) UpdateTeam(ctx context.Context, teamSlug string, input model.UpdateTeamInput) (*model.Team, error) {
+	query := `mutation ($slug: Slug!, $input: UpdateTeamInput!) {
+		updateTeam(slug: $slug, input: $input) {` + teamFields + `
+		}
+	}`
+
+	in := map[string]any{}
+	if input.Purpose != nil {
+		in["purpose"] = *input.Purpose
+	}
+	if input.SlackChannel != nil {
+		in["slackChannel"] = *input.SlackChannel
+	}
+	if input.SlackAlertsChannels != nil {
+		channels := make([]map[string]any, 0)
+		for _, ch := range input.SlackAlertsChannels {
+			channels = append(channels, map[string]any{
+				"environment": ch.Env,
+				"channelName": ch.Name,
+			})
+		}
+		in["slackAlertsChannels"] = channels
+	}
+
+	vars := map[string]any{
+		"slug":  teamSlug,
+		"input": in,
+	}
+
+	return c.teamMutation(ctx, query, vars, "updateTeam", "updating team")
+}
+
+// AddTeamMember adds a user to a team with the given role
+func (c *client) AddTeamMember(ctx context.Context, teamSlug string, userID uuid.UUID, role model.TeamRole) (*model.Team, error) {
+	query := `mutation ($slug: Slug!, $member: TeamMemberInput!) {
+		addTeamMember(slug: $slug, member: $member) {` + teamFields + `
+		}
+	}`
+
+	vars := map[string]any{
+		"slug": teamSlug,
+		"member": map[string]string{
+			"userId": userID.String(),
+			"role":   role.String(),
+		},
+	}
+
+	return c.teamMutation(ctx, query, vars, "addTeamMember", "adding team member")
+}
+
+// RemoveTeamMember removes a user from a team
+func (c *client) RemoveTeamMember(ctx context.Context, teamSlug string, userID uuid.UUID) (*model.Team, error) {
+	query := `mutation ($slug: Slug!, $userId: UUID!) {
+		removeUserFromTeam(slug: $slug, userId: $userId) {` + teamFields + `
+		}
+	}`
+
+	vars := map[string]any{
+		"slug":   teamSlug,
+		"userId": userID.String(),
+	}
+
+	return c.teamMutation(ctx, query, vars, "removeUserFromTeam", "removing team member")
+}
+
+// SetTeamMemberRole sets the role of an existing team member
+func (c *client) SetTeamMemberRole(ctx context.Context, teamSlug string, userID uuid.UUID, role model.TeamRole) (*model.Team, error) {
+	query := `mutation ($slug: Slug!, $userId: UUID!, $role: TeamRole!) {
+		setTeamMemberRole(slug: $slug, userId: $userId, role: $role) {` + teamFields + `
+		}
+	}`
+
+	vars := map[string]any{
+		"slug":   teamSlug,
+		"userId": userID.String(),
+		"role":   role.String(),
+	}
+
+	return c.teamMutation(ctx, query, vars, "setTeamMemberRole", "setting team member role")
+}
+
+// SynchronizeTeam requests a synchronization of the team with all external systems
+func (c *client) SynchronizeTeam(ctx context.Context, teamSlug string) (*model.TeamSync, error) {
+	query := `mutation ($slug: Slug!) {
+		synchronizeTeam(slug: $slug) {
+			correlationID
+		}
+	}`
+
+	vars := map[string]any{
+		"slug": teamSlug,
+	}
+
+	respBody := struct {
+		Data struct {
+			SynchronizeTeam *model.TeamSync `json:"synchronizeTeam"`
+		} `json:"data"`
+		Errors []map[string]any `json:"errors"`
+	}{}
+
+	if err := c.teamsQuery(ctx, query, vars, &respBody); err != nil {
+		return nil, c.error(ctx, err, "synchronizing team")
+	}
+
+	if len(respBody.Errors) > 0 {
+		return nil, fmt.Errorf("synchronizing team: %v", respBody.Errors[0]["message"])
+	}
+
+	if respBody.Data.SynchronizeTeam == nil {
+		return nil, fmt.Errorf("synchronizing team: no sync returned from the teams-backend")
+	}
+
+	return respBody.Data.SynchronizeTeam, nil
+}
+
+// ReconcilerStatuses returns the status of all reconcilers for the team, based on the sync errors reported by the
+// teams-backend
+func (t Team) ReconcilerStatuses() []model.ReconcilerStatus {
+	errors := make(map[string]SyncError)
+	for _, syncErr := range t.SyncErrors {
+		errors[syncErr.Reconciler] = syncErr
+	}
+
+	names := append([]string{}, reconcilers...)
+	for _, syncErr := range t.SyncErrors {
+		if !slices.Contains(names, syncErr.Reconciler) {
+			names = append(names, syncErr.Reconciler)
+		}
+	}
+
+	statuses := make([]model.ReconcilerStatus, 0, len(names))
+	for _, name := range names {
+		status := model.ReconcilerStatus{
+			Name:  name,
+			State: model.ReconcilerStateOk,
+		}
+		if syncErr, exists := errors[name]; exists {
+			status.State = model.ReconcilerStateFailing
+			status.Error = &syncErr.Error
+			status.ErrorAt = &syncErr.CreatedAt
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// teamMutation runs a mutation against the teams-backend that returns a team in the given response field. The local
+// teams cache is invalidated when the mutation succeeds.
+func (c *client) teamMutation(ctx context.Context, query string, vars map[string]any, field, msg string) (*model.Team, error) {
+	respBody := struct {
+		Data   map[string]*Team `json:"data"`
+		Errors []map[string]any `json:"errors"`
+	}{}
+
+	if err := c.teamsQuery(ctx, query, vars, &respBody); err != nil {
+		return nil, c.error(ctx, err, msg)
+	}
+
+	if len(respBody.Errors) > 0 {
+		return nil, fmt.Errorf("%s: %v", msg, respBody.Errors[0]["message"])
+	}
+
+	team := respBody.Data[field]
+	if team == nil {
+		return nil, fmt.Errorf("%s: no team returned from the teams-backend", msg)
+	}
+
+	c.invalidateTeams(ctx)
+	return toModelTeams([]Team{*team})[0], nil
+}
+
+func (c *client) teamsQuery(ctx context.Context, query string, vars map[string]any, respBody interface{}) error {
 	q := struct {
-		Query     string            `json:"query"`
-		Variables map[string]string `json:"variables"`
+		Query     string         `json:"query"`
+		Variables map[string]any `json:"variables"`
 	}{
 		Query:     query,
 		Variables: vars,
@@ -471,6 +868,10 @@ func (c *client) teamsQuery(ctx context.Context, query string, vars map[string]s
 		return err
 	}
 
+	if strings.HasPrefix(query, "query") {
+		ctx = upstream.WithIdempotent(ctx)
+	}
+
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
 	if err != nil {
 		return err
@@ -500,25 +901,87 @@ func (c *client) error(ctx context.Context, err error, msg string) error {
 	return fmt.Errorf("%s: %w", msg, err)
 }
 
-// updateTeams update the teams cache when necessary
-func (c *client) updateTeams(ctx context.Context) error {
-	c.lock.RLock()
-	if time.Since(c.updated) < teamsCacheTTL {
-		c.lock.RUnlock()
-		return nil
+// cachedTeams returns the teams from the cache. The teams-backend is only queried synchronously when the cache is
+// empty, a stale cache is returned as-is while it is refreshed in the background.
+func (c *client) cachedTeams(ctx context.Context) ([]*model.Team, error) {
+	snapshot := c.snapshot.Load()
+	if snapshot == nil {
+		c.refreshLock.Lock()
+		defer c.refreshLock.Unlock()
+
+		if snapshot = c.snapshot.Load(); snapshot == nil {
+			var err error
+			if snapshot, err = c.fetchTeams(ctx); err != nil {
+				return nil, err
+			}
+		}
+		return snapshot.teams, nil
+	}
+
+	if snapshot.stale || time.Since(snapshot.updated) > teamsCacheTTL {
+		c.refreshTeamsInBackground(ctx)
 	}
-	c.lock.RUnlock()
-	c.lock.Lock()
-	defer c.lock.Unlock()
 
+	return snapshot.teams, nil
+}
+
+// refreshTeams fetches all teams from the teams-backend and swaps in a new snapshot
+func (c *client) refreshTeams(ctx context.Context) error {
+	c.refreshLock.Lock()
+	defer c.refreshLock.Unlock()
+
+	_, err := c.fetchTeams(ctx)
+	return err
+}
+
+// refreshTeamsInBackground refreshes the teams cache in the background, unless a background refresh is already running
+func (c *client) refreshTeamsInBackground(ctx context.Context) {
+	if !c.refreshing.CompareAndSwap(false, true) {
+		return
+	}
+
+	go func() {
+		defer c.refreshing.Store(false)
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), teamsRefreshTimeout)
+		defer cancel()
+		if err := c.refreshTeams(ctx); err != nil {
+			c.error(ctx, err, "refresh teams from the teams-backend")
+		}
+	}()
+}
+
+// fetchTeams fetches all teams from the teams-backend and swaps in a new snapshot. If the teams are changed through the
+// client while they are being fetched, the new snapshot is marked as stale. The caller must hold the refresh lock.
+func (c *client) fetchTeams(ctx context.Context) (*teamsSnapshot, error) {
+	invalidations := c.invalidations.Load()
 	teams, err := c.GetTeams(ctx)
 	if err != nil {
-		return c.error(ctx, err, "get teams from the teams-backend")
+		return nil, err
 	}
 
-	c.teams = toModelTeams(teams)
-	c.updated = time.Now()
-	return nil
+	slices.SortFunc(teams, func(a, b Team) int {
+		return strings.Compare(a.Slug, b.Slug)
+	})
+
+	snapshot := &teamsSnapshot{
+		teams:   toModelTeams(teams),
+		updated: time.Now(),
+		stale:   c.invalidations.Load() != invalidations,
+	}
+	c.snapshot.Store(snapshot)
+	return snapshot, nil
+}
+
+// invalidateTeams marks the cached teams as stale and refreshes them in the background. Readers keep getting the
+// previous teams until the refresh succeeds. The cached team memberships of users are dropped, as they are queried
+// directly from the teams-backend.
+func (c *client) invalidateTeams(ctx context.Context) {
+	c.invalidations.Add(1)
+	if snapshot := c.snapshot.Load(); snapshot != nil {
+		c.snapshot.CompareAndSwap(snapshot, &teamsSnapshot{teams: snapshot.teams, updated: snapshot.updated, stale: true})
+		c.refreshTeamsInBackground(ctx)
+	}
+	c.userTeams.Flush()
 }
 
 // toModelTeams convert a list of teams from the backend to a list of console backend teams
@@ -551,6 +1014,8 @@ func toModelTeams(teams []Team) []*model.Team {
 				}
 				return models
 			}(team.ReconcilerState.GcpProjects),
+			Reconcilers:        team.ReconcilerStatuses(),
+			LastSuccessfulSync: team.LastSuccessfulSync,
 		})
 	}
 	return models
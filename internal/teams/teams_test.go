@@ -2,13 +2,17 @@ package teams_test
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/nais/console-backend/internal/config"
 	"github.com/nais/console-backend/internal/graph/model"
 	"github.com/nais/console-backend/internal/teams"
+	"github.com/sirupsen/logrus"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	api "go.opentelemetry.io/otel/metric"
@@ -122,6 +126,83 @@ func TestClient_GetTeam(t *testing.T) {
 	})
 }
 
+func TestClient_ReconcilerStatuses(t *testing.T) {
+	ctx := context.Background()
+	testLogger, _ := test.NewNullLogger()
+	log := testLogger.WithContext(ctx)
+
+	teamsBackend := httpServerWithHandlers(t, []http.HandlerFunc{
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"data": {"teams": [{
+				"slug": "team-1",
+				"lastSuccessfulSync": "2023-11-01T12:00:00Z",
+				"syncErrors": [
+					{"reconciler": "github:team", "error": "team not found", "createdAt": "2023-11-02T12:00:00Z"},
+					{"reconciler": "google:gcp:project", "error": "quota exceeded", "createdAt": "2023-11-02T13:00:00Z"}
+				]
+			}]}}`))
+		},
+	})
+	team, err := teams.
+		New(config.Teams{Token: apiToken, Endpoint: teamsBackend.URL}, errorsMeter(t), log).
+		GetTeam(ctx, "team-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2023, 11, 1, 12, 0, 0, 0, time.UTC), *team.LastSuccessfulSync)
+	assert.Len(t, team.Reconcilers, 5)
+
+	statuses := make(map[string]model.ReconcilerStatus)
+	for _, status := range team.Reconcilers {
+		statuses[status.Name] = status
+	}
+
+	assert.Equal(t, model.ReconcilerStateFailing, statuses["github:team"].State)
+	assert.Equal(t, "team not found", *statuses["github:team"].Error)
+	assert.Equal(t, model.ReconcilerStateFailing, statuses["google:gcp:project"].State)
+	assert.Equal(t, model.ReconcilerStateOk, statuses["azure:group"].State)
+	assert.Nil(t, statuses["azure:group"].Error)
+	assert.Nil(t, statuses["azure:group"].ErrorAt)
+}
+
+func TestClient_GetCachedTeams(t *testing.T) {
+	ctx := context.Background()
+	testLogger, _ := test.NewNullLogger()
+	log := testLogger.WithContext(ctx)
+
+	t.Run("error from the teams-backend", func(t *testing.T) {
+		teamsBackend := httpServerWithHandlers(t, []http.HandlerFunc{
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+			},
+		})
+		cachedTeams, err := teams.
+			New(config.Teams{Token: apiToken, Endpoint: teamsBackend.URL}, errorsMeter(t), log).
+			GetCachedTeams(ctx)
+
+		assert.Nil(t, cachedTeams)
+		assert.EqualError(t, err, "querying teams for teams: teams: 400 Bad Request")
+	})
+
+	t.Run("teams in alphabetical order", func(t *testing.T) {
+		teamsBackend := httpServerWithHandlers(t, []http.HandlerFunc{
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{"data": {"teams": [{"slug": "team-b"}, {"slug": "team-c"}, {"slug": "team-a"}]}}`))
+			},
+		})
+		cachedTeams, err := teams.
+			New(config.Teams{Token: apiToken, Endpoint: teamsBackend.URL}, errorsMeter(t), log).
+			GetCachedTeams(ctx)
+
+		assert.NoError(t, err)
+		assert.Len(t, cachedTeams, 3)
+		assert.Equal(t, "team-a", cachedTeams[0].Name)
+		assert.Equal(t, "team-b", cachedTeams[1].Name)
+		assert.Equal(t, "team-c", cachedTeams[2].Name)
+	})
+}
+
 func TestClient_GetGithubRepositories(t *testing.T) {
 	ctx := context.Background()
 	testLogger, _ := test.NewNullLogger()
@@ -227,6 +308,185 @@ func TestClient_GetTeamMembers(t *testing.T) {
 	})
 }
 
+func TestClient_Run(t *testing.T) {
+	t.Run("teams refreshed", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		testLogger, _ := test.NewNullLogger()
+		log := testLogger.WithContext(ctx)
+
+		refreshed := make(chan struct{})
+		teamsBackend := httpServerWithHandlers(t, []http.HandlerFunc{
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{"data": {"teams": [{"slug": "team-1"}, {"slug": "team-2"}]}}`))
+				close(refreshed)
+			},
+		})
+		client := teams.New(config.Teams{Token: apiToken, Endpoint: teamsBackend.URL}, errorsMeter(t), log)
+		go client.Run(ctx)
+		<-refreshed
+
+		assert.True(t, client.TeamExists(ctx, "team-1"))
+		assert.True(t, client.TeamExists(ctx, "team-2"))
+		assert.False(t, client.TeamExists(ctx, "team-3"))
+	})
+
+	t.Run("failed refresh is logged", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		testLogger, hook := test.NewNullLogger()
+		log := testLogger.WithContext(ctx)
+
+		teamsBackend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+		}))
+		defer teamsBackend.Close()
+		client := teams.New(config.Teams{Token: apiToken, Endpoint: teamsBackend.URL}, errorsMeter(t), log)
+		go client.Run(ctx)
+
+		assert.Eventually(t, func() bool {
+			entry := hook.LastEntry()
+			return entry != nil && entry.Level == logrus.ErrorLevel && entry.Message == "refresh teams from the teams-backend"
+		}, time.Second, 10*time.Millisecond)
+	})
+}
+
+func TestClient_GetTeamsForUser(t *testing.T) {
+	ctx := context.Background()
+	testLogger, _ := test.NewNullLogger()
+	log := testLogger.WithContext(ctx)
+
+	t.Run("user not found", func(t *testing.T) {
+		teamsBackend := httpServerWithHandlers(t, []http.HandlerFunc{
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{"data": {"userByEmail": null}}`))
+			},
+		})
+		memberships, err := teams.
+			New(config.Teams{Token: apiToken, Endpoint: teamsBackend.URL}, errorsMeter(t), log).
+			GetTeamsForUser(ctx, "user@example.com")
+
+		assert.Nil(t, memberships)
+		assert.EqualError(t, err, "user user@example.com not found")
+	})
+
+	t.Run("memberships are cached per user", func(t *testing.T) {
+		teamsBackend := httpServerWithHandlers(t, []http.HandlerFunc{
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{"data": {"userByEmail": {"teams": [{"team": {"slug": "team-1"}}]}}}`))
+			},
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{"data": {"userByEmail": {"teams": []}}}`))
+			},
+		})
+		client := teams.New(config.Teams{Token: apiToken, Endpoint: teamsBackend.URL}, errorsMeter(t), log)
+
+		for i := 0; i < 2; i++ {
+			memberships, err := client.GetTeamsForUser(ctx, "user@example.com")
+			assert.NoError(t, err)
+			assert.Len(t, memberships, 1)
+			assert.Equal(t, "team-1", memberships[0].Team.Slug)
+		}
+
+		memberships, err := client.GetTeamsForUser(ctx, "other@example.com")
+		assert.NoError(t, err)
+		assert.Len(t, memberships, 0)
+	})
+}
+
+func TestClient_SetTeamMemberRole(t *testing.T) {
+	ctx := context.Background()
+	testLogger, hook := test.NewNullLogger()
+	log := testLogger.WithContext(ctx)
+	userID := uuid.New()
+
+	t.Run("error from the teams-backend", func(t *testing.T) {
+		teamsBackend := httpServerWithHandlers(t, []http.HandlerFunc{
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{"errors": [{"message": "user is not a member of the team"}],"data": null}`))
+			},
+		})
+		team, err := teams.
+			New(config.Teams{Token: apiToken, Endpoint: teamsBackend.URL}, errorsMeter(t), log).
+			SetTeamMemberRole(ctx, "team-1", userID, model.TeamRoleOwner)
+
+		assert.Nil(t, team)
+		assert.EqualError(t, err, "setting team member role: user is not a member of the team")
+	})
+
+	t.Run("role updated and teams cache invalidated", func(t *testing.T) {
+		teamsBackend := httpServerWithHandlers(t, []http.HandlerFunc{
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{"data": {"teams": [{"slug": "team-1", "purpose": "old purpose"}]}}`))
+			},
+			func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				assert.Contains(t, string(body), userID.String())
+				assert.Contains(t, string(body), `"role":"OWNER"`)
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{"data": {"setTeamMemberRole": {"slug": "team-1", "purpose": "new purpose"}}}`))
+			},
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{"data": {"teams": [{"slug": "team-1", "purpose": "new purpose"}]}}`))
+			},
+		})
+		client := teams.New(config.Teams{Token: apiToken, Endpoint: teamsBackend.URL}, errorsMeter(t), log)
+
+		team, err := client.GetTeam(ctx, "team-1")
+		assert.NoError(t, err)
+		assert.Equal(t, "old purpose", team.Description)
+
+		team, err = client.SetTeamMemberRole(ctx, "team-1", userID, model.TeamRoleOwner)
+		assert.NoError(t, err)
+		assert.Equal(t, "team-1", team.Name)
+
+		assert.Eventually(t, func() bool {
+			team, err := client.GetTeam(ctx, "team-1")
+			return err == nil && team.Description == "new purpose"
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("previous teams kept when the refresh fails", func(t *testing.T) {
+		refreshFailed := make(chan struct{})
+		requests := 0
+		teamsBackend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			switch requests {
+			case 1:
+				w.Write([]byte(`{"data": {"teams": [{"slug": "team-1"}]}}`))
+			case 2:
+				w.Write([]byte(`{"data": {"setTeamMemberRole": {"slug": "team-1"}}}`))
+			case 3:
+				defer close(refreshFailed)
+				fallthrough
+			default:
+				w.WriteHeader(http.StatusBadRequest)
+			}
+		}))
+		defer teamsBackend.Close()
+		client := teams.New(config.Teams{Token: apiToken, Endpoint: teamsBackend.URL}, errorsMeter(t), log)
+
+		assert.True(t, client.TeamExists(ctx, "team-1"))
+
+		_, err := client.SetTeamMemberRole(ctx, "team-1", userID, model.TeamRoleOwner)
+		assert.NoError(t, err)
+		<-refreshFailed
+
+		assert.True(t, client.TeamExists(ctx, "team-1"))
+		assert.Eventually(t, func() bool {
+			entry := hook.LastEntry()
+			return entry != nil && entry.Level == logrus.ErrorLevel && entry.Message == "refresh teams from the teams-backend"
+		}, time.Second, 10*time.Millisecond)
+	})
+}
+
 func httpServerWithHandlers(t *testing.T, handlers []http.HandlerFunc) *httptest.Server {
 	idx := 0
 	t.Cleanup(func() {
@@ -251,3 +511,93 @@ func errorsMeter(t *testing.T) api.Int64Counter {
 	errors, _ := meter.Int64Counter("errors")
 	return errors
 }
+
+func TestClient_GetUser(t *testing.T) {
+	ctx := context.Background()
+	testLogger, _ := test.NewNullLogger()
+	log := testLogger.WithContext(ctx)
+
+	t.Run("tenant admin", func(t *testing.T) {
+		teamsBackend := httpServerWithHandlers(t, []http.HandlerFunc{
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{"data": {"userByEmail": {"name": "Admin", "roles": [{"name": "Team owner", "isGlobal": false}, {"name": "Admin", "isGlobal": true}]}}}`))
+			},
+		})
+		user, err := teams.
+			New(config.Teams{Token: apiToken, Endpoint: teamsBackend.URL}, errorsMeter(t), log).
+			GetUser(ctx, "admin@example.com")
+
+		assert.NoError(t, err)
+		assert.True(t, user.IsAdmin())
+	})
+
+	t.Run("admin role of a team only", func(t *testing.T) {
+		teamsBackend := httpServerWithHandlers(t, []http.HandlerFunc{
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{"data": {"userByEmail": {"name": "User", "roles": [{"name": "Admin", "isGlobal": false}]}}}`))
+			},
+		})
+		user, err := teams.
+			New(config.Teams{Token: apiToken, Endpoint: teamsBackend.URL}, errorsMeter(t), log).
+			GetUser(ctx, "user@example.com")
+
+		assert.NoError(t, err)
+		assert.False(t, user.IsAdmin())
+	})
+}
+
+func TestClient_CreateTeam(t *testing.T) {
+	ctx := context.Background()
+	testLogger, _ := test.NewNullLogger()
+	log := testLogger.WithContext(ctx)
+	ownerID := uuid.New()
+
+	createTeam := func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Contains(t, string(body), "createTeam")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data": {"createTeam": {"slug": "team-1"}}}`))
+	}
+	addOwnerError := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"errors": [{"message": "something went wrong"}],"data": null}`))
+	}
+
+	t.Run("owner added after a failed attempt", func(t *testing.T) {
+		teamsBackend := httpServerWithHandlers(t, []http.HandlerFunc{
+			createTeam,
+			addOwnerError,
+			func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				assert.Contains(t, string(body), ownerID.String())
+				assert.Contains(t, string(body), `"role":"OWNER"`)
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{"data": {"addTeamMember": {"slug": "team-1"}}}`))
+			},
+		})
+		team, err := teams.
+			New(config.Teams{Token: apiToken, Endpoint: teamsBackend.URL}, errorsMeter(t), log).
+			CreateTeam(ctx, "team-1", "purpose", "#channel", ownerID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "team-1", team.Name)
+	})
+
+	t.Run("owner could not be added", func(t *testing.T) {
+		teamsBackend := httpServerWithHandlers(t, []http.HandlerFunc{
+			createTeam,
+			addOwnerError,
+			addOwnerError,
+			addOwnerError,
+		})
+		team, err := teams.
+			New(config.Teams{Token: apiToken, Endpoint: teamsBackend.URL}, errorsMeter(t), log).
+			CreateTeam(ctx, "team-1", "purpose", "#channel", ownerID)
+
+		assert.Nil(t, team)
+		assert.ErrorIs(t, err, teams.ErrOwnerNotAdded)
+		assert.EqualError(t, err, "team created without owner: team-1: adding team member: something went wrong")
+	})
+}
@@ -2,10 +2,12 @@ package teams
 
 import (
 	"net/http"
+
+	"github.com/nais/console-backend/internal/upstream"
 )
 
 type httpClient struct {
-	client   *http.Client
+	client   *upstream.Client
 	apiToken string
 }
 
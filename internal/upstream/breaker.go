@@ -0,0 +1,114 @@
+package upstream
+
+import (
+	"sync"
+	"time"
+)
+
+// State is the state of a circuit breaker
+type State int64
+
+const (
+	// StateClosed lets all calls through
+	StateClosed State = iota
+
+	// StateHalfOpen lets a single trial call through, to check if the upstream has recovered
+	StateHalfOpen
+
+	// StateOpen rejects all calls until the open duration has passed
+	StateOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateHalfOpen:
+		return "half-open"
+	default:
+		return "open"
+	}
+}
+
+// breaker is a circuit breaker that opens after a number of consecutive failures
+type breaker struct {
+	failureThreshold int
+	openDuration     time.Duration
+
+	lock          sync.Mutex
+	state         State
+	failures      int
+	openedAt      time.Time
+	trialInFlight bool
+	now           func() time.Time
+}
+
+func newBreaker(failureThreshold int, openDuration time.Duration) *breaker {
+	return &breaker{
+		failureThreshold: failureThreshold,
+		openDuration:     openDuration,
+		now:              time.Now,
+	}
+}
+
+// allow returns true if a call may be made. A half-open breaker only allows a single call at a time.
+func (b *breaker) allow() bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.state == StateOpen && b.now().Sub(b.openedAt) >= b.openDuration {
+		b.state = StateHalfOpen
+		b.trialInFlight = false
+	}
+
+	switch b.state {
+	case StateClosed:
+		return true
+	case StateHalfOpen:
+		if b.trialInFlight {
+			return false
+		}
+		b.trialInFlight = true
+		return true
+	default:
+		return false
+	}
+}
+
+// record registers the outcome of a call that was allowed by the breaker
+func (b *breaker) record(success bool) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if success {
+		b.state = StateClosed
+		b.failures = 0
+		b.trialInFlight = false
+		return
+	}
+
+	b.failures++
+	if b.state == StateHalfOpen || b.failures >= b.failureThreshold {
+		b.state = StateOpen
+		b.openedAt = b.now()
+		b.trialInFlight = false
+	}
+}
+
+// release ends a call without recording an outcome, for instance when the caller gave up
+func (b *breaker) release() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.trialInFlight = false
+}
+
+// currentState returns the state of the breaker
+func (b *breaker) currentState() State {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.state == StateOpen && b.now().Sub(b.openedAt) >= b.openDuration {
+		return StateHalfOpen
+	}
+	return b.state
+}
@@ -0,0 +1,257 @@
+package upstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	defaultFailureThreshold = 5
+	defaultOpenDuration     = 30 * time.Second
+	retryBaseDelay          = 100 * time.Millisecond
+	retryMaxDelay           = 2 * time.Second
+)
+
+// UnavailableError is returned for all calls to an upstream while its circuit breaker is open
+type UnavailableError struct {
+	Upstream string
+}
+
+func (e *UnavailableError) Error() string {
+	return fmt.Sprintf("upstream %s is unavailable", e.Upstream)
+}
+
+// Config is the configuration of an upstream
+type Config struct {
+	// Name of the upstream, used in errors and metrics
+	Name string
+
+	// Timeout of each attempt. Zero means no timeout.
+	Timeout time.Duration
+
+	// MaxRetries is the max number of retries of idempotent calls that fail
+	MaxRetries int
+
+	// FailureThreshold is the number of consecutive failures that will open the circuit breaker. Defaults to 5.
+	FailureThreshold int
+
+	// OpenDuration is how long the circuit breaker stays open before a trial call is let through. Defaults to 30s.
+	OpenDuration time.Duration
+}
+
+// Client guards calls to an upstream with timeouts, retries and a circuit breaker
+type Client struct {
+	name       string
+	timeout    time.Duration
+	maxRetries int
+	breaker    *breaker
+	httpClient *http.Client
+}
+
+// New creates a new upstream client
+func New(cfg Config) *Client {
+	if cfg.FailureThreshold <= 0 {
+		cfg.FailureThreshold = defaultFailureThreshold
+	}
+	if cfg.OpenDuration <= 0 {
+		cfg.OpenDuration = defaultOpenDuration
+	}
+
+	c := &Client{
+		name:       cfg.Name,
+		timeout:    cfg.Timeout,
+		maxRetries: cfg.MaxRetries,
+		breaker:    newBreaker(cfg.FailureThreshold, cfg.OpenDuration),
+		httpClient: &http.Client{Timeout: cfg.Timeout},
+	}
+	return c
+}
+
+// RegisterMetrics registers a gauge reporting the circuit breaker state of the upstream with the given meter. The
+// state is reported as 0 for closed, 1 for half-open and 2 for open.
+func (c *Client) RegisterMetrics(meter metric.Meter) error {
+	_, err := meter.Int64ObservableGauge(
+		"upstream_circuit_breaker_state",
+		metric.WithDescription("circuit breaker state of upstream services, 0 is closed, 1 is half-open and 2 is open"),
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			o.Observe(int64(c.State()), metric.WithAttributes(attribute.String("upstream", c.name)))
+			return nil
+		}),
+	)
+	return err
+}
+
+type contextKey int
+
+const contextIdempotent contextKey = 1
+
+// WithIdempotent marks calls made with the returned context as idempotent, so that they can be retried regardless of
+// HTTP method
+func WithIdempotent(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextIdempotent, true)
+}
+
+// State returns the current state of the circuit breaker
+func (c *Client) State() State {
+	return c.breaker.currentState()
+}
+
+// Available returns an UnavailableError if the circuit breaker is open
+func (c *Client) Available() error {
+	if c.State() == StateOpen {
+		return &UnavailableError{Upstream: c.name}
+	}
+	return nil
+}
+
+// Do sends an HTTP request. GET, HEAD and OPTIONS requests, and requests marked with WithIdempotent, are retried on
+// network errors and 5xx responses. The response of the last attempt is returned as-is.
+func (c *Client) Do(req *http.Request) (*http.Response, error) {
+	retries := 0
+	if isIdempotent(req) {
+		retries = c.maxRetries
+	}
+
+	var resp *http.Response
+	err := c.attempt(req.Context(), retries, func(attempt int) (bool, error) {
+		r := req
+		if attempt > 0 {
+			if req.Body != nil && req.GetBody == nil {
+				return false, fmt.Errorf("request body cannot be replayed")
+			}
+
+			r = req.Clone(req.Context())
+			if req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return false, err
+				}
+				r.Body = body
+			}
+		}
+
+		var err error
+		resp, err = c.httpClient.Do(r)
+		if err != nil {
+			return true, err
+		}
+
+		if resp.StatusCode >= http.StatusInternalServerError {
+			if attempt < retries {
+				resp.Body.Close()
+			}
+			return true, nil
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		if resp != nil {
+			resp.Body.Close()
+		}
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// Call runs fn guarded by the circuit breaker, with the timeout of the upstream applied to the context. fn is retried
+// when it fails with a transient error, so it must be idempotent. Other errors are returned as-is, and do not count
+// against the circuit breaker.
+func (c *Client) Call(ctx context.Context, fn func(ctx context.Context) error) error {
+	return c.attempt(ctx, c.maxRetries, func(int) (bool, error) {
+		ctx := ctx
+		if c.timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, c.timeout)
+			defer cancel()
+		}
+
+		err := fn(ctx)
+		return isTransient(err), err
+	})
+}
+
+// attempt runs fn until it succeeds, or the retries are exhausted. fn returns true when the attempt failed in a way
+// that should count against the circuit breaker.
+func (c *Client) attempt(ctx context.Context, retries int, fn func(attempt int) (failed bool, err error)) error {
+	for attempt := 0; ; attempt++ {
+		if !c.breaker.allow() {
+			return &UnavailableError{Upstream: c.name}
+		}
+
+		failed, err := fn(attempt)
+		if ctx.Err() != nil {
+			// the caller gave up, which says nothing about the health of the upstream
+			c.breaker.release()
+			if err == nil {
+				err = ctx.Err()
+			}
+			return err
+		}
+
+		c.breaker.record(!failed)
+		if !failed || attempt >= retries {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff(attempt)):
+		}
+	}
+}
+
+// isTransient returns true for errors that say something about the health of the upstream: timeouts, network errors
+// and errors with a 5xx status code, the same failures that are retried by Do
+func isTransient(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+
+	var statusErr interface{ StatusCode() int }
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode() >= http.StatusInternalServerError
+	}
+
+	return false
+}
+
+// isIdempotent returns true if the request can safely be retried
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+
+	idempotent, _ := req.Context().Value(contextIdempotent).(bool)
+	return idempotent
+}
+
+// backoff returns the delay before the given retry, with exponential growth and jitter
+func backoff(attempt int) time.Duration {
+	delay := retryBaseDelay << attempt
+	if delay > retryMaxDelay || delay <= 0 {
+		delay = retryMaxDelay
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
@@ -0,0 +1,225 @@
+package upstream_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	httptest "github.com/nais/console-backend/internal/test"
+	"github.com/nais/console-backend/internal/upstream"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestClient_Do(t *testing.T) {
+	ctx := context.Background()
+
+	failing := func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}
+
+	t.Run("idempotent request is retried", func(t *testing.T) {
+		server := httptest.NewHttpServerWithHandlers(t, []http.HandlerFunc{
+			failing,
+			failing,
+			func(w http.ResponseWriter, _ *http.Request) {
+				w.Write([]byte("ok"))
+			},
+		})
+
+		client := upstream.New(upstream.Config{Name: "test", MaxRetries: 2})
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		resp, err := client.Do(req)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "ok", string(body))
+	})
+
+	t.Run("last response is returned when retries are exhausted", func(t *testing.T) {
+		server := httptest.NewHttpServerWithHandlers(t, []http.HandlerFunc{failing, failing})
+
+		client := upstream.New(upstream.Config{Name: "test", MaxRetries: 1})
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		resp, err := client.Do(req)
+		assert.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	})
+
+	t.Run("non-idempotent request is not retried", func(t *testing.T) {
+		server := httptest.NewHttpServerWithHandlers(t, []http.HandlerFunc{failing})
+
+		client := upstream.New(upstream.Config{Name: "test", MaxRetries: 2})
+		req, _ := http.NewRequestWithContext(ctx, http.MethodPost, server.URL, bytes.NewReader([]byte("body")))
+		resp, err := client.Do(req)
+		assert.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	})
+
+	t.Run("request marked as idempotent is retried with the same body", func(t *testing.T) {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			assert.Equal(t, "body", string(body))
+			w.WriteHeader(http.StatusBadGateway)
+		}
+		server := httptest.NewHttpServerWithHandlers(t, []http.HandlerFunc{handler, handler})
+
+		client := upstream.New(upstream.Config{Name: "test", MaxRetries: 1})
+		req, _ := http.NewRequestWithContext(upstream.WithIdempotent(ctx), http.MethodPost, server.URL, bytes.NewReader([]byte("body")))
+		resp, err := client.Do(req)
+		assert.NoError(t, err)
+		resp.Body.Close()
+	})
+
+	t.Run("breaker opens after consecutive failures", func(t *testing.T) {
+		server := httptest.NewHttpServerWithHandlers(t, []http.HandlerFunc{failing, failing})
+
+		client := upstream.New(upstream.Config{Name: "test", FailureThreshold: 2, OpenDuration: time.Hour})
+		for i := 0; i < 2; i++ {
+			req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+			resp, err := client.Do(req)
+			assert.NoError(t, err)
+			resp.Body.Close()
+		}
+		assert.Equal(t, upstream.StateOpen, client.State())
+
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		_, err := client.Do(req)
+		unavailableError := &upstream.UnavailableError{}
+		assert.ErrorAs(t, err, &unavailableError)
+		assert.Equal(t, "test", unavailableError.Upstream)
+		assert.ErrorAs(t, client.Available(), &unavailableError)
+	})
+
+	t.Run("breaker closes after a successful trial call", func(t *testing.T) {
+		server := httptest.NewHttpServerWithHandlers(t, []http.HandlerFunc{
+			failing,
+			func(w http.ResponseWriter, _ *http.Request) {},
+		})
+
+		client := upstream.New(upstream.Config{Name: "test", FailureThreshold: 1, OpenDuration: 10 * time.Millisecond})
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		resp, err := client.Do(req)
+		assert.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, upstream.StateOpen, client.State())
+
+		time.Sleep(20 * time.Millisecond)
+		assert.Equal(t, upstream.StateHalfOpen, client.State())
+
+		req, _ = http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		resp, err = client.Do(req)
+		assert.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, upstream.StateClosed, client.State())
+	})
+}
+
+func TestClient_Call(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("call failing with a transient error is retried", func(t *testing.T) {
+		calls := 0
+		client := upstream.New(upstream.Config{Name: "test", MaxRetries: 2})
+		err := client.Call(ctx, func(ctx context.Context) error {
+			calls++
+			if calls < 3 {
+				return &net.OpError{Op: "dial", Err: errors.New("connection refused")}
+			}
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("call failing with a 5xx status is retried", func(t *testing.T) {
+		calls := 0
+		client := upstream.New(upstream.Config{Name: "test", MaxRetries: 2})
+		err := client.Call(ctx, func(ctx context.Context) error {
+			calls++
+			return statusError(http.StatusBadGateway)
+		})
+		assert.Error(t, err)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("other errors are neither retried nor counted by the breaker", func(t *testing.T) {
+		client := upstream.New(upstream.Config{Name: "test", MaxRetries: 2, FailureThreshold: 1})
+		for _, callErr := range []error{errors.New("some error"), statusError(http.StatusNotFound)} {
+			calls := 0
+			err := client.Call(ctx, func(ctx context.Context) error {
+				calls++
+				return callErr
+			})
+			assert.Equal(t, callErr, err)
+			assert.Equal(t, 1, calls)
+		}
+		assert.Equal(t, upstream.StateClosed, client.State())
+	})
+
+	t.Run("timeout is applied to each call", func(t *testing.T) {
+		client := upstream.New(upstream.Config{Name: "test", Timeout: 10 * time.Millisecond})
+		err := client.Call(ctx, func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("calls made by a cancelled caller do not open the breaker", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		client := upstream.New(upstream.Config{Name: "test", FailureThreshold: 1})
+		err := client.Call(ctx, func(ctx context.Context) error {
+			return ctx.Err()
+		})
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, upstream.StateClosed, client.State())
+	})
+}
+
+func TestClient_RegisterMetrics(t *testing.T) {
+	ctx := context.Background()
+	reader := metric.NewManualReader()
+	meter := metric.NewMeterProvider(metric.WithReader(reader)).Meter("test")
+
+	open := upstream.New(upstream.Config{Name: "open", FailureThreshold: 1, OpenDuration: time.Hour})
+	_ = open.Call(ctx, func(context.Context) error { return context.DeadlineExceeded })
+	closed := upstream.New(upstream.Config{Name: "closed"})
+
+	assert.NoError(t, open.RegisterMetrics(meter))
+	assert.NoError(t, closed.RegisterMetrics(meter))
+
+	rm := metricdata.ResourceMetrics{}
+	assert.NoError(t, reader.Collect(ctx, &rm))
+	assert.Len(t, rm.ScopeMetrics, 1)
+	assert.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+
+	states := map[string]int64{}
+	for _, dp := range rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Gauge[int64]).DataPoints {
+		name, _ := dp.Attributes.Value("upstream")
+		states[name.AsString()] = dp.Value
+	}
+	assert.Equal(t, map[string]int64{"open": int64(upstream.StateOpen), "closed": int64(upstream.StateClosed)}, states)
+}
+
+// statusError is an error carrying the HTTP status of a failed call
+type statusError int
+
+func (e statusError) Error() string {
+	return http.StatusText(int(e))
+}
+
+func (e statusError) StatusCode() int {
+	return int(e)
+}
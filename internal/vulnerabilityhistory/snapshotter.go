@@ -0,0 +1,187 @@
+package vulnerabilityhistory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nais/console-backend/internal/database/gensql"
+	"github.com/nais/console-backend/internal/dependencytrack"
+	"github.com/nais/console-backend/internal/graph/model"
+	"github.com/nais/console-backend/internal/teams"
+	"github.com/sirupsen/logrus"
+)
+
+// DependencyTrackClient is the part of the DependencyTrack client used by the snapshotter
+type DependencyTrackClient interface {
+	VulnerabilitySummary(ctx context.Context, app *dependencytrack.AppInstance) (*model.VulnerabilitiesNode, error)
+	VulnerabilityFindings(ctx context.Context, app *dependencytrack.AppInstance) ([]*model.VulnerabilityFinding, error)
+}
+
+// AppLister lists the apps of a team, implemented by the k8s client
+type AppLister interface {
+	Apps(ctx context.Context, team string) ([]*model.App, error)
+}
+
+type Snapshotter struct {
+	dependencyTrackClient DependencyTrackClient
+	appLister             AppLister
+	teamsClient           teams.Client
+	querier               gensql.Querier
+	log                   logrus.FieldLogger
+}
+
+// NewSnapshotter creates a new vulnerability snapshotter
+func NewSnapshotter(dependencyTrackClient DependencyTrackClient, appLister AppLister, teamsClient teams.Client, querier gensql.Querier, log logrus.FieldLogger) *Snapshotter {
+	return &Snapshotter{
+		dependencyTrackClient: dependencyTrackClient,
+		appLister:             appLister,
+		teamsClient:           teamsClient,
+		querier:               querier,
+		log:                   log,
+	}
+}
+
+// FindingKey identifies a finding across images of an app. The component version is not part of the key, so a finding
+// disappears when the component is upgraded to a version without the vulnerability.
+func FindingKey(f *model.VulnerabilityFinding) string {
+	return f.VulnerabilityID + ":" + f.Component.Name
+}
+
+// Snapshot stores the vulnerability summary of the running apps of all teams as today's snapshot, replacing earlier
+// snapshots from the same day. Critical findings are tracked from when they appear until they disappear, or are
+// suppressed, or the app is removed, to measure the time to remediate them. Apps without a BOM are skipped. Returns the
+// number of apps snapshotted.
+func (s *Snapshotter) Snapshot(ctx context.Context) (snapshotted int, err error) {
+	now := time.Now()
+	failed := 0
+	listed := gensql.CriticalFindingsResolveForRemovedAppsParams{
+		Resolved: pgtype.Timestamptz{Time: now, Valid: true},
+		Teams:    make([]string, 0),
+		Envs:     make([]string, 0),
+		Apps:     make([]string, 0),
+	}
+
+	teams, err := s.teamsClient.GetCachedTeams(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("unable to get teams: %w", err)
+	}
+
+	for _, team := range teams {
+		apps, err := s.appLister.Apps(ctx, team.Name)
+		if err != nil {
+			s.log.WithError(err).WithField("team", team.Name).Errorf("unable to list apps")
+			failed++
+			continue
+		}
+
+		for _, app := range apps {
+			listed.Teams = append(listed.Teams, team.Name)
+			listed.Envs = append(listed.Envs, app.Env.Name)
+			listed.Apps = append(listed.Apps, app.Name)
+
+			instance := &dependencytrack.AppInstance{Env: app.Env.Name, Team: team.Name, App: app.Name, Image: app.Image}
+			ok, err := s.snapshotApp(ctx, instance, now)
+			if err != nil {
+				s.log.WithError(err).WithField("app", instance.ID()).Errorf("unable to snapshot app")
+				failed++
+				continue
+			}
+			if ok {
+				snapshotted++
+			}
+		}
+	}
+
+	if failed > 0 {
+		return snapshotted, fmt.Errorf("unable to snapshot %d teams or apps, keeping critical findings of removed apps open", failed)
+	}
+
+	// the apps of every team were listed, so the critical findings of apps that are no longer running can be resolved
+	if err := s.querier.CriticalFindingsResolveForRemovedApps(ctx, listed); err != nil {
+		return snapshotted, fmt.Errorf("unable to resolve critical findings of removed apps: %w", err)
+	}
+
+	return snapshotted, nil
+}
+
+func (s *Snapshotter) snapshotApp(ctx context.Context, app *dependencytrack.AppInstance, now time.Time) (bool, error) {
+	node, err := s.dependencyTrackClient.VulnerabilitySummary(ctx, app)
+	if err != nil {
+		return false, err
+	}
+	if !node.HasBom || node.Summary == nil {
+		return false, nil
+	}
+
+	findings, err := s.dependencyTrackClient.VulnerabilityFindings(ctx, app)
+	if err != nil {
+		return false, err
+	}
+
+	err = s.querier.VulnerabilitySnapshotUpsert(ctx, gensql.VulnerabilitySnapshotUpsertParams{
+		Date:       pgtype.Date{Time: now.UTC().Truncate(24 * time.Hour), Valid: true},
+		Team:       app.Team,
+		Env:        app.Env,
+		App:        app.App,
+		Critical:   int32(node.Summary.Critical),
+		High:       int32(node.Summary.High),
+		Medium:     int32(node.Summary.Medium),
+		Low:        int32(node.Summary.Low),
+		Unassigned: int32(node.Summary.Unassigned),
+		RiskScore:  int32(node.Summary.RiskScore),
+	})
+	if err != nil {
+		return false, fmt.Errorf("storing snapshot: %w", err)
+	}
+
+	if err := s.trackCriticalFindings(ctx, app, findings, now); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *Snapshotter) trackCriticalFindings(ctx context.Context, app *dependencytrack.AppInstance, findings []*model.VulnerabilityFinding, now time.Time) error {
+	current := make(map[string]struct{})
+	for _, f := range findings {
+		if f.Severity == model.VulnerabilitySeverityCritical && !f.Suppressed {
+			current[FindingKey(f)] = struct{}{}
+		}
+	}
+
+	open, err := s.querier.OpenCriticalFindings(ctx, gensql.OpenCriticalFindingsParams{
+		Team: app.Team,
+		Env:  app.Env,
+		App:  app.App,
+	})
+	if err != nil {
+		return fmt.Errorf("getting open critical findings: %w", err)
+	}
+
+	seen := pgtype.Timestamptz{Time: now, Valid: true}
+	for _, f := range open {
+		if _, exists := current[f.Finding]; exists {
+			delete(current, f.Finding)
+			continue
+		}
+
+		if err := s.querier.CriticalFindingResolve(ctx, gensql.CriticalFindingResolveParams{ID: f.ID, Resolved: seen}); err != nil {
+			return fmt.Errorf("resolving critical finding: %w", err)
+		}
+	}
+
+	for finding := range current {
+		err := s.querier.CriticalFindingCreate(ctx, gensql.CriticalFindingCreateParams{
+			Team:      app.Team,
+			Env:       app.Env,
+			App:       app.App,
+			Finding:   finding,
+			FirstSeen: seen,
+		})
+		if err != nil {
+			return fmt.Errorf("storing critical finding: %w", err)
+		}
+	}
+	return nil
+}
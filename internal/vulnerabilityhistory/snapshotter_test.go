@@ -0,0 +1,120 @@
+package vulnerabilityhistory_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nais/console-backend/internal/database/gensql"
+	"github.com/nais/console-backend/internal/dependencytrack"
+	"github.com/nais/console-backend/internal/graph/model"
+	"github.com/nais/console-backend/internal/teams"
+	"github.com/nais/console-backend/internal/vulnerabilityhistory"
+	logrustest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type fakeDependencyTrack struct {
+	summaries map[string]*model.VulnerabilitiesNode
+	findings  map[string][]*model.VulnerabilityFinding
+}
+
+func (f *fakeDependencyTrack) VulnerabilitySummary(_ context.Context, app *dependencytrack.AppInstance) (*model.VulnerabilitiesNode, error) {
+	return f.summaries[app.ID()], nil
+}
+
+func (f *fakeDependencyTrack) VulnerabilityFindings(_ context.Context, app *dependencytrack.AppInstance) ([]*model.VulnerabilityFinding, error) {
+	return f.findings[app.ID()], nil
+}
+
+type fakeAppLister map[string][]*model.App
+
+func (f fakeAppLister) Apps(_ context.Context, team string) ([]*model.App, error) {
+	return f[team], nil
+}
+
+func finding(vulnerabilityID, component string, severity model.VulnerabilitySeverity, suppressed bool) *model.VulnerabilityFinding {
+	return &model.VulnerabilityFinding{
+		VulnerabilityID: vulnerabilityID,
+		Severity:        severity,
+		Component:       model.VulnerableComponent{Name: component, Version: "1.0.0"},
+		Suppressed:      suppressed,
+	}
+}
+
+func TestSnapshotter_Snapshot(t *testing.T) {
+	ctx := context.Background()
+	log, _ := logrustest.NewNullLogger()
+
+	apps := fakeAppLister{
+		"team-a": {
+			{Name: "app-1", Env: model.Env{Name: "dev"}, Image: "image-1"},
+			{Name: "app-2", Env: model.Env{Name: "dev"}, Image: "image-2"},
+		},
+	}
+
+	dependencyTrack := &fakeDependencyTrack{
+		summaries: map[string]*model.VulnerabilitiesNode{
+			"dev:team-a:app-1:image-1": {
+				HasBom:  true,
+				Summary: &model.VulnerabilitySummary{Critical: 2, High: 1, RiskScore: 25, Total: 3},
+			},
+			"dev:team-a:app-2:image-2": {HasBom: false},
+		},
+		findings: map[string][]*model.VulnerabilityFinding{
+			"dev:team-a:app-1:image-1": {
+				finding("CVE-1", "log4j-core", model.VulnerabilitySeverityCritical, false),
+				finding("CVE-2", "lodash", model.VulnerabilitySeverityCritical, false),
+				finding("CVE-3", "lodash", model.VulnerabilitySeverityHigh, false),
+				finding("CVE-4", "spring", model.VulnerabilitySeverityCritical, true),
+			},
+		},
+	}
+
+	teamsClient := teams.NewMockClient(t)
+	teamsClient.EXPECT().GetCachedTeams(ctx).Return([]*model.Team{{Name: "team-a"}}, nil)
+
+	querier := gensql.NewMockQuerier(t)
+	querier.EXPECT().
+		VulnerabilitySnapshotUpsert(ctx, mock.MatchedBy(func(p gensql.VulnerabilitySnapshotUpsertParams) bool {
+			return p.Team == "team-a" && p.Env == "dev" && p.App == "app-1" && p.Critical == 2 && p.High == 1 && p.RiskScore == 25 && p.Date.Valid
+		})).
+		Return(nil).
+		Once()
+	querier.EXPECT().
+		OpenCriticalFindings(ctx, gensql.OpenCriticalFindingsParams{Team: "team-a", Env: "dev", App: "app-1"}).
+		Return([]*gensql.VulnerabilityCriticalFinding{
+			{ID: 1, Finding: "CVE-1:log4j-core"},
+			{ID: 2, Finding: "CVE-0:openssl"},
+			{ID: 3, Finding: "CVE-4:spring"},
+		}, nil).
+		Once()
+	querier.EXPECT().
+		CriticalFindingResolve(ctx, mock.MatchedBy(func(p gensql.CriticalFindingResolveParams) bool { return p.ID == 2 })).
+		Return(nil).
+		Once()
+	querier.EXPECT().
+		CriticalFindingResolve(ctx, mock.MatchedBy(func(p gensql.CriticalFindingResolveParams) bool { return p.ID == 3 })).
+		Return(nil).
+		Once()
+	querier.EXPECT().
+		CriticalFindingCreate(ctx, mock.MatchedBy(func(p gensql.CriticalFindingCreateParams) bool {
+			return p.Finding == "CVE-2:lodash" && p.Team == "team-a" && p.Env == "dev" && p.App == "app-1" && p.FirstSeen != (pgtype.Timestamptz{})
+		})).
+		Return(nil).
+		Once()
+	querier.EXPECT().
+		CriticalFindingsResolveForRemovedApps(ctx, mock.MatchedBy(func(p gensql.CriticalFindingsResolveForRemovedAppsParams) bool {
+			return assert.ObjectsAreEqual([]string{"team-a", "team-a"}, p.Teams) &&
+				assert.ObjectsAreEqual([]string{"dev", "dev"}, p.Envs) &&
+				assert.ObjectsAreEqual([]string{"app-1", "app-2"}, p.Apps) &&
+				p.Resolved.Valid
+		})).
+		Return(nil).
+		Once()
+
+	snapshotted, err := vulnerabilityhistory.NewSnapshotter(dependencyTrack, apps, teamsClient, querier, log).Snapshot(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, snapshotted)
+}
@@ -0,0 +1,191 @@
+package vulnerabilityindex
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/nais/console-backend/internal/database"
+	"github.com/nais/console-backend/internal/database/gensql"
+	"github.com/nais/console-backend/internal/dependencytrack"
+	"github.com/nais/console-backend/internal/graph/model"
+	"github.com/nais/console-backend/internal/teams"
+	"github.com/sirupsen/logrus"
+)
+
+// DependencyTrackClient is the part of the DependencyTrack client used by the indexer
+type DependencyTrackClient interface {
+	VulnerabilitySummary(ctx context.Context, app *dependencytrack.AppInstance) (*model.VulnerabilitiesNode, error)
+	Components(ctx context.Context, app *dependencytrack.AppInstance) ([]dependencytrack.Component, error)
+}
+
+// WorkloadLister lists the apps and naisjobs of a team, implemented by the k8s client
+type WorkloadLister interface {
+	Apps(ctx context.Context, team string) ([]*model.App, error)
+	NaisJobs(ctx context.Context, team string) ([]*model.NaisJob, error)
+}
+
+type Indexer struct {
+	dependencyTrackClient DependencyTrackClient
+	workloadLister        WorkloadLister
+	teamsClient           teams.Client
+	querier               database.Querier
+	log                   logrus.FieldLogger
+}
+
+// NewIndexer creates a new vulnerability indexer
+func NewIndexer(dependencyTrackClient DependencyTrackClient, workloadLister WorkloadLister, teamsClient teams.Client, querier database.Querier, log logrus.FieldLogger) *Indexer {
+	return &Indexer{
+		dependencyTrackClient: dependencyTrackClient,
+		workloadLister:        workloadLister,
+		teamsClient:           teamsClient,
+		querier:               querier,
+		log:                   log,
+	}
+}
+
+// UpdateIndex stores the vulnerability summary and components of the running apps and naisjobs of all teams in the
+// database. Workloads that are no longer running are removed from the index, unless some workloads could not be
+// indexed, as the index would otherwise lose workloads on every DependencyTrack hiccup. Returns the number of workloads
+// indexed.
+func (i *Indexer) UpdateIndex(ctx context.Context) (indexed int, err error) {
+	start := time.Now()
+	failed := 0
+
+	teams, err := i.teamsClient.GetCachedTeams(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("unable to get teams: %w", err)
+	}
+
+	for _, team := range teams {
+		instances, err := i.workloads(ctx, team.Name)
+		if err != nil {
+			i.log.WithError(err).WithField("team", team.Name).Errorf("unable to list workloads")
+			failed++
+			continue
+		}
+
+		for _, instance := range instances {
+			if err := i.indexApp(ctx, instance); err != nil {
+				i.log.WithError(err).WithField("workload", instance.ID()).Errorf("unable to index workload")
+				failed++
+				continue
+			}
+			indexed++
+		}
+	}
+
+	if failed > 0 {
+		return indexed, fmt.Errorf("unable to index %d teams or workloads, keeping stale workloads in the index", failed)
+	}
+
+	if err := i.querier.VulnerabilityIndexDeleteStale(ctx, pgtype.Timestamptz{Time: start, Valid: true}); err != nil {
+		return indexed, fmt.Errorf("unable to remove stale workloads from the index: %w", err)
+	}
+
+	return indexed, nil
+}
+
+// workloads returns the apps and naisjobs of a team
+func (i *Indexer) workloads(ctx context.Context, team string) ([]*dependencytrack.AppInstance, error) {
+	apps, err := i.workloadLister.Apps(ctx, team)
+	if err != nil {
+		return nil, fmt.Errorf("listing apps: %w", err)
+	}
+
+	jobs, err := i.workloadLister.NaisJobs(ctx, team)
+	if err != nil {
+		return nil, fmt.Errorf("listing naisjobs: %w", err)
+	}
+
+	instances := make([]*dependencytrack.AppInstance, 0, len(apps)+len(jobs))
+	for _, app := range apps {
+		instances = append(instances, &dependencytrack.AppInstance{Env: app.Env.Name, Team: team, App: app.Name, Image: app.Image, Kind: model.WorkloadTypeApp})
+	}
+	for _, job := range jobs {
+		instances = append(instances, &dependencytrack.AppInstance{Env: job.Env.Name, Team: team, App: job.Name, Image: job.Image, Kind: model.WorkloadTypeNaisjob})
+	}
+	return instances, nil
+}
+
+func (i *Indexer) indexApp(ctx context.Context, app *dependencytrack.AppInstance) error {
+	node, err := i.dependencyTrackClient.VulnerabilitySummary(ctx, app)
+	if err != nil {
+		return err
+	}
+
+	components, err := i.dependencyTrackClient.Components(ctx, app)
+	if err != nil {
+		return err
+	}
+
+	params := gensql.VulnerabilityIndexUpsertParams{
+		Team:   app.Team,
+		Env:    app.Env,
+		Kind:   string(app.WorkloadType()),
+		App:    app.App,
+		Image:  app.Image,
+		HasBom: node.HasBom,
+	}
+	if node.HasBom && node.Summary != nil {
+		params.Critical = int32(node.Summary.Critical)
+		params.High = int32(node.Summary.High)
+		params.Medium = int32(node.Summary.Medium)
+		params.Low = int32(node.Summary.Low)
+		params.Unassigned = int32(node.Summary.Unassigned)
+		params.RiskScore = int32(node.Summary.RiskScore)
+	}
+
+	// the summary and components are stored in a transaction, so that the index never has a summary without its
+	// components
+	return i.querier.Transaction(ctx, func(ctx context.Context, querier gensql.Querier) error {
+		return storeApp(ctx, querier, app, params, components)
+	})
+}
+
+// storeApp stores the vulnerability summary of a workload, and replaces its components
+func storeApp(ctx context.Context, querier gensql.Querier, app *dependencytrack.AppInstance, params gensql.VulnerabilityIndexUpsertParams, components []dependencytrack.Component) error {
+	if err := querier.VulnerabilityIndexUpsert(ctx, params); err != nil {
+		return fmt.Errorf("storing vulnerability summary: %w", err)
+	}
+
+	err := querier.VulnerabilityIndexComponentsDelete(ctx, gensql.VulnerabilityIndexComponentsDeleteParams{
+		Team: app.Team,
+		Env:  app.Env,
+		Kind: string(app.WorkloadType()),
+		App:  app.App,
+	})
+	if err != nil {
+		return fmt.Errorf("removing components: %w", err)
+	}
+
+	if len(components) == 0 {
+		return nil
+	}
+
+	batch := make([]gensql.VulnerabilityIndexComponentsInsertParams, 0, len(components))
+	for _, c := range components {
+		batch = append(batch, gensql.VulnerabilityIndexComponentsInsertParams{
+			Team:    app.Team,
+			Env:     app.Env,
+			Kind:    string(app.WorkloadType()),
+			App:     app.App,
+			Group:   c.Group,
+			Name:    c.Name,
+			Version: c.Version,
+			Purl:    c.Purl,
+		})
+	}
+
+	var batchErr error
+	querier.VulnerabilityIndexComponentsInsert(ctx, batch).Exec(func(_ int, err error) {
+		if err != nil {
+			batchErr = err
+		}
+	})
+	if batchErr != nil {
+		return fmt.Errorf("storing components: %w", batchErr)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package vulnerabilityindex_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/nais/console-backend/internal/database"
+	"github.com/nais/console-backend/internal/database/gensql"
+	"github.com/nais/console-backend/internal/dependencytrack"
+	"github.com/nais/console-backend/internal/graph/model"
+	"github.com/nais/console-backend/internal/teams"
+	"github.com/nais/console-backend/internal/vulnerabilityindex"
+	logrustest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type fakeDependencyTrack struct {
+	summaries map[string]*model.VulnerabilitiesNode
+}
+
+func (f *fakeDependencyTrack) VulnerabilitySummary(_ context.Context, app *dependencytrack.AppInstance) (*model.VulnerabilitiesNode, error) {
+	node, ok := f.summaries[app.ID()]
+	if !ok {
+		return nil, fmt.Errorf("DependencyTrack is down")
+	}
+	return node, nil
+}
+
+func (f *fakeDependencyTrack) Components(_ context.Context, _ *dependencytrack.AppInstance) ([]dependencytrack.Component, error) {
+	return nil, nil
+}
+
+type fakeWorkloadLister struct {
+	apps map[string][]*model.App
+	jobs map[string][]*model.NaisJob
+}
+
+func (f fakeWorkloadLister) Apps(_ context.Context, team string) ([]*model.App, error) {
+	return f.apps[team], nil
+}
+
+func (f fakeWorkloadLister) NaisJobs(_ context.Context, team string) ([]*model.NaisJob, error) {
+	return f.jobs[team], nil
+}
+
+func TestIndexer_UpdateIndex(t *testing.T) {
+	ctx := context.Background()
+	log, _ := logrustest.NewNullLogger()
+
+	workloads := fakeWorkloadLister{
+		apps: map[string][]*model.App{
+			"team-a": {
+				{Name: "app-1", Env: model.Env{Name: "dev"}, Image: "image-1"},
+				{Name: "app-2", Env: model.Env{Name: "prod"}, Image: "image-2"},
+			},
+		},
+		jobs: map[string][]*model.NaisJob{
+			"team-a": {
+				{Name: "app-1", Env: model.Env{Name: "dev"}, Image: "job-image-1"},
+			},
+		},
+	}
+
+	teamsClient := func(t *testing.T) *teams.MockClient {
+		teamsClient := teams.NewMockClient(t)
+		teamsClient.EXPECT().GetCachedTeams(ctx).Return([]*model.Team{{Name: "team-a"}}, nil)
+		return teamsClient
+	}
+
+	t.Run("index apps and naisjobs and remove stale workloads", func(t *testing.T) {
+		dependencyTrack := &fakeDependencyTrack{
+			summaries: map[string]*model.VulnerabilitiesNode{
+				"dev:team-a:app-1:image-1": {
+					HasBom:  true,
+					Summary: &model.VulnerabilitySummary{Critical: 1, High: 2, Medium: 3, Low: 4, Unassigned: 5, RiskScore: 50, Total: 15},
+				},
+				"prod:team-a:app-2:image-2": {
+					HasBom:  false,
+					Summary: &model.VulnerabilitySummary{Critical: -1, High: -1, Medium: -1, Low: -1, Unassigned: -1, RiskScore: -1, Total: -1},
+				},
+				"dev:team-a:naisjob:app-1:job-image-1": {
+					HasBom:  true,
+					Summary: &model.VulnerabilitySummary{Critical: 2, Total: 2},
+				},
+			},
+		}
+
+		querier := database.NewMockQuerier(t)
+		querier.EXPECT().
+			Transaction(ctx, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context, gensql.Querier) error) error {
+				return fn(ctx, querier)
+			})
+		querier.EXPECT().VulnerabilityIndexUpsert(ctx, gensql.VulnerabilityIndexUpsertParams{
+			Team: "team-a", Env: "dev", Kind: "APP", App: "app-1", Image: "image-1", HasBom: true,
+			Critical: 1, High: 2, Medium: 3, Low: 4, Unassigned: 5, RiskScore: 50,
+		}).Return(nil).Once()
+		querier.EXPECT().VulnerabilityIndexUpsert(ctx, gensql.VulnerabilityIndexUpsertParams{
+			Team: "team-a", Env: "prod", Kind: "APP", App: "app-2", Image: "image-2", HasBom: false,
+		}).Return(nil).Once()
+		querier.EXPECT().VulnerabilityIndexUpsert(ctx, gensql.VulnerabilityIndexUpsertParams{
+			Team: "team-a", Env: "dev", Kind: "NAISJOB", App: "app-1", Image: "job-image-1", HasBom: true, Critical: 2,
+		}).Return(nil).Once()
+		querier.EXPECT().VulnerabilityIndexComponentsDelete(ctx, gensql.VulnerabilityIndexComponentsDeleteParams{Team: "team-a", Env: "dev", Kind: "APP", App: "app-1"}).Return(nil).Once()
+		querier.EXPECT().VulnerabilityIndexComponentsDelete(ctx, gensql.VulnerabilityIndexComponentsDeleteParams{Team: "team-a", Env: "prod", Kind: "APP", App: "app-2"}).Return(nil).Once()
+		querier.EXPECT().VulnerabilityIndexComponentsDelete(ctx, gensql.VulnerabilityIndexComponentsDeleteParams{Team: "team-a", Env: "dev", Kind: "NAISJOB", App: "app-1"}).Return(nil).Once()
+		querier.EXPECT().VulnerabilityIndexDeleteStale(ctx, mock.AnythingOfType("pgtype.Timestamptz")).Return(nil).Once()
+
+		indexed, err := vulnerabilityindex.NewIndexer(dependencyTrack, workloads, teamsClient(t), querier, log).UpdateIndex(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, indexed)
+	})
+
+	t.Run("keep stale workloads when some workloads fail", func(t *testing.T) {
+		dependencyTrack := &fakeDependencyTrack{
+			summaries: map[string]*model.VulnerabilitiesNode{
+				"dev:team-a:app-1:image-1": {HasBom: true, Summary: &model.VulnerabilitySummary{}},
+			},
+		}
+
+		querier := database.NewMockQuerier(t)
+		querier.EXPECT().
+			Transaction(ctx, mock.Anything).
+			RunAndReturn(func(ctx context.Context, fn func(context.Context, gensql.Querier) error) error {
+				return fn(ctx, querier)
+			})
+		querier.EXPECT().VulnerabilityIndexUpsert(ctx, mock.Anything).Return(nil).Once()
+		querier.EXPECT().VulnerabilityIndexComponentsDelete(ctx, mock.Anything).Return(nil).Once()
+
+		indexed, err := vulnerabilityindex.NewIndexer(dependencyTrack, workloads, teamsClient(t), querier, log).UpdateIndex(ctx)
+		assert.EqualError(t, err, "unable to index 2 teams or workloads, keeping stale workloads in the index")
+		assert.Equal(t, 1, indexed)
+	})
+}
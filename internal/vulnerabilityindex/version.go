@@ -0,0 +1,116 @@
+package vulnerabilityindex
+
+import (
+	"cmp"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type constraint struct {
+	operator string
+	version  string
+}
+
+// VersionRange is a set of version constraints that must all be satisfied, for instance ">=2.0, <2.17"
+type VersionRange []constraint
+
+// operators is ordered so that two-character operators are matched first
+var operators = []string{">=", "<=", "!=", ">", "<", "="}
+
+// ParseVersionRange parses a comma-separated list of constraints. Each constraint is an operator, one of <, <=, >, >=,
+// = and !=, followed by a version. A version without an operator must match exactly.
+func ParseVersionRange(s string) (VersionRange, error) {
+	ret := make(VersionRange, 0)
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		c := constraint{operator: "=", version: part}
+		for _, op := range operators {
+			if strings.HasPrefix(part, op) {
+				c = constraint{operator: op, version: strings.TrimSpace(strings.TrimPrefix(part, op))}
+				break
+			}
+		}
+		if c.version == "" {
+			return nil, fmt.Errorf("missing version in constraint %q", part)
+		}
+		ret = append(ret, c)
+	}
+
+	if len(ret) == 0 {
+		return nil, fmt.Errorf("empty version range")
+	}
+	return ret, nil
+}
+
+// Contains returns true if the version satisfies all constraints of the range
+func (r VersionRange) Contains(version string) bool {
+	for _, c := range r {
+		res := CompareVersions(version, c.version)
+		ok := false
+		switch c.operator {
+		case "<":
+			ok = res < 0
+		case "<=":
+			ok = res <= 0
+		case ">":
+			ok = res > 0
+		case ">=":
+			ok = res >= 0
+		case "=":
+			ok = res == 0
+		case "!=":
+			ok = res != 0
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// CompareVersions compares two versions, and returns -1, 0 or 1 if a is less than, equal to or greater than b. The
+// versions are compared segment by segment, numerically where both segments are numbers. Missing segments count as 0,
+// so 2.17 equals 2.17.0, and a pre-release, for instance 2.17.0-rc1, is less than the release.
+func CompareVersions(a, b string) int {
+	a, aPre, _ := strings.Cut(strings.TrimPrefix(a, "v"), "-")
+	b, bPre, _ := strings.Cut(strings.TrimPrefix(b, "v"), "-")
+
+	aSegments := strings.Split(a, ".")
+	bSegments := strings.Split(b, ".")
+	for i := 0; i < max(len(aSegments), len(bSegments)); i++ {
+		if res := compareSegments(segment(aSegments, i), segment(bSegments, i)); res != 0 {
+			return res
+		}
+	}
+
+	switch {
+	case aPre == bPre:
+		return 0
+	case aPre == "":
+		return 1
+	case bPre == "":
+		return -1
+	}
+	return compareSegments(aPre, bPre)
+}
+
+func segment(segments []string, i int) string {
+	if i < len(segments) {
+		return segments[i]
+	}
+	return "0"
+}
+
+func compareSegments(a, b string) int {
+	aNum, aErr := strconv.Atoi(a)
+	bNum, bErr := strconv.Atoi(b)
+	if aErr == nil && bErr == nil {
+		return cmp.Compare(aNum, bNum)
+	}
+	return strings.Compare(a, b)
+}
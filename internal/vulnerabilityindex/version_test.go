@@ -0,0 +1,63 @@
+package vulnerabilityindex_test
+
+import (
+	"testing"
+
+	"github.com/nais/console-backend/internal/vulnerabilityindex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"2.17.0", "2.17.0", 0},
+		{"2.17", "2.17.0", 0},
+		{"v1.2.3", "1.2.3", 0},
+		{"2.9.1", "2.17.0", -1},
+		{"2.17.1", "2.17", 1},
+		{"2.17.0-rc1", "2.17.0", -1},
+		{"2.17.0-rc2", "2.17.0-rc1", 1},
+		{"1.0.0.Final", "1.0.0.Beta", 1},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, vulnerabilityindex.CompareVersions(tt.a, tt.b), "%s vs %s", tt.a, tt.b)
+	}
+}
+
+func TestParseVersionRange(t *testing.T) {
+	t.Run("invalid ranges", func(t *testing.T) {
+		_, err := vulnerabilityindex.ParseVersionRange(" , ")
+		assert.EqualError(t, err, "empty version range")
+
+		_, err = vulnerabilityindex.ParseVersionRange(">=2.0, <")
+		assert.EqualError(t, err, `missing version in constraint "<"`)
+	})
+
+	t.Run("upper bound", func(t *testing.T) {
+		r, err := vulnerabilityindex.ParseVersionRange("<2.17")
+		assert.NoError(t, err)
+		assert.True(t, r.Contains("2.14.1"))
+		assert.True(t, r.Contains("2.17.0-rc1"))
+		assert.False(t, r.Contains("2.17.0"))
+		assert.False(t, r.Contains("2.20.0"))
+	})
+
+	t.Run("bounded range", func(t *testing.T) {
+		r, err := vulnerabilityindex.ParseVersionRange(">=2.0, <= 2.16.0")
+		assert.NoError(t, err)
+		assert.True(t, r.Contains("2.0"))
+		assert.True(t, r.Contains("2.16.0"))
+		assert.False(t, r.Contains("1.2.17"))
+		assert.False(t, r.Contains("2.16.1"))
+	})
+
+	t.Run("exact version", func(t *testing.T) {
+		r, err := vulnerabilityindex.ParseVersionRange("2.14.1")
+		assert.NoError(t, err)
+		assert.True(t, r.Contains("2.14.1"))
+		assert.False(t, r.Contains("2.14.2"))
+	})
+}
@@ -0,0 +1,53 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// timeout is the max duration of a single call to the webhook
+const timeout = 10 * time.Second
+
+// Client posts JSON payloads to a webhook, such as a Slack incoming webhook
+type Client struct {
+	url        string
+	httpClient *http.Client
+}
+
+// New creates a client that posts to the webhook at the given URL
+func New(url string) *Client {
+	return &Client{
+		url:        url,
+		httpClient: &http.Client{Timeout: timeout},
+	}
+}
+
+// Post posts the payload to the webhook as JSON. Any response status other than 2xx is an error.
+func (c *Client) Post(ctx context.Context, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("marshal webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("calling webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+
+	return nil
+}
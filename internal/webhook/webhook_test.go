@@ -0,0 +1,43 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	httptest "github.com/nais/console-backend/internal/test"
+	"github.com/nais/console-backend/internal/webhook"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_Post(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("payload is posted as JSON", func(t *testing.T) {
+		server := httptest.NewHttpServerWithHandlers(t, []http.HandlerFunc{
+			func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+				body := map[string]string{}
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, map[string]string{"text": "message"}, body)
+			},
+		})
+
+		err := webhook.New(server.URL).Post(ctx, map[string]string{"text": "message"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("webhook returns error", func(t *testing.T) {
+		server := httptest.NewHttpServerWithHandlers(t, []http.HandlerFunc{
+			func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+			},
+		})
+
+		err := webhook.New(server.URL).Post(ctx, map[string]string{"text": "message"})
+		assert.EqualError(t, err, "webhook returned 404 Not Found")
+	})
+}